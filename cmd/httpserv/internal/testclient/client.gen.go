@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/oapi-codegen/runtime"
 )
@@ -20,6 +21,227 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AuctionBidStatus.
+const (
+	AuctionBidStatusHeld     AuctionBidStatus = "held"
+	AuctionBidStatusReleased AuctionBidStatus = "released"
+	AuctionBidStatusWon      AuctionBidStatus = "won"
+)
+
+// Defines values for AuctionStatus.
+const (
+	AuctionStatusClosed AuctionStatus = "closed"
+	AuctionStatusOpen   AuctionStatus = "open"
+)
+
+// Defines values for EmailPreferencesLanguage.
+const (
+	EmailPreferencesLanguageEn EmailPreferencesLanguage = "en"
+	EmailPreferencesLanguageRu EmailPreferencesLanguage = "ru"
+)
+
+// Defines values for ErrorCode.
+const (
+	ErrorCodeALREADYINWISHLIST       ErrorCode = "ALREADY_IN_WISHLIST"
+	ErrorCodeAUCTIONCLOSED           ErrorCode = "AUCTION_CLOSED"
+	ErrorCodeAUCTIONNOTFOUND         ErrorCode = "AUCTION_NOT_FOUND"
+	ErrorCodeAUCTIONNOTSTARTED       ErrorCode = "AUCTION_NOT_STARTED"
+	ErrorCodeBADREQUEST              ErrorCode = "BAD_REQUEST"
+	ErrorCodeBIDTOOLOW               ErrorCode = "BID_TOO_LOW"
+	ErrorCodeBUNDLEALREADYEXISTS     ErrorCode = "BUNDLE_ALREADY_EXISTS"
+	ErrorCodeBUYOWNLISTING           ErrorCode = "BUY_OWN_LISTING"
+	ErrorCodeCONFLICT                ErrorCode = "CONFLICT"
+	ErrorCodeEMAILNOTVERIFIED        ErrorCode = "EMAIL_NOT_VERIFIED"
+	ErrorCodeFORBIDDEN               ErrorCode = "FORBIDDEN"
+	ErrorCodeGIFTTOSELF              ErrorCode = "GIFT_TO_SELF"
+	ErrorCodeINTERNAL                ErrorCode = "INTERNAL"
+	ErrorCodeINVALIDAUCTION          ErrorCode = "INVALID_AUCTION"
+	ErrorCodeINVALIDBUNDLE           ErrorCode = "INVALID_BUNDLE"
+	ErrorCodeINVALIDLISTING          ErrorCode = "INVALID_LISTING"
+	ErrorCodeINVALIDOTP              ErrorCode = "INVALID_OTP"
+	ErrorCodeINVALIDPROMOCODE        ErrorCode = "INVALID_PROMO_CODE"
+	ErrorCodeINVALIDPROMOTION        ErrorCode = "INVALID_PROMOTION"
+	ErrorCodeINVALIDRAFFLE           ErrorCode = "INVALID_RAFFLE"
+	ErrorCodeINVALIDRECIPIENT        ErrorCode = "INVALID_RECIPIENT"
+	ErrorCodeINVALIDSENDER           ErrorCode = "INVALID_SENDER"
+	ErrorCodeINVALIDTOKEN            ErrorCode = "INVALID_TOKEN"
+	ErrorCodeINVALIDUSER             ErrorCode = "INVALID_USER"
+	ErrorCodeLISTINGCLOSED           ErrorCode = "LISTING_CLOSED"
+	ErrorCodeLISTINGNOTFOUND         ErrorCode = "LISTING_NOT_FOUND"
+	ErrorCodeNEGATIVEPRICE           ErrorCode = "NEGATIVE_PRICE"
+	ErrorCodeNONPOSITIVEAMOUNT       ErrorCode = "NON_POSITIVE_AMOUNT"
+	ErrorCodeNOREFUNDABLEPURCHASE    ErrorCode = "NO_REFUNDABLE_PURCHASE"
+	ErrorCodeNOTENOUGHCOINS          ErrorCode = "NOT_ENOUGH_COINS"
+	ErrorCodeNOTENOUGHLISTED         ErrorCode = "NOT_ENOUGH_LISTED"
+	ErrorCodeNOTENOUGHMERCH          ErrorCode = "NOT_ENOUGH_MERCH"
+	ErrorCodeNOTFOUND                ErrorCode = "NOT_FOUND"
+	ErrorCodeNOTIFICATIONNOTFOUND    ErrorCode = "NOTIFICATION_NOT_FOUND"
+	ErrorCodeNOTINWISHLIST           ErrorCode = "NOT_IN_WISHLIST"
+	ErrorCodeORDERNOTFOUND           ErrorCode = "ORDER_NOT_FOUND"
+	ErrorCodeORDERSTATUSTRANSITION   ErrorCode = "ORDER_STATUS_TRANSITION"
+	ErrorCodeOUTOFSTOCK              ErrorCode = "OUT_OF_STOCK"
+	ErrorCodePROMOCODEALREADYEXISTS  ErrorCode = "PROMO_CODE_ALREADY_EXISTS"
+	ErrorCodePROMOCODENOTAPPLICABLE  ErrorCode = "PROMO_CODE_NOT_APPLICABLE"
+	ErrorCodePROMOTIONEXHAUSTED      ErrorCode = "PROMOTION_EXHAUSTED"
+	ErrorCodeQUERYTOOCOMPLEX         ErrorCode = "QUERY_TOO_COMPLEX"
+	ErrorCodeRAFFLEDRAWN             ErrorCode = "RAFFLE_DRAWN"
+	ErrorCodeRAFFLENOTFOUND          ErrorCode = "RAFFLE_NOT_FOUND"
+	ErrorCodeRAFFLESALESNOTOVER      ErrorCode = "RAFFLE_SALES_NOT_OVER"
+	ErrorCodeRAFFLESALESOVER         ErrorCode = "RAFFLE_SALES_OVER"
+	ErrorCodeRAFFLETICKETSLIMIT      ErrorCode = "RAFFLE_TICKETS_LIMIT"
+	ErrorCodeREFUNDALREADYREQUESTED  ErrorCode = "REFUND_ALREADY_REQUESTED"
+	ErrorCodeREFUNDALREADYRESOLVED   ErrorCode = "REFUND_ALREADY_RESOLVED"
+	ErrorCodeREFUNDNOTFOUND          ErrorCode = "REFUND_NOT_FOUND"
+	ErrorCodeREFUNDORDERCANCELLED    ErrorCode = "REFUND_ORDER_CANCELLED"
+	ErrorCodeSELFTRANSFER            ErrorCode = "SELF_TRANSFER"
+	ErrorCodeTOOMANYREQUESTS         ErrorCode = "TOO_MANY_REQUESTS"
+	ErrorCodeTRANSFERNOTFOUND        ErrorCode = "TRANSFER_NOT_FOUND"
+	ErrorCodeTWOFACTORALREADYENABLED ErrorCode = "TWO_FACTOR_ALREADY_ENABLED"
+	ErrorCodeTWOFACTORNOTENABLED     ErrorCode = "TWO_FACTOR_NOT_ENABLED"
+	ErrorCodeTWOFACTORREQUIRED       ErrorCode = "TWO_FACTOR_REQUIRED"
+	ErrorCodeUNAUTHORIZED            ErrorCode = "UNAUTHORIZED"
+	ErrorCodeUNKNOWNITEM             ErrorCode = "UNKNOWN_ITEM"
+	ErrorCodeUNKNOWNVARIANT          ErrorCode = "UNKNOWN_VARIANT"
+	ErrorCodeUSERALREADYEXISTS       ErrorCode = "USER_ALREADY_EXISTS"
+	ErrorCodeUSERDELETED             ErrorCode = "USER_DELETED"
+	ErrorCodeVALIDATIONFAILED        ErrorCode = "VALIDATION_FAILED"
+	ErrorCodeWEBHOOKDELIVERYNOTFOUND ErrorCode = "WEBHOOK_DELIVERY_NOT_FOUND"
+	ErrorCodeWEBHOOKNOTFOUND         ErrorCode = "WEBHOOK_NOT_FOUND"
+	ErrorCodeWRONGPASSWORD           ErrorCode = "WRONG_PASSWORD"
+	ErrorCodeWRONGPICKUPCODE         ErrorCode = "WRONG_PICKUP_CODE"
+)
+
+// Defines values for ListingStatus.
+const (
+	ListingStatusActive    ListingStatus = "active"
+	ListingStatusCancelled ListingStatus = "cancelled"
+	ListingStatusSold      ListingStatus = "sold"
+)
+
+// Defines values for MerchSort.
+const (
+	MerchSortMinusPrice MerchSort = "-price"
+	MerchSortName       MerchSort = "name"
+	MerchSortPrice      MerchSort = "price"
+)
+
+// Defines values for NotificationKind.
+const (
+	NotificationKindCoinsReceived NotificationKind = "coins_received"
+	NotificationKindItemOnSale    NotificationKind = "item_on_sale"
+	NotificationKindItemRestocked NotificationKind = "item_restocked"
+	NotificationKindOrderReady    NotificationKind = "order_ready"
+)
+
+// Defines values for OrderStatus.
+const (
+	OrderStatusCancelled  OrderStatus = "cancelled"
+	OrderStatusHandedOver OrderStatus = "handed_over"
+	OrderStatusPlaced     OrderStatus = "placed"
+	OrderStatusReady      OrderStatus = "ready"
+)
+
+// Defines values for RaffleStatus.
+const (
+	RaffleStatusDrawn RaffleStatus = "drawn"
+	RaffleStatusOpen  RaffleStatus = "open"
+)
+
+// Defines values for RefundStatus.
+const (
+	RefundStatusApproved  RefundStatus = "approved"
+	RefundStatusRejected  RefundStatus = "rejected"
+	RefundStatusRequested RefundStatus = "requested"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeCoinsTransferred WebhookEventType = "coins.transferred"
+	WebhookEventTypeMerchPurchased   WebhookEventType = "merch.purchased"
+)
+
+// AddWishlistItemRequest defines model for AddWishlistItemRequest.
+type AddWishlistItemRequest struct {
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Variant Вариант предмета.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// Auction defines model for Auction.
+type Auction struct {
+	// Bids Ставки, сначала новые. Возвращаются только при запросе одного аукциона.
+	Bids *[]AuctionBid `json:"bids,omitempty"`
+
+	// ClosedAt Время закрытия аукциона.
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+
+	// EndsAt Время окончания аукциона с учетом продлений.
+	EndsAt time.Time `json:"endsAt"`
+
+	// ExtensionSeconds Ставка, сделанная меньше чем за это время до конца, продлевает аукцион на это время.
+	ExtensionSeconds int64 `json:"extensionSeconds"`
+
+	// Id Идентификатор аукциона.
+	Id int64 `json:"id"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Leader Имя лидирующего участника, после закрытия - победителя.
+	Leader *string `json:"leader,omitempty"`
+
+	// LeadingBid Лидирующая ставка.
+	LeadingBid *int64 `json:"leadingBid,omitempty"`
+
+	// MinStep На сколько следующая ставка должна быть больше лидирующей.
+	MinStep int64 `json:"minStep"`
+
+	// StartPrice Минимальная первая ставка.
+	StartPrice int64 `json:"startPrice"`
+
+	// StartsAt Время начала приема ставок.
+	StartsAt time.Time `json:"startsAt"`
+
+	// Status Статус аукциона.
+	Status AuctionStatus `json:"status"`
+
+	// Variant Вариант предмета.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// AuctionBid defines model for AuctionBid.
+type AuctionBid struct {
+	// Amount Сумма ставки.
+	Amount int64 `json:"amount"`
+
+	// Bidder Имя участника.
+	Bidder string `json:"bidder"`
+
+	// CreatedAt Время ставки.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Идентификатор ставки.
+	Id int64 `json:"id"`
+
+	// Status Статус ставки - удерживается, возвращена или выиграла.
+	Status AuctionBidStatus `json:"status"`
+}
+
+// AuctionBidStatus Статус ставки - удерживается, возвращена или выиграла.
+type AuctionBidStatus string
+
+// AuctionStatus Статус аукциона.
+type AuctionStatus string
+
 // AuthRequest defines model for AuthRequest.
 type AuthRequest struct {
 	// Password Пароль для аутентификации.
@@ -31,14 +253,364 @@ type AuthRequest struct {
 
 // AuthResponse defines model for AuthResponse.
 type AuthResponse struct {
+	// Challenge Возвращается вместо токена, если нужен код двухфакторной аутентификации.
+	Challenge *string `json:"challenge,omitempty"`
+
 	// Token JWT-токен для доступа к защищенным ресурсам.
 	Token *string `json:"token,omitempty"`
 }
 
+// Bundle defines model for Bundle.
+type Bundle struct {
+	// EndsAt Время окончания продажи набора.
+	EndsAt time.Time    `json:"endsAt"`
+	Items  []BundleItem `json:"items"`
+
+	// Price Цена набора.
+	Price int64 `json:"price"`
+
+	// Slug Идентификатор набора.
+	Slug string `json:"slug"`
+
+	// StartsAt Время начала продажи набора.
+	StartsAt time.Time `json:"startsAt"`
+}
+
+// BundleItem defines model for BundleItem.
+type BundleItem struct {
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Quantity Количество предметов в наборе.
+	Quantity int64 `json:"quantity"`
+}
+
+// BuyListingRequest defines model for BuyListingRequest.
+type BuyListingRequest struct {
+	// Quantity Сколько предметов купить, по умолчанию один.
+	Quantity *int64 `json:"quantity,omitempty"`
+}
+
+// BuyMerchRequest defines model for BuyMerchRequest.
+type BuyMerchRequest struct {
+	// ForUser Имя пользователя, которому покупается подарок. Если не указано - предмет покупается себе.
+	ForUser *string `json:"forUser,omitempty"`
+
+	// Message Сообщение к подарку.
+	Message *string `json:"message,omitempty"`
+
+	// PromoCode Промокод на скидку.
+	PromoCode *string `json:"promoCode,omitempty"`
+
+	// Variant Вариант предмета (например, размер). Если не указан - покупается предмет без варианта.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// BuyRaffleTicketsRequest defines model for BuyRaffleTicketsRequest.
+type BuyRaffleTicketsRequest struct {
+	// Quantity Сколько билетов купить, по умолчанию 1.
+	Quantity *int64 `json:"quantity,omitempty"`
+}
+
+// CreateAuctionRequest defines model for CreateAuctionRequest.
+type CreateAuctionRequest struct {
+	// EndsAt Время окончания аукциона.
+	EndsAt time.Time `json:"endsAt"`
+
+	// ExtensionSeconds Продление при поздней ставке в секундах, по умолчанию 60.
+	ExtensionSeconds *int64 `json:"extensionSeconds,omitempty"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// MinStep Минимальный шаг ставки, по умолчанию 1.
+	MinStep *int64 `json:"minStep,omitempty"`
+
+	// StartPrice Минимальная первая ставка.
+	StartPrice int64 `json:"startPrice"`
+
+	// StartsAt Время начала приема ставок.
+	StartsAt time.Time `json:"startsAt"`
+
+	// Variant Вариант предмета.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// CreateListingRequest defines model for CreateListingRequest.
+type CreateListingRequest struct {
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Price Цена за один предмет.
+	Price int64 `json:"price"`
+
+	// Quantity Сколько предметов выставить на продажу.
+	Quantity int64 `json:"quantity"`
+
+	// Variant Вариант предмета.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// CreateOrderRequest defines model for CreateOrderRequest.
+type CreateOrderRequest struct {
+	// ForUser Имя пользователя, которому покупается подарок. Если не указано - предмет покупается себе.
+	ForUser *string `json:"forUser,omitempty"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Message Сообщение к подарку.
+	Message *string `json:"message,omitempty"`
+
+	// PromoCode Промокод на скидку.
+	PromoCode *string `json:"promoCode,omitempty"`
+
+	// Variant Вариант предмета (например, размер). Если не указан - покупается предмет без варианта.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// CreatePromotionRequest defines model for CreatePromotionRequest.
+type CreatePromotionRequest struct {
+	// AmountOff Скидка в монетах.
+	AmountOff *int64 `json:"amountOff,omitempty"`
+
+	// Code Промокод. Если не указан - создается распродажа, которая применяется автоматически.
+	Code *string `json:"code,omitempty"`
+
+	// EndsAt Время окончания акции.
+	EndsAt time.Time `json:"endsAt"`
+
+	// Item Тип предмета, на который действует акция. Если не указан - акция действует на все предметы.
+	Item *string `json:"item,omitempty"`
+
+	// MaxRedemptions Сколько раз акция может быть применена всего.
+	MaxRedemptions *int64 `json:"maxRedemptions,omitempty"`
+
+	// MaxRedemptionsPerUser Сколько раз акция может быть применена одним пользователем.
+	MaxRedemptionsPerUser *int64 `json:"maxRedemptionsPerUser,omitempty"`
+
+	// PercentOff Скидка в процентах от цены.
+	PercentOff *int32 `json:"percentOff,omitempty"`
+
+	// Stackable Можно ли совмещать акцию с другими.
+	Stackable *bool `json:"stackable,omitempty"`
+
+	// StartsAt Время начала акции.
+	StartsAt time.Time `json:"startsAt"`
+}
+
+// CreateRaffleRequest defines model for CreateRaffleRequest.
+type CreateRaffleRequest struct {
+	// Item Разыгрываемый предмет.
+	Item string `json:"item"`
+
+	// MaxTicketsPerUser Сколько билетов может купить один участник. Если не указано - без ограничений.
+	MaxTicketsPerUser *int64 `json:"maxTicketsPerUser,omitempty"`
+
+	// SalesEndAt Время окончания продажи билетов.
+	SalesEndAt time.Time `json:"salesEndAt"`
+
+	// TicketPrice Цена одного билета.
+	TicketPrice int64 `json:"ticketPrice"`
+
+	// Variant Вариант разыгрываемого предмета.
+	Variant *string `json:"variant,omitempty"`
+
+	// WinnersCount Сколько участников получат приз, по умолчанию 1.
+	WinnersCount *int64 `json:"winnersCount,omitempty"`
+}
+
+// CreateWebhookSubscriptionRequest defines model for CreateWebhookSubscriptionRequest.
+type CreateWebhookSubscriptionRequest struct {
+	Events []WebhookEventType `json:"events"`
+
+	// Secret Секрет для подписи. Если не указан - генерируется.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Адрес http или https, на который доставляются события.
+	Url string `json:"url"`
+}
+
+// EmailPreferences Письма о получении монет и о готовности заказа отправляются на имя пользователя, если оно является email. Если не передано при изменении настроек, остается прежним.
+type EmailPreferences struct {
+	// Enabled Отправлять ли письма. По умолчанию включено.
+	Enabled bool `json:"enabled"`
+
+	// Language Язык писем. По умолчанию en.
+	Language EmailPreferencesLanguage `json:"language"`
+}
+
+// EmailPreferencesLanguage Язык писем. По умолчанию en.
+type EmailPreferencesLanguage string
+
+// ErrorCode Стабильный машиночитаемый код ошибки. Значения не меняются, новые коды могут добавляться.
+// * `INTERNAL` - внутренняя ошибка сервера
+// * `BAD_REQUEST` - неверный запрос без более точного кода
+// * `UNAUTHORIZED` - отсутствует или неверный токен
+// * `FORBIDDEN` - недостаточно прав
+// * `NOT_FOUND` - ресурс не найден
+// * `CONFLICT` - конфликт состояния без более точного кода
+// * `VALIDATION_FAILED` - параметры запроса не прошли валидацию
+// * `WRONG_PASSWORD` - неверный пароль
+// * `USER_ALREADY_EXISTS` - пользователь уже существует
+// * `INVALID_USER` - пользователь не существует
+// * `USER_DELETED` - пользователь был удален
+// * `NOT_ENOUGH_COINS` - у пользователя недостаточно монет
+// * `NON_POSITIVE_AMOUNT` - сумма перевода должна быть положительной
+// * `INVALID_SENDER` - отправитель не существует
+// * `INVALID_RECIPIENT` - получатель не существует
+// * `SELF_TRANSFER` - перевод самому себе
+// * `TRANSFER_NOT_FOUND` - перевод не найден
+// * `UNKNOWN_ITEM` - неизвестный товар
+// * `UNKNOWN_VARIANT` - неизвестный вариант товара
+// * `OUT_OF_STOCK` - вариант товара закончился
+// * `NOT_ENOUGH_MERCH` - у пользователя недостаточно товаров
+// * `GIFT_TO_SELF` - подарок самому себе
+// * `NEGATIVE_PRICE` - цена должна быть неотрицательной
+// * `NO_REFUNDABLE_PURCHASE` - нет покупки, доступной для возврата
+// * `REFUND_ALREADY_REQUESTED` - возврат этой покупки уже запрошен
+// * `REFUND_NOT_FOUND` - возврат не найден
+// * `REFUND_ALREADY_RESOLVED` - возврат уже рассмотрен
+// * `REFUND_ORDER_CANCELLED` - заказ покупки уже отменен, монеты возвращены при отмене
+// * `INVALID_PROMOTION` - неверные параметры акции
+// * `PROMO_CODE_ALREADY_EXISTS` - промокод уже существует
+// * `INVALID_PROMO_CODE` - неверный промокод
+// * `PROMO_CODE_NOT_APPLICABLE` - промокод не применим к этому товару сейчас
+// * `PROMOTION_EXHAUSTED` - исчерпан лимит использований акции
+// * `INVALID_BUNDLE` - неверный набор
+// * `BUNDLE_ALREADY_EXISTS` - набор уже существует
+// * `ORDER_NOT_FOUND` - заказ не найден
+// * `ORDER_STATUS_TRANSITION` - заказ нельзя перевести в этот статус
+// * `WRONG_PICKUP_CODE` - неверный код выдачи
+// * `INVALID_LISTING` - неверные параметры объявления
+// * `LISTING_NOT_FOUND` - объявление не найдено
+// * `LISTING_CLOSED` - объявление уже продано или отменено
+// * `NOT_ENOUGH_LISTED` - в объявлении недостаточно единиц
+// * `BUY_OWN_LISTING` - покупка своего объявления
+// * `ALREADY_IN_WISHLIST` - товар уже в списке желаний
+// * `NOT_IN_WISHLIST` - товара нет в списке желаний
+// * `INVALID_AUCTION` - неверные параметры аукциона
+// * `AUCTION_NOT_FOUND` - аукцион не найден
+// * `AUCTION_NOT_STARTED` - аукцион еще не начался
+// * `AUCTION_CLOSED` - аукцион завершен
+// * `BID_TOO_LOW` - ставка слишком мала
+// * `INVALID_RAFFLE` - неверные параметры розыгрыша
+// * `RAFFLE_NOT_FOUND` - розыгрыш не найден
+// * `RAFFLE_SALES_OVER` - продажа билетов завершена
+// * `RAFFLE_SALES_NOT_OVER` - продажа билетов еще не завершена
+// * `RAFFLE_TICKETS_LIMIT` - превышен лимит билетов на пользователя
+// * `RAFFLE_DRAWN` - розыгрыш уже проведен
+// * `QUERY_TOO_COMPLEX` - GraphQL-запрос превышает лимит глубины или сложности
+// * `WEBHOOK_NOT_FOUND` - подписка на вебхуки не найдена
+// * `WEBHOOK_DELIVERY_NOT_FOUND` - доставка вебхука не найдена
+// * `NOTIFICATION_NOT_FOUND` - уведомление не найдено
+// * `TOO_MANY_REQUESTS` - слишком много запросов
+// * `INVALID_TOKEN` - токен недействителен, истек или уже использован
+// * `EMAIL_NOT_VERIFIED` - email пользователя не подтвержден
+// * `INVALID_OTP` - неверный или уже использованный код двухфакторной аутентификации
+// * `TWO_FACTOR_REQUIRED` - требуется двухфакторная аутентификация
+// * `TWO_FACTOR_ALREADY_ENABLED` - двухфакторная аутентификация уже включена
+// * `TWO_FACTOR_NOT_ENABLED` - двухфакторная аутентификация не включена
+type ErrorCode string
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
+	// Code Стабильный машиночитаемый код ошибки. Значения не меняются, новые коды могут добавляться.
+	// * `INTERNAL` - внутренняя ошибка сервера
+	// * `BAD_REQUEST` - неверный запрос без более точного кода
+	// * `UNAUTHORIZED` - отсутствует или неверный токен
+	// * `FORBIDDEN` - недостаточно прав
+	// * `NOT_FOUND` - ресурс не найден
+	// * `CONFLICT` - конфликт состояния без более точного кода
+	// * `VALIDATION_FAILED` - параметры запроса не прошли валидацию
+	// * `WRONG_PASSWORD` - неверный пароль
+	// * `USER_ALREADY_EXISTS` - пользователь уже существует
+	// * `INVALID_USER` - пользователь не существует
+	// * `USER_DELETED` - пользователь был удален
+	// * `NOT_ENOUGH_COINS` - у пользователя недостаточно монет
+	// * `NON_POSITIVE_AMOUNT` - сумма перевода должна быть положительной
+	// * `INVALID_SENDER` - отправитель не существует
+	// * `INVALID_RECIPIENT` - получатель не существует
+	// * `SELF_TRANSFER` - перевод самому себе
+	// * `TRANSFER_NOT_FOUND` - перевод не найден
+	// * `UNKNOWN_ITEM` - неизвестный товар
+	// * `UNKNOWN_VARIANT` - неизвестный вариант товара
+	// * `OUT_OF_STOCK` - вариант товара закончился
+	// * `NOT_ENOUGH_MERCH` - у пользователя недостаточно товаров
+	// * `GIFT_TO_SELF` - подарок самому себе
+	// * `NEGATIVE_PRICE` - цена должна быть неотрицательной
+	// * `NO_REFUNDABLE_PURCHASE` - нет покупки, доступной для возврата
+	// * `REFUND_ALREADY_REQUESTED` - возврат этой покупки уже запрошен
+	// * `REFUND_NOT_FOUND` - возврат не найден
+	// * `REFUND_ALREADY_RESOLVED` - возврат уже рассмотрен
+	// * `REFUND_ORDER_CANCELLED` - заказ покупки уже отменен, монеты возвращены при отмене
+	// * `INVALID_PROMOTION` - неверные параметры акции
+	// * `PROMO_CODE_ALREADY_EXISTS` - промокод уже существует
+	// * `INVALID_PROMO_CODE` - неверный промокод
+	// * `PROMO_CODE_NOT_APPLICABLE` - промокод не применим к этому товару сейчас
+	// * `PROMOTION_EXHAUSTED` - исчерпан лимит использований акции
+	// * `INVALID_BUNDLE` - неверный набор
+	// * `BUNDLE_ALREADY_EXISTS` - набор уже существует
+	// * `ORDER_NOT_FOUND` - заказ не найден
+	// * `ORDER_STATUS_TRANSITION` - заказ нельзя перевести в этот статус
+	// * `WRONG_PICKUP_CODE` - неверный код выдачи
+	// * `INVALID_LISTING` - неверные параметры объявления
+	// * `LISTING_NOT_FOUND` - объявление не найдено
+	// * `LISTING_CLOSED` - объявление уже продано или отменено
+	// * `NOT_ENOUGH_LISTED` - в объявлении недостаточно единиц
+	// * `BUY_OWN_LISTING` - покупка своего объявления
+	// * `ALREADY_IN_WISHLIST` - товар уже в списке желаний
+	// * `NOT_IN_WISHLIST` - товара нет в списке желаний
+	// * `INVALID_AUCTION` - неверные параметры аукциона
+	// * `AUCTION_NOT_FOUND` - аукцион не найден
+	// * `AUCTION_NOT_STARTED` - аукцион еще не начался
+	// * `AUCTION_CLOSED` - аукцион завершен
+	// * `BID_TOO_LOW` - ставка слишком мала
+	// * `INVALID_RAFFLE` - неверные параметры розыгрыша
+	// * `RAFFLE_NOT_FOUND` - розыгрыш не найден
+	// * `RAFFLE_SALES_OVER` - продажа билетов завершена
+	// * `RAFFLE_SALES_NOT_OVER` - продажа билетов еще не завершена
+	// * `RAFFLE_TICKETS_LIMIT` - превышен лимит билетов на пользователя
+	// * `RAFFLE_DRAWN` - розыгрыш уже проведен
+	// * `QUERY_TOO_COMPLEX` - GraphQL-запрос превышает лимит глубины или сложности
+	// * `WEBHOOK_NOT_FOUND` - подписка на вебхуки не найдена
+	// * `WEBHOOK_DELIVERY_NOT_FOUND` - доставка вебхука не найдена
+	// * `NOTIFICATION_NOT_FOUND` - уведомление не найдено
+	// * `TOO_MANY_REQUESTS` - слишком много запросов
+	// * `INVALID_TOKEN` - токен недействителен, истек или уже использован
+	// * `EMAIL_NOT_VERIFIED` - email пользователя не подтвержден
+	// * `INVALID_OTP` - неверный или уже использованный код двухфакторной аутентификации
+	// * `TWO_FACTOR_REQUIRED` - требуется двухфакторная аутентификация
+	// * `TWO_FACTOR_ALREADY_ENABLED` - двухфакторная аутентификация уже включена
+	// * `TWO_FACTOR_NOT_ENABLED` - двухфакторная аутентификация не включена
+	Code ErrorCode `json:"code"`
+
 	// Errors Сообщение об ошибке, описывающее проблему.
 	Errors *string `json:"errors,omitempty"`
+
+	// Fields Ошибки валидации по полям запроса, только для кода VALIDATION_FAILED.
+	Fields *[]FieldError `json:"fields,omitempty"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Путь к полю запроса, для элементов массивов через точку, например items.0.quantity.
+	Field string `json:"field"`
+
+	// Message Почему значение поля не прошло валидацию.
+	Message string `json:"message"`
+}
+
+// ForgotPasswordRequest defines model for ForgotPasswordRequest.
+type ForgotPasswordRequest struct {
+	// Username Имя пользователя, письмо отправляется на него.
+	Username string `json:"username"`
+}
+
+// HandOverOrderRequest defines model for HandOverOrderRequest.
+type HandOverOrderRequest struct {
+	// PickupCode Код выдачи, который показал пользователь.
+	PickupCode string `json:"pickupCode"`
 }
 
 // InfoResponse defines model for InfoResponse.
@@ -61,713 +633,13001 @@ type InfoResponse struct {
 	} `json:"coinHistory,omitempty"`
 
 	// Coins Количество доступных монет.
-	Coins     *int `json:"coins,omitempty"`
+	Coins       *int `json:"coins,omitempty"`
+	GiftHistory *struct {
+		Received *[]struct {
+			// FromUser Имя пользователя, который подарил предмет.
+			FromUser *string `json:"fromUser,omitempty"`
+
+			// Item Тип подаренного предмета.
+			Item *string `json:"item,omitempty"`
+
+			// Message Сообщение к подарку.
+			Message *string `json:"message,omitempty"`
+		} `json:"received,omitempty"`
+		Sent *[]struct {
+			// Item Тип подаренного предмета.
+			Item *string `json:"item,omitempty"`
+
+			// Message Сообщение к подарку.
+			Message *string `json:"message,omitempty"`
+
+			// ToUser Имя пользователя, которому подарен предмет.
+			ToUser *string `json:"toUser,omitempty"`
+		} `json:"sent,omitempty"`
+	} `json:"giftHistory,omitempty"`
 	Inventory *[]struct {
 		// Quantity Количество предметов.
 		Quantity *int `json:"quantity,omitempty"`
 
 		// Type Тип предмета.
 		Type *string `json:"type,omitempty"`
+
+		// Variant Вариант предмета, отсутствует у предметов без вариантов.
+		Variant *string `json:"variant,omitempty"`
 	} `json:"inventory,omitempty"`
 }
 
-// SendCoinRequest defines model for SendCoinRequest.
-type SendCoinRequest struct {
-	// Amount Количество монет, которые необходимо отправить.
-	Amount int `json:"amount"`
+// InventoryItem defines model for InventoryItem.
+type InventoryItem struct {
+	// Item Тип предмета.
+	Item string `json:"item"`
 
-	// ToUser Имя пользователя, которому нужно отправить монеты.
-	ToUser string `json:"toUser"`
+	// Quantity Количество предметов.
+	Quantity int64 `json:"quantity"`
+
+	// Variant Вариант предмета, отсутствует у предметов без вариантов.
+	Variant *string `json:"variant,omitempty"`
 }
 
-// PostApiAuthJSONRequestBody defines body for PostApiAuth for application/json ContentType.
-type PostApiAuthJSONRequestBody = AuthRequest
+// Listing defines model for Listing.
+type Listing struct {
+	// ClosedAt Время продажи последнего предмета или отмены объявления.
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
 
-// PostApiSendCoinJSONRequestBody defines body for PostApiSendCoin for application/json ContentType.
-type PostApiSendCoinJSONRequestBody = SendCoinRequest
+	// CreatedAt Время создания объявления.
+	CreatedAt time.Time `json:"createdAt"`
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+	// Id Идентификатор объявления.
+	Id int64 `json:"id"`
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Price Цена за один предмет.
+	Price int64 `json:"price"`
+
+	// Quantity Сколько предметов еще продается.
+	Quantity int64 `json:"quantity"`
+
+	// Seller Имя продавца.
+	Seller string `json:"seller"`
+
+	// Status Статус объявления.
+	Status ListingStatus `json:"status"`
+
+	// Variant Вариант предмета.
+	Variant *string `json:"variant,omitempty"`
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// ListingStatus Статус объявления.
+type ListingStatus string
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+// LoginOTPRequest defines model for LoginOTPRequest.
+type LoginOTPRequest struct {
+	// Challenge Challenge из ответа /api/auth.
+	Challenge string `json:"challenge"`
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+	// Code Шестизначный код из приложения-аутентификатора или резервный код.
+	Code string `json:"code"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// MerchCategory defines model for MerchCategory.
+type MerchCategory struct {
+	// Name Название категории.
+	Name string `json:"name"`
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
+	// Slug Идентификатор категории.
+	Slug string `json:"slug"`
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
+// MerchItem defines model for MerchItem.
+type MerchItem struct {
+	// Category Категория предмета.
+	Category *string `json:"category,omitempty"`
+
+	// Description Описание предмета.
+	Description string `json:"description"`
+
+	// ImageUrl Ссылка на изображение предмета, отсутствует у предметов без изображения.
+	ImageUrl *string `json:"imageUrl,omitempty"`
+
+	// Name Название предмета.
+	Name string `json:"name"`
+
+	// Price Цена предмета.
+	Price int64 `json:"price"`
+
+	// Slug Идентификатор предмета, используется в /api/buy/{item}.
+	Slug string `json:"slug"`
 }
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
+// MerchPage defines model for MerchPage.
+type MerchPage struct {
+	Items []MerchItem `json:"items"`
+
+	// Limit Размер страницы.
+	Limit int32 `json:"limit"`
+
+	// Offset Сколько предметов пропущено.
+	Offset int32 `json:"offset"`
+
+	// Total Сколько всего предметов подходит под фильтры.
+	Total int64 `json:"total"`
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// PostApiAuthWithBody request with any body
-	PostApiAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// MerchSort Сортировка каталога - по названию, по возрастанию или убыванию цены.
+type MerchSort string
 
-	PostApiAuth(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Notification defines model for Notification.
+type Notification struct {
+	// CreatedAt Время создания уведомления.
+	CreatedAt time.Time `json:"createdAt"`
 
-	// GetApiBuyItem request
-	GetApiBuyItem(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Id Идентификатор уведомления.
+	Id int64 `json:"id"`
 
-	// GetApiInfo request
-	GetApiInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Item Тип предмета, к которому относится уведомление.
+	Item *string `json:"item,omitempty"`
 
-	// PostApiSendCoinWithBody request with any body
-	PostApiSendCoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Kind Тип уведомления.
+	Kind NotificationKind `json:"kind"`
 
-	PostApiSendCoin(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Message Текст уведомления.
+	Message string `json:"message"`
+
+	// ReadAt Время прочтения, отсутствует у непрочитанных.
+	ReadAt *time.Time `json:"readAt,omitempty"`
 }
 
-func (c *Client) PostApiAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiAuthRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// NotificationKind Тип уведомления.
+type NotificationKind string
+
+// NotificationPreferences defines model for NotificationPreferences.
+type NotificationPreferences struct {
+	// Email Письма о получении монет и о готовности заказа отправляются на имя пользователя, если оно является email. Если не передано при изменении настроек, остается прежним.
+	Email *EmailPreferences `json:"email,omitempty"`
+
+	// Muted Отключенные типы уведомлений.
+	Muted []NotificationKind `json:"muted"`
 }
 
-func (c *Client) PostApiAuth(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiAuthRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// Order defines model for Order.
+type Order struct {
+	// CreatedAt Время оформления заказа.
+	CreatedAt time.Time `json:"createdAt"`
 
-func (c *Client) GetApiBuyItem(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiBuyItemRequest(c.Server, item)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	// Id Идентификатор заказа.
+	Id int64 `json:"id"`
 
-func (c *Client) GetApiInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiInfoRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	// Item Тип предмета.
+	Item string `json:"item"`
 
-func (c *Client) PostApiSendCoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiSendCoinRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	// Payer Имя пользователя, который оплатил заказ. Отличается от user для подарков.
+	Payer string `json:"payer"`
 
-func (c *Client) PostApiSendCoin(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiSendCoinRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	// PickupCode Код, который пользователь показывает при получении заказа.
+	PickupCode *string `json:"pickupCode,omitempty"`
+
+	// Price Оплаченная цена - столько монет вернется при отмене.
+	Price int64 `json:"price"`
+
+	// Status Статус заказа - оформлен, готов к выдаче, выдан или отменен.
+	Status OrderStatus `json:"status"`
+
+	// UpdatedAt Время последнего изменения статуса.
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// UpdatedBy Пользователь, последним изменивший статус.
+	UpdatedBy *string `json:"updatedBy,omitempty"`
+
+	// User Имя пользователя, который забирает предмет.
+	User string `json:"user"`
+
+	// Variant Вариант предмета.
+	Variant *string `json:"variant,omitempty"`
 }
 
-// NewPostApiAuthRequest calls the generic PostApiAuth builder with application/json body
-func NewPostApiAuthRequest(server string, body PostApiAuthJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiAuthRequestWithBody(server, "application/json", bodyReader)
+// OrderStatus Статус заказа - оформлен, готов к выдаче, выдан или отменен.
+type OrderStatus string
+
+// PlaceBidRequest defines model for PlaceBidRequest.
+type PlaceBidRequest struct {
+	// Amount Сумма ставки.
+	Amount int64 `json:"amount"`
 }
 
-// NewPostApiAuthRequestWithBody generates requests for PostApiAuth with any type of body
-func NewPostApiAuthRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// ProblemDetails Ошибка в формате RFC 7807. Возвращается, если клиент указал application/problem+json
+// в заголовке Accept, иначе возвращается ErrorResponse.
+type ProblemDetails struct {
+	// Code Стабильный машиночитаемый код ошибки. Значения не меняются, новые коды могут добавляться.
+	// * `INTERNAL` - внутренняя ошибка сервера
+	// * `BAD_REQUEST` - неверный запрос без более точного кода
+	// * `UNAUTHORIZED` - отсутствует или неверный токен
+	// * `FORBIDDEN` - недостаточно прав
+	// * `NOT_FOUND` - ресурс не найден
+	// * `CONFLICT` - конфликт состояния без более точного кода
+	// * `VALIDATION_FAILED` - параметры запроса не прошли валидацию
+	// * `WRONG_PASSWORD` - неверный пароль
+	// * `USER_ALREADY_EXISTS` - пользователь уже существует
+	// * `INVALID_USER` - пользователь не существует
+	// * `USER_DELETED` - пользователь был удален
+	// * `NOT_ENOUGH_COINS` - у пользователя недостаточно монет
+	// * `NON_POSITIVE_AMOUNT` - сумма перевода должна быть положительной
+	// * `INVALID_SENDER` - отправитель не существует
+	// * `INVALID_RECIPIENT` - получатель не существует
+	// * `SELF_TRANSFER` - перевод самому себе
+	// * `TRANSFER_NOT_FOUND` - перевод не найден
+	// * `UNKNOWN_ITEM` - неизвестный товар
+	// * `UNKNOWN_VARIANT` - неизвестный вариант товара
+	// * `OUT_OF_STOCK` - вариант товара закончился
+	// * `NOT_ENOUGH_MERCH` - у пользователя недостаточно товаров
+	// * `GIFT_TO_SELF` - подарок самому себе
+	// * `NEGATIVE_PRICE` - цена должна быть неотрицательной
+	// * `NO_REFUNDABLE_PURCHASE` - нет покупки, доступной для возврата
+	// * `REFUND_ALREADY_REQUESTED` - возврат этой покупки уже запрошен
+	// * `REFUND_NOT_FOUND` - возврат не найден
+	// * `REFUND_ALREADY_RESOLVED` - возврат уже рассмотрен
+	// * `REFUND_ORDER_CANCELLED` - заказ покупки уже отменен, монеты возвращены при отмене
+	// * `INVALID_PROMOTION` - неверные параметры акции
+	// * `PROMO_CODE_ALREADY_EXISTS` - промокод уже существует
+	// * `INVALID_PROMO_CODE` - неверный промокод
+	// * `PROMO_CODE_NOT_APPLICABLE` - промокод не применим к этому товару сейчас
+	// * `PROMOTION_EXHAUSTED` - исчерпан лимит использований акции
+	// * `INVALID_BUNDLE` - неверный набор
+	// * `BUNDLE_ALREADY_EXISTS` - набор уже существует
+	// * `ORDER_NOT_FOUND` - заказ не найден
+	// * `ORDER_STATUS_TRANSITION` - заказ нельзя перевести в этот статус
+	// * `WRONG_PICKUP_CODE` - неверный код выдачи
+	// * `INVALID_LISTING` - неверные параметры объявления
+	// * `LISTING_NOT_FOUND` - объявление не найдено
+	// * `LISTING_CLOSED` - объявление уже продано или отменено
+	// * `NOT_ENOUGH_LISTED` - в объявлении недостаточно единиц
+	// * `BUY_OWN_LISTING` - покупка своего объявления
+	// * `ALREADY_IN_WISHLIST` - товар уже в списке желаний
+	// * `NOT_IN_WISHLIST` - товара нет в списке желаний
+	// * `INVALID_AUCTION` - неверные параметры аукциона
+	// * `AUCTION_NOT_FOUND` - аукцион не найден
+	// * `AUCTION_NOT_STARTED` - аукцион еще не начался
+	// * `AUCTION_CLOSED` - аукцион завершен
+	// * `BID_TOO_LOW` - ставка слишком мала
+	// * `INVALID_RAFFLE` - неверные параметры розыгрыша
+	// * `RAFFLE_NOT_FOUND` - розыгрыш не найден
+	// * `RAFFLE_SALES_OVER` - продажа билетов завершена
+	// * `RAFFLE_SALES_NOT_OVER` - продажа билетов еще не завершена
+	// * `RAFFLE_TICKETS_LIMIT` - превышен лимит билетов на пользователя
+	// * `RAFFLE_DRAWN` - розыгрыш уже проведен
+	// * `QUERY_TOO_COMPLEX` - GraphQL-запрос превышает лимит глубины или сложности
+	// * `WEBHOOK_NOT_FOUND` - подписка на вебхуки не найдена
+	// * `WEBHOOK_DELIVERY_NOT_FOUND` - доставка вебхука не найдена
+	// * `NOTIFICATION_NOT_FOUND` - уведомление не найдено
+	// * `TOO_MANY_REQUESTS` - слишком много запросов
+	// * `INVALID_TOKEN` - токен недействителен, истек или уже использован
+	// * `EMAIL_NOT_VERIFIED` - email пользователя не подтвержден
+	// * `INVALID_OTP` - неверный или уже использованный код двухфакторной аутентификации
+	// * `TWO_FACTOR_REQUIRED` - требуется двухфакторная аутентификация
+	// * `TWO_FACTOR_ALREADY_ENABLED` - двухфакторная аутентификация уже включена
+	// * `TWO_FACTOR_NOT_ENABLED` - двухфакторная аутентификация не включена
+	Code ErrorCode `json:"code"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Detail Сообщение об ошибке, описывающее проблему.
+	Detail *string `json:"detail,omitempty"`
 
-	operationPath := fmt.Sprintf("/api/auth")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Fields Ошибки валидации по полям запроса, только для кода VALIDATION_FAILED.
+	Fields *[]FieldError `json:"fields,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// Instance Идентификатор запроса из заголовка X-Request-ID.
+	Instance *string `json:"instance,omitempty"`
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// Status HTTP статус ответа.
+	Status int `json:"status"`
 
-	req.Header.Add("Content-Type", contentType)
+	// Title Краткое описание HTTP статуса.
+	Title string `json:"title"`
 
-	return req, nil
+	// Type URI типа ошибки, однозначно соответствует коду ошибки.
+	Type string `json:"type"`
 }
 
-// NewGetApiBuyItemRequest generates requests for GetApiBuyItem
-func NewGetApiBuyItemRequest(server string, item string) (*http.Request, error) {
-	var err error
+// Promotion defines model for Promotion.
+type Promotion struct {
+	// AmountOff Скидка в монетах.
+	AmountOff int64 `json:"amountOff"`
 
-	var pathParam0 string
+	// Code Промокод. Отсутствует у распродаж, которые применяются автоматически.
+	Code *string `json:"code,omitempty"`
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
-	if err != nil {
-		return nil, err
-	}
+	// EndsAt Время окончания акции.
+	EndsAt time.Time `json:"endsAt"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Id Идентификатор акции.
+	Id int64 `json:"id"`
 
-	operationPath := fmt.Sprintf("/api/buy/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Item Тип предмета, на который действует акция. Если не указан - акция действует на все предметы.
+	Item *string `json:"item,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// MaxRedemptions Сколько раз акция может быть применена всего. Если не указано - без ограничений.
+	MaxRedemptions *int64 `json:"maxRedemptions,omitempty"`
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// MaxRedemptionsPerUser Сколько раз акция может быть применена одним пользователем. Если не указано - без ограничений.
+	MaxRedemptionsPerUser *int64 `json:"maxRedemptionsPerUser,omitempty"`
 
-	return req, nil
+	// PercentOff Скидка в процентах от цены.
+	PercentOff int32 `json:"percentOff"`
+
+	// Stackable Можно ли совмещать акцию с другими. Из несовмещаемых акций применяется одна с наибольшей скидкой, совмещаемые применяются после нее к оставшейся цене.
+	Stackable bool `json:"stackable"`
+
+	// StartsAt Время начала акции.
+	StartsAt time.Time `json:"startsAt"`
 }
 
-// NewGetApiInfoRequest generates requests for GetApiInfo
-func NewGetApiInfoRequest(server string) (*http.Request, error) {
-	var err error
+// Raffle defines model for Raffle.
+type Raffle struct {
+	// CreatedAt Время создания розыгрыша.
+	CreatedAt time.Time `json:"createdAt"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// DrawnAt Время проведения розыгрыша.
+	DrawnAt *time.Time `json:"drawnAt,omitempty"`
 
-	operationPath := fmt.Sprintf("/api/info")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// DrawnBy Кто провел розыгрыш.
+	DrawnBy *string `json:"drawnBy,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// Id Идентификатор розыгрыша.
+	Id int64 `json:"id"`
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// Item Разыгрываемый предмет.
+	Item string `json:"item"`
 
-	return req, nil
+	// MaxTicketsPerUser Сколько билетов может купить один участник.
+	MaxTicketsPerUser *int64 `json:"maxTicketsPerUser,omitempty"`
+
+	// SalesEndAt Время окончания продажи билетов.
+	SalesEndAt time.Time `json:"salesEndAt"`
+
+	// Seed Зерно розыгрыша, раскрывается после розыгрыша. Победители выбираются по очереди из билетов в порядке продажи: на шаге k берутся первые 8 байт SHA-256 от строки "<seed>:<k>" как беззнаковое big-endian число, остаток от деления на число оставшихся билетов - индекс билета-победителя, после чего все билеты его владельца исключаются.
+	Seed *string `json:"seed,omitempty"`
+
+	// SeedHash SHA-256 от зерна в hex, публикуется при создании розыгрыша.
+	SeedHash string `json:"seedHash"`
+
+	// Status Статус розыгрыша.
+	Status RaffleStatus `json:"status"`
+
+	// TicketPrice Цена одного билета.
+	TicketPrice int64 `json:"ticketPrice"`
+
+	// TicketsSold Сколько билетов продано. Возвращается только при запросе одного розыгрыша.
+	TicketsSold *int64 `json:"ticketsSold,omitempty"`
+
+	// Variant Вариант разыгрываемого предмета.
+	Variant *string `json:"variant,omitempty"`
+
+	// Winners Выигравшие билеты. Возвращаются только при запросе одного розыгрыша.
+	Winners *[]RaffleTicket `json:"winners,omitempty"`
+
+	// WinnersCount Сколько участников получат приз.
+	WinnersCount int64 `json:"winnersCount"`
 }
 
-// NewPostApiSendCoinRequest calls the generic PostApiSendCoin builder with application/json body
-func NewPostApiSendCoinRequest(server string, body PostApiSendCoinJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiSendCoinRequestWithBody(server, "application/json", bodyReader)
+// RaffleStatus Статус розыгрыша.
+type RaffleStatus string
+
+// RaffleTicket defines model for RaffleTicket.
+type RaffleTicket struct {
+	// CreatedAt Время покупки билета.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Номер билета.
+	Id int64 `json:"id"`
+
+	// Price Цена, уплаченная за билет.
+	Price int64 `json:"price"`
+
+	// User Владелец билета.
+	User string `json:"user"`
+
+	// Won Выиграл ли билет.
+	Won bool `json:"won"`
 }
 
-// NewPostApiSendCoinRequestWithBody generates requests for PostApiSendCoin with any type of body
-func NewPostApiSendCoinRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// Refund defines model for Refund.
+type Refund struct {
+	// Id Идентификатор запроса на возврат.
+	Id int64 `json:"id"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Item Тип возвращаемого предмета.
+	Item string `json:"item"`
 
-	operationPath := fmt.Sprintf("/api/sendCoin")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Price Цена покупки - столько монет будет возвращено.
+	Price int64 `json:"price"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// RequestedAt Время создания запроса на возврат.
+	RequestedAt time.Time `json:"requestedAt"`
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// ResolvedAt Время обработки запроса администратором.
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
 
-	req.Header.Add("Content-Type", contentType)
+	// ResolvedBy Администратор, обработавший запрос.
+	ResolvedBy *string `json:"resolvedBy,omitempty"`
 
-	return req, nil
+	// Status Статус запроса на возврат.
+	Status RefundStatus `json:"status"`
+
+	// User Имя пользователя, запросившего возврат.
+	User string `json:"user"`
+
+	// Variant Вариант возвращаемого предмета.
+	Variant *string `json:"variant,omitempty"`
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
+// RefundStatus Статус запроса на возврат.
+type RefundStatus string
+
+// ResetPasswordRequest defines model for ResetPasswordRequest.
+type ResetPasswordRequest struct {
+	// Password Новый пароль.
+	Password string `json:"password"`
+
+	// Token Токен из письма.
+	Token string `json:"token"`
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+// RestockRequest defines model for RestockRequest.
+type RestockRequest struct {
+	// Quantity Сколько предметов добавить в запас.
+	Quantity int64 `json:"quantity"`
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &ClientWithResponses{client}, nil
+// RestockResponse defines model for RestockResponse.
+type RestockResponse struct {
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Notified Сколько пользователей получили уведомление.
+	Notified int64 `json:"notified"`
+
+	// Stock Запас после пополнения.
+	Stock int64 `json:"stock"`
+
+	// Variant Вариант предмета.
+	Variant string `json:"variant"`
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
+// SendCoinRequest defines model for SendCoinRequest.
+type SendCoinRequest struct {
+	// Amount Количество монет, которые необходимо отправить.
+	Amount int `json:"amount"`
+
+	// ToUser Имя пользователя, которому нужно отправить монеты.
+	ToUser string `json:"toUser"`
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostApiAuthWithBodyWithResponse request with any body
-	PostApiAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error)
+// Transfer defines model for Transfer.
+type Transfer struct {
+	// Amount Количество монет.
+	Amount int64 `json:"amount"`
 
-	PostApiAuthWithResponse(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error)
+	// CreatedAt Время перевода. У переводов, сделанных до появления v2, - время обновления сервиса.
+	CreatedAt time.Time `json:"createdAt"`
 
-	// GetApiBuyItemWithResponse request
-	GetApiBuyItemWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*GetApiBuyItemResponse, error)
+	// FromUser Имя пользователя, который отправил монеты.
+	FromUser string `json:"fromUser"`
 
-	// GetApiInfoWithResponse request
-	GetApiInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiInfoResponse, error)
+	// Id Идентификатор перевода.
+	Id int64 `json:"id"`
 
-	// PostApiSendCoinWithBodyWithResponse request with any body
-	PostApiSendCoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error)
+	// ToUser Имя пользователя, который получил монеты.
+	ToUser string `json:"toUser"`
+}
 
-	PostApiSendCoinWithResponse(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error)
+// TransferPage defines model for TransferPage.
+type TransferPage struct {
+	Items []Transfer `json:"items"`
+
+	// Limit Размер страницы.
+	Limit int32 `json:"limit"`
+
+	// Offset Сколько переводов пропущено.
+	Offset int32 `json:"offset"`
+
+	// Total Сколько всего переводов у пользователя.
+	Total int64 `json:"total"`
 }
 
-type PostApiAuthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
+// TwoFactorCodeRequest defines model for TwoFactorCodeRequest.
+type TwoFactorCodeRequest struct {
+	// Code Шестизначный код из приложения-аутентификатора, для отключения подходит и резервный код.
+	Code string `json:"code"`
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiAuthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// TwoFactorEnrollment defines model for TwoFactorEnrollment.
+type TwoFactorEnrollment struct {
+	// Secret Секрет в base32 для ручного ввода в приложение.
+	Secret string `json:"secret"`
+
+	// Uri otpauth:// URI для QR-кода.
+	Uri string `json:"uri"`
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiAuthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// TwoFactorRecoveryCodes defines model for TwoFactorRecoveryCodes.
+type TwoFactorRecoveryCodes struct {
+	// RecoveryCodes Одноразовые резервные коды на случай потери приложения.
+	RecoveryCodes []string `json:"recoveryCodes"`
 }
 
-type GetApiBuyItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
+// TwoFactorStatus defines model for TwoFactorStatus.
+type TwoFactorStatus struct {
+	// Enabled Вход требует код.
+	Enabled bool `json:"enabled"`
+
+	// RecoveryCodesLeft Количество неиспользованных резервных кодов.
+	RecoveryCodesLeft int64 `json:"recoveryCodesLeft"`
+
+	// Required Двухфакторная аутентификация обязательна для пользователя - он администратор.
+	Required bool `json:"required"`
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiBuyItemResponse) Status() string {
+// User defines model for User.
+type User struct {
+	// Coins Количество доступных монет.
+	Coins     int64           `json:"coins"`
+	Inventory []InventoryItem `json:"inventory"`
+
+	// Name Имя пользователя.
+	Name string `json:"name"`
+}
+
+// VerifyEmailRequest defines model for VerifyEmailRequest.
+type VerifyEmailRequest struct {
+	// Token Токен из письма.
+	Token string `json:"token"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts Сколько попыток сделано.
+	Attempts int32 `json:"attempts"`
+
+	// CreatedAt Время создания доставки.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeliveredAt Время успешной доставки.
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+
+	// EventId Идентификатор события.
+	EventId int64 `json:"eventId"`
+
+	// EventType Тип события.
+	EventType WebhookEventType `json:"eventType"`
+
+	// Id Идентификатор доставки.
+	Id int64 `json:"id"`
+
+	// LastError Ошибка последней попытки.
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastStatusCode HTTP-статус последнего ответа получателя.
+	LastStatusCode *int32 `json:"lastStatusCode,omitempty"`
+
+	// NextAttemptAt Время следующей попытки для статуса pending.
+	NextAttemptAt time.Time `json:"nextAttemptAt"`
+
+	// Status Статус доставки - ожидает отправки, доставлено или попытки исчерпаны.
+	Status WebhookDeliveryStatus `json:"status"`
+
+	// SubscriptionId Идентификатор подписки.
+	SubscriptionId int64 `json:"subscriptionId"`
+}
+
+// WebhookDeliveryStatus Статус доставки - ожидает отправки, доставлено или попытки исчерпаны.
+type WebhookDeliveryStatus string
+
+// WebhookEventType Тип события.
+type WebhookEventType string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	// CreatedAt Время создания подписки.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Администратор, создавший подписку.
+	CreatedBy string             `json:"createdBy"`
+	Events    []WebhookEventType `json:"events"`
+
+	// Id Идентификатор подписки.
+	Id int64 `json:"id"`
+
+	// Secret Секрет для проверки подписи, возвращается только при создании подписки.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Адрес, на который доставляются события.
+	Url string `json:"url"`
+}
+
+// Wishlist defines model for Wishlist.
+type Wishlist struct {
+	// Coins Текущий баланс пользователя.
+	Coins int64          `json:"coins"`
+	Items []WishlistItem `json:"items"`
+}
+
+// WishlistItem defines model for WishlistItem.
+type WishlistItem struct {
+	// CreatedAt Время добавления в список.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// InStock Есть ли предмет в наличии.
+	InStock bool `json:"inStock"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// MissingCoins Сколько монет не хватает для покупки, 0 если хватает.
+	MissingCoins int64 `json:"missingCoins"`
+
+	// Price Текущая цена предмета с учетом распродаж.
+	Price int64 `json:"price"`
+
+	// Variant Вариант предмета. Если не указан - подходит любой вариант.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// MerchCategoryFilter defines model for MerchCategoryFilter.
+type MerchCategoryFilter = string
+
+// MerchMaxPriceFilter defines model for MerchMaxPriceFilter.
+type MerchMaxPriceFilter = int64
+
+// MerchMinPriceFilter defines model for MerchMinPriceFilter.
+type MerchMinPriceFilter = int64
+
+// MerchSearchQuery defines model for MerchSearchQuery.
+type MerchSearchQuery = string
+
+// MerchSortOrder Сортировка каталога - по названию, по возрастанию или убыванию цены.
+type MerchSortOrder = MerchSort
+
+// PageLimit defines model for PageLimit.
+type PageLimit = int32
+
+// PageOffset defines model for PageOffset.
+type PageOffset = int32
+
+// GetApiAdminOrdersParams defines parameters for GetApiAdminOrders.
+type GetApiAdminOrdersParams struct {
+	// Status Статус заказов, по умолчанию placed.
+	Status *OrderStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetApiAdminRefundsParams defines parameters for GetApiAdminRefunds.
+type GetApiAdminRefundsParams struct {
+	// Status Статус запросов на возврат, по умолчанию requested.
+	Status *RefundStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetApiAdminWebhooksIdDeliveriesParams defines parameters for GetApiAdminWebhooksIdDeliveries.
+type GetApiAdminWebhooksIdDeliveriesParams struct {
+	// Status Статус доставок, по умолчанию все.
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 20.
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько элементов пропустить.
+	Offset *PageOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetApiBuyItemParams defines parameters for GetApiBuyItem.
+type GetApiBuyItemParams struct {
+	// Variant Вариант предмета (например, размер). Если не указан - покупается предмет без варианта.
+	Variant *string `form:"variant,omitempty" json:"variant,omitempty"`
+
+	// PromoCode Промокод на скидку.
+	PromoCode *string `form:"promoCode,omitempty" json:"promoCode,omitempty"`
+}
+
+// GetApiMarketListingsParams defines parameters for GetApiMarketListings.
+type GetApiMarketListingsParams struct {
+	// Item Тип предмета. Если не указан - возвращаются объявления по всем предметам.
+	Item *string `form:"item,omitempty" json:"item,omitempty"`
+}
+
+// DeleteApiMeWishlistItemParams defines parameters for DeleteApiMeWishlistItem.
+type DeleteApiMeWishlistItemParams struct {
+	// Variant Вариант предмета.
+	Variant *string `form:"variant,omitempty" json:"variant,omitempty"`
+}
+
+// GetApiMerchParams defines parameters for GetApiMerch.
+type GetApiMerchParams struct {
+	// Category Категория предметов.
+	Category *MerchCategoryFilter `form:"category,omitempty" json:"category,omitempty"`
+
+	// MinPrice Минимальная цена.
+	MinPrice *MerchMinPriceFilter `form:"minPrice,omitempty" json:"minPrice,omitempty"`
+
+	// MaxPrice Максимальная цена.
+	MaxPrice *MerchMaxPriceFilter `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
+
+	// Q Текст для поиска по идентификатору, названию и описанию предмета.
+	Q *MerchSearchQuery `form:"q,omitempty" json:"q,omitempty"`
+
+	// Sort Сортировка - по названию, по возрастанию или убыванию цены. По умолчанию по идентификатору.
+	Sort *MerchSortOrder `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 20.
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько элементов пропустить.
+	Offset *PageOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetApiNotificationsParams defines parameters for GetApiNotifications.
+type GetApiNotificationsParams struct {
+	// Unread Только непрочитанные уведомления.
+	Unread *bool `form:"unread,omitempty" json:"unread,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 20.
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько элементов пропустить.
+	Offset *PageOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PostApiRefundItemParams defines parameters for PostApiRefundItem.
+type PostApiRefundItemParams struct {
+	// Variant Вариант возвращаемого предмета.
+	Variant *string `form:"variant,omitempty" json:"variant,omitempty"`
+}
+
+// GetApiV2MerchParams defines parameters for GetApiV2Merch.
+type GetApiV2MerchParams struct {
+	// Category Категория предметов.
+	Category *MerchCategoryFilter `form:"category,omitempty" json:"category,omitempty"`
+
+	// MinPrice Минимальная цена.
+	MinPrice *MerchMinPriceFilter `form:"minPrice,omitempty" json:"minPrice,omitempty"`
+
+	// MaxPrice Максимальная цена.
+	MaxPrice *MerchMaxPriceFilter `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
+
+	// Q Текст для поиска по идентификатору, названию и описанию предмета.
+	Q *MerchSearchQuery `form:"q,omitempty" json:"q,omitempty"`
+
+	// Sort Сортировка - по названию, по возрастанию или убыванию цены. По умолчанию по идентификатору.
+	Sort *MerchSortOrder `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 20.
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько элементов пропустить.
+	Offset *PageOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetApiV2UsersMeTransactionsParams defines parameters for GetApiV2UsersMeTransactions.
+type GetApiV2UsersMeTransactionsParams struct {
+	// Limit Размер страницы, по умолчанию 20.
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько элементов пропустить.
+	Offset *PageOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PostApiAdminAuctionsJSONRequestBody defines body for PostApiAdminAuctions for application/json ContentType.
+type PostApiAdminAuctionsJSONRequestBody = CreateAuctionRequest
+
+// PostApiAdminBundlesJSONRequestBody defines body for PostApiAdminBundles for application/json ContentType.
+type PostApiAdminBundlesJSONRequestBody = Bundle
+
+// PostApiAdminMerchItemVariantsVariantRestockJSONRequestBody defines body for PostApiAdminMerchItemVariantsVariantRestock for application/json ContentType.
+type PostApiAdminMerchItemVariantsVariantRestockJSONRequestBody = RestockRequest
+
+// PostApiAdminOrdersIdHandoverJSONRequestBody defines body for PostApiAdminOrdersIdHandover for application/json ContentType.
+type PostApiAdminOrdersIdHandoverJSONRequestBody = HandOverOrderRequest
+
+// PostApiAdminPromotionsJSONRequestBody defines body for PostApiAdminPromotions for application/json ContentType.
+type PostApiAdminPromotionsJSONRequestBody = CreatePromotionRequest
+
+// PostApiAdminRafflesJSONRequestBody defines body for PostApiAdminRaffles for application/json ContentType.
+type PostApiAdminRafflesJSONRequestBody = CreateRaffleRequest
+
+// PostApiAdminWebhooksJSONRequestBody defines body for PostApiAdminWebhooks for application/json ContentType.
+type PostApiAdminWebhooksJSONRequestBody = CreateWebhookSubscriptionRequest
+
+// PostApiAuctionsIdBidsJSONRequestBody defines body for PostApiAuctionsIdBids for application/json ContentType.
+type PostApiAuctionsIdBidsJSONRequestBody = PlaceBidRequest
+
+// PostApiAuthJSONRequestBody defines body for PostApiAuth for application/json ContentType.
+type PostApiAuthJSONRequestBody = AuthRequest
+
+// PostApiAuth2faConfirmJSONRequestBody defines body for PostApiAuth2faConfirm for application/json ContentType.
+type PostApiAuth2faConfirmJSONRequestBody = TwoFactorCodeRequest
+
+// PostApiAuth2faDisableJSONRequestBody defines body for PostApiAuth2faDisable for application/json ContentType.
+type PostApiAuth2faDisableJSONRequestBody = TwoFactorCodeRequest
+
+// PostApiAuthForgotJSONRequestBody defines body for PostApiAuthForgot for application/json ContentType.
+type PostApiAuthForgotJSONRequestBody = ForgotPasswordRequest
+
+// PostApiAuthOtpJSONRequestBody defines body for PostApiAuthOtp for application/json ContentType.
+type PostApiAuthOtpJSONRequestBody = LoginOTPRequest
+
+// PostApiAuthResetJSONRequestBody defines body for PostApiAuthReset for application/json ContentType.
+type PostApiAuthResetJSONRequestBody = ResetPasswordRequest
+
+// PostApiAuthVerifyJSONRequestBody defines body for PostApiAuthVerify for application/json ContentType.
+type PostApiAuthVerifyJSONRequestBody = VerifyEmailRequest
+
+// PostApiBuyItemJSONRequestBody defines body for PostApiBuyItem for application/json ContentType.
+type PostApiBuyItemJSONRequestBody = BuyMerchRequest
+
+// PostApiMarketListingsJSONRequestBody defines body for PostApiMarketListings for application/json ContentType.
+type PostApiMarketListingsJSONRequestBody = CreateListingRequest
+
+// PostApiMarketListingsIdBuyJSONRequestBody defines body for PostApiMarketListingsIdBuy for application/json ContentType.
+type PostApiMarketListingsIdBuyJSONRequestBody = BuyListingRequest
+
+// PostApiMeWishlistJSONRequestBody defines body for PostApiMeWishlist for application/json ContentType.
+type PostApiMeWishlistJSONRequestBody = AddWishlistItemRequest
+
+// PutApiNotificationsPreferencesJSONRequestBody defines body for PutApiNotificationsPreferences for application/json ContentType.
+type PutApiNotificationsPreferencesJSONRequestBody = NotificationPreferences
+
+// PostApiRafflesIdTicketsJSONRequestBody defines body for PostApiRafflesIdTickets for application/json ContentType.
+type PostApiRafflesIdTicketsJSONRequestBody = BuyRaffleTicketsRequest
+
+// PostApiSendCoinJSONRequestBody defines body for PostApiSendCoin for application/json ContentType.
+type PostApiSendCoinJSONRequestBody = SendCoinRequest
+
+// PostApiV2OrdersJSONRequestBody defines body for PostApiV2Orders for application/json ContentType.
+type PostApiV2OrdersJSONRequestBody = CreateOrderRequest
+
+// PostApiV2TransfersJSONRequestBody defines body for PostApiV2Transfers for application/json ContentType.
+type PostApiV2TransfersJSONRequestBody = SendCoinRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// PostApiAdminAuctionsWithBody request with any body
+	PostApiAdminAuctionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminAuctions(ctx context.Context, body PostApiAdminAuctionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminBundlesWithBody request with any body
+	PostApiAdminBundlesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminBundles(ctx context.Context, body PostApiAdminBundlesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminMerchItemVariantsVariantRestockWithBody request with any body
+	PostApiAdminMerchItemVariantsVariantRestockWithBody(ctx context.Context, item string, variant string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminMerchItemVariantsVariantRestock(ctx context.Context, item string, variant string, body PostApiAdminMerchItemVariantsVariantRestockJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminOrders request
+	GetApiAdminOrders(ctx context.Context, params *GetApiAdminOrdersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminOrdersIdCancel request
+	PostApiAdminOrdersIdCancel(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminOrdersIdHandoverWithBody request with any body
+	PostApiAdminOrdersIdHandoverWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminOrdersIdHandover(ctx context.Context, id int64, body PostApiAdminOrdersIdHandoverJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminOrdersIdReady request
+	PostApiAdminOrdersIdReady(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminPromotions request
+	GetApiAdminPromotions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminPromotionsWithBody request with any body
+	PostApiAdminPromotionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminPromotions(ctx context.Context, body PostApiAdminPromotionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminRafflesWithBody request with any body
+	PostApiAdminRafflesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminRaffles(ctx context.Context, body PostApiAdminRafflesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminRafflesIdDraw request
+	PostApiAdminRafflesIdDraw(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminRefunds request
+	GetApiAdminRefunds(ctx context.Context, params *GetApiAdminRefundsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminRefundsIdApprove request
+	PostApiAdminRefundsIdApprove(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminRefundsIdReject request
+	PostApiAdminRefundsIdReject(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminWebhookDeliveriesIdRedeliver request
+	PostApiAdminWebhookDeliveriesIdRedeliver(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminWebhooks request
+	GetApiAdminWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminWebhooksWithBody request with any body
+	PostApiAdminWebhooksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminWebhooks(ctx context.Context, body PostApiAdminWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiAdminWebhooksId request
+	DeleteApiAdminWebhooksId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminWebhooksIdDeliveries request
+	GetApiAdminWebhooksIdDeliveries(ctx context.Context, id int64, params *GetApiAdminWebhooksIdDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAuctions request
+	GetApiAuctions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAuctionsId request
+	GetApiAuctionsId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuctionsIdBidsWithBody request with any body
+	PostApiAuctionsIdBidsWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAuctionsIdBids(ctx context.Context, id int64, body PostApiAuctionsIdBidsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuthWithBody request with any body
+	PostApiAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAuth(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAuth2fa request
+	GetApiAuth2fa(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuth2faConfirmWithBody request with any body
+	PostApiAuth2faConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAuth2faConfirm(ctx context.Context, body PostApiAuth2faConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuth2faDisableWithBody request with any body
+	PostApiAuth2faDisableWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAuth2faDisable(ctx context.Context, body PostApiAuth2faDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuth2faEnroll request
+	PostApiAuth2faEnroll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuthForgotWithBody request with any body
+	PostApiAuthForgotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAuthForgot(ctx context.Context, body PostApiAuthForgotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuthOtpWithBody request with any body
+	PostApiAuthOtpWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAuthOtp(ctx context.Context, body PostApiAuthOtpJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuthResetWithBody request with any body
+	PostApiAuthResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAuthReset(ctx context.Context, body PostApiAuthResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuthVerifyWithBody request with any body
+	PostApiAuthVerifyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAuthVerify(ctx context.Context, body PostApiAuthVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuthVerifySend request
+	PostApiAuthVerifySend(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiBundles request
+	GetApiBundles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiBundlesBundleBuy request
+	PostApiBundlesBundleBuy(ctx context.Context, bundle string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiBuyItem request
+	GetApiBuyItem(ctx context.Context, item string, params *GetApiBuyItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiBuyItemWithBody request with any body
+	PostApiBuyItemWithBody(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiBuyItem(ctx context.Context, item string, body PostApiBuyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiInfo request
+	GetApiInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMarketListings request
+	GetApiMarketListings(ctx context.Context, params *GetApiMarketListingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiMarketListingsWithBody request with any body
+	PostApiMarketListingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiMarketListings(ctx context.Context, body PostApiMarketListingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiMarketListingsIdBuyWithBody request with any body
+	PostApiMarketListingsIdBuyWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiMarketListingsIdBuy(ctx context.Context, id int64, body PostApiMarketListingsIdBuyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiMarketListingsIdCancel request
+	PostApiMarketListingsIdCancel(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMeWishlist request
+	GetApiMeWishlist(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiMeWishlistWithBody request with any body
+	PostApiMeWishlistWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiMeWishlist(ctx context.Context, body PostApiMeWishlistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiMeWishlistItem request
+	DeleteApiMeWishlistItem(ctx context.Context, item string, params *DeleteApiMeWishlistItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMerch request
+	GetApiMerch(ctx context.Context, params *GetApiMerchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiMerchCategories request
+	GetApiMerchCategories(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiNotifications request
+	GetApiNotifications(ctx context.Context, params *GetApiNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiNotificationsPreferences request
+	GetApiNotificationsPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiNotificationsPreferencesWithBody request with any body
+	PutApiNotificationsPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiNotificationsPreferences(ctx context.Context, body PutApiNotificationsPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiNotificationsReadAll request
+	PostApiNotificationsReadAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiNotificationsIdRead request
+	PostApiNotificationsIdRead(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiOrders request
+	GetApiOrders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiOrdersIdCancel request
+	PostApiOrdersIdCancel(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiRaffles request
+	GetApiRaffles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiRafflesId request
+	GetApiRafflesId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiRafflesIdTickets request
+	GetApiRafflesIdTickets(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiRafflesIdTicketsWithBody request with any body
+	PostApiRafflesIdTicketsWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiRafflesIdTickets(ctx context.Context, id int64, body PostApiRafflesIdTicketsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiRefundItem request
+	PostApiRefundItem(ctx context.Context, item string, params *PostApiRefundItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiSendCoinWithBody request with any body
+	PostApiSendCoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiSendCoin(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV2Merch request
+	GetApiV2Merch(ctx context.Context, params *GetApiV2MerchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV2OrdersWithBody request with any body
+	PostApiV2OrdersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV2Orders(ctx context.Context, body PostApiV2OrdersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV2OrdersId request
+	GetApiV2OrdersId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV2TransfersWithBody request with any body
+	PostApiV2TransfersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV2Transfers(ctx context.Context, body PostApiV2TransfersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV2TransfersId request
+	GetApiV2TransfersId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV2UsersMe request
+	GetApiV2UsersMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV2UsersMeTransactions request
+	GetApiV2UsersMeTransactions(ctx context.Context, params *GetApiV2UsersMeTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) PostApiAdminAuctionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminAuctionsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminAuctions(ctx context.Context, body PostApiAdminAuctionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminAuctionsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminBundlesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminBundlesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminBundles(ctx context.Context, body PostApiAdminBundlesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminBundlesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminMerchItemVariantsVariantRestockWithBody(ctx context.Context, item string, variant string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminMerchItemVariantsVariantRestockRequestWithBody(c.Server, item, variant, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminMerchItemVariantsVariantRestock(ctx context.Context, item string, variant string, body PostApiAdminMerchItemVariantsVariantRestockJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminMerchItemVariantsVariantRestockRequest(c.Server, item, variant, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAdminOrders(ctx context.Context, params *GetApiAdminOrdersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminOrdersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminOrdersIdCancel(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminOrdersIdCancelRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminOrdersIdHandoverWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminOrdersIdHandoverRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminOrdersIdHandover(ctx context.Context, id int64, body PostApiAdminOrdersIdHandoverJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminOrdersIdHandoverRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminOrdersIdReady(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminOrdersIdReadyRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAdminPromotions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminPromotionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminPromotionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminPromotionsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminPromotions(ctx context.Context, body PostApiAdminPromotionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminPromotionsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminRafflesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminRafflesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminRaffles(ctx context.Context, body PostApiAdminRafflesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminRafflesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminRafflesIdDraw(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminRafflesIdDrawRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAdminRefunds(ctx context.Context, params *GetApiAdminRefundsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminRefundsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminRefundsIdApprove(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminRefundsIdApproveRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminRefundsIdReject(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminRefundsIdRejectRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminWebhookDeliveriesIdRedeliver(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminWebhookDeliveriesIdRedeliverRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAdminWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminWebhooksRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminWebhooksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminWebhooksRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminWebhooks(ctx context.Context, body PostApiAdminWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminWebhooksRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiAdminWebhooksId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiAdminWebhooksIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAdminWebhooksIdDeliveries(ctx context.Context, id int64, params *GetApiAdminWebhooksIdDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminWebhooksIdDeliveriesRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAuctions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAuctionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAuctionsId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAuctionsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuctionsIdBidsWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuctionsIdBidsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuctionsIdBids(ctx context.Context, id int64, body PostApiAuctionsIdBidsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuctionsIdBidsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuth(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAuth2fa(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAuth2faRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuth2faConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuth2faConfirmRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuth2faConfirm(ctx context.Context, body PostApiAuth2faConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuth2faConfirmRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuth2faDisableWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuth2faDisableRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuth2faDisable(ctx context.Context, body PostApiAuth2faDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuth2faDisableRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuth2faEnroll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuth2faEnrollRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthForgotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthForgotRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthForgot(ctx context.Context, body PostApiAuthForgotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthForgotRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthOtpWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthOtpRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthOtp(ctx context.Context, body PostApiAuthOtpJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthOtpRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthResetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthReset(ctx context.Context, body PostApiAuthResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthResetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthVerifyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthVerifyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthVerify(ctx context.Context, body PostApiAuthVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthVerifyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthVerifySend(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthVerifySendRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiBundles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiBundlesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiBundlesBundleBuy(ctx context.Context, bundle string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiBundlesBundleBuyRequest(c.Server, bundle)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiBuyItem(ctx context.Context, item string, params *GetApiBuyItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiBuyItemRequest(c.Server, item, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiBuyItemWithBody(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiBuyItemRequestWithBody(c.Server, item, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiBuyItem(ctx context.Context, item string, body PostApiBuyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiBuyItemRequest(c.Server, item, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiInfoRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMarketListings(ctx context.Context, params *GetApiMarketListingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMarketListingsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMarketListingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMarketListingsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMarketListings(ctx context.Context, body PostApiMarketListingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMarketListingsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMarketListingsIdBuyWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMarketListingsIdBuyRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMarketListingsIdBuy(ctx context.Context, id int64, body PostApiMarketListingsIdBuyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMarketListingsIdBuyRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMarketListingsIdCancel(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMarketListingsIdCancelRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMeWishlist(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMeWishlistRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMeWishlistWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMeWishlistRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMeWishlist(ctx context.Context, body PostApiMeWishlistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMeWishlistRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiMeWishlistItem(ctx context.Context, item string, params *DeleteApiMeWishlistItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiMeWishlistItemRequest(c.Server, item, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMerch(ctx context.Context, params *GetApiMerchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMerchRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiMerchCategories(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiMerchCategoriesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiNotifications(ctx context.Context, params *GetApiNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiNotificationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiNotificationsPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiNotificationsPreferencesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiNotificationsPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiNotificationsPreferencesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiNotificationsPreferences(ctx context.Context, body PutApiNotificationsPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiNotificationsPreferencesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiNotificationsReadAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiNotificationsReadAllRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiNotificationsIdRead(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiNotificationsIdReadRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiOrders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiOrdersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOrdersIdCancel(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOrdersIdCancelRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiRaffles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiRafflesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiRafflesId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiRafflesIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiRafflesIdTickets(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiRafflesIdTicketsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiRafflesIdTicketsWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiRafflesIdTicketsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiRafflesIdTickets(ctx context.Context, id int64, body PostApiRafflesIdTicketsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiRafflesIdTicketsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiRefundItem(ctx context.Context, item string, params *PostApiRefundItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiRefundItemRequest(c.Server, item, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSendCoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSendCoinRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSendCoin(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSendCoinRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV2Merch(ctx context.Context, params *GetApiV2MerchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV2MerchRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV2OrdersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV2OrdersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV2Orders(ctx context.Context, body PostApiV2OrdersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV2OrdersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV2OrdersId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV2OrdersIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV2TransfersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV2TransfersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV2Transfers(ctx context.Context, body PostApiV2TransfersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV2TransfersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV2TransfersId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV2TransfersIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV2UsersMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV2UsersMeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV2UsersMeTransactions(ctx context.Context, params *GetApiV2UsersMeTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV2UsersMeTransactionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewPostApiAdminAuctionsRequest calls the generic PostApiAdminAuctions builder with application/json body
+func NewPostApiAdminAuctionsRequest(server string, body PostApiAdminAuctionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminAuctionsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAdminAuctionsRequestWithBody generates requests for PostApiAdminAuctions with any type of body
+func NewPostApiAdminAuctionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/auctions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAdminBundlesRequest calls the generic PostApiAdminBundles builder with application/json body
+func NewPostApiAdminBundlesRequest(server string, body PostApiAdminBundlesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminBundlesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAdminBundlesRequestWithBody generates requests for PostApiAdminBundles with any type of body
+func NewPostApiAdminBundlesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/bundles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAdminMerchItemVariantsVariantRestockRequest calls the generic PostApiAdminMerchItemVariantsVariantRestock builder with application/json body
+func NewPostApiAdminMerchItemVariantsVariantRestockRequest(server string, item string, variant string, body PostApiAdminMerchItemVariantsVariantRestockJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminMerchItemVariantsVariantRestockRequestWithBody(server, item, variant, "application/json", bodyReader)
+}
+
+// NewPostApiAdminMerchItemVariantsVariantRestockRequestWithBody generates requests for PostApiAdminMerchItemVariantsVariantRestock with any type of body
+func NewPostApiAdminMerchItemVariantsVariantRestockRequestWithBody(server string, item string, variant string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "variant", runtime.ParamLocationPath, variant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/merch/%s/variants/%s/restock", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiAdminOrdersRequest generates requests for GetApiAdminOrders
+func NewGetApiAdminOrdersRequest(server string, params *GetApiAdminOrdersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/orders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminOrdersIdCancelRequest generates requests for PostApiAdminOrdersIdCancel
+func NewPostApiAdminOrdersIdCancelRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/orders/%s/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminOrdersIdHandoverRequest calls the generic PostApiAdminOrdersIdHandover builder with application/json body
+func NewPostApiAdminOrdersIdHandoverRequest(server string, id int64, body PostApiAdminOrdersIdHandoverJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminOrdersIdHandoverRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiAdminOrdersIdHandoverRequestWithBody generates requests for PostApiAdminOrdersIdHandover with any type of body
+func NewPostApiAdminOrdersIdHandoverRequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/orders/%s/handover", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAdminOrdersIdReadyRequest generates requests for PostApiAdminOrdersIdReady
+func NewPostApiAdminOrdersIdReadyRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/orders/%s/ready", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAdminPromotionsRequest generates requests for GetApiAdminPromotions
+func NewGetApiAdminPromotionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/promotions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminPromotionsRequest calls the generic PostApiAdminPromotions builder with application/json body
+func NewPostApiAdminPromotionsRequest(server string, body PostApiAdminPromotionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminPromotionsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAdminPromotionsRequestWithBody generates requests for PostApiAdminPromotions with any type of body
+func NewPostApiAdminPromotionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/promotions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAdminRafflesRequest calls the generic PostApiAdminRaffles builder with application/json body
+func NewPostApiAdminRafflesRequest(server string, body PostApiAdminRafflesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminRafflesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAdminRafflesRequestWithBody generates requests for PostApiAdminRaffles with any type of body
+func NewPostApiAdminRafflesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/raffles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAdminRafflesIdDrawRequest generates requests for PostApiAdminRafflesIdDraw
+func NewPostApiAdminRafflesIdDrawRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/raffles/%s/draw", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAdminRefundsRequest generates requests for GetApiAdminRefunds
+func NewGetApiAdminRefundsRequest(server string, params *GetApiAdminRefundsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/refunds")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminRefundsIdApproveRequest generates requests for PostApiAdminRefundsIdApprove
+func NewPostApiAdminRefundsIdApproveRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/refunds/%s/approve", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminRefundsIdRejectRequest generates requests for PostApiAdminRefundsIdReject
+func NewPostApiAdminRefundsIdRejectRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/refunds/%s/reject", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminWebhookDeliveriesIdRedeliverRequest generates requests for PostApiAdminWebhookDeliveriesIdRedeliver
+func NewPostApiAdminWebhookDeliveriesIdRedeliverRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/webhook-deliveries/%s/redeliver", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAdminWebhooksRequest generates requests for GetApiAdminWebhooks
+func NewGetApiAdminWebhooksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminWebhooksRequest calls the generic PostApiAdminWebhooks builder with application/json body
+func NewPostApiAdminWebhooksRequest(server string, body PostApiAdminWebhooksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminWebhooksRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAdminWebhooksRequestWithBody generates requests for PostApiAdminWebhooks with any type of body
+func NewPostApiAdminWebhooksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiAdminWebhooksIdRequest generates requests for DeleteApiAdminWebhooksId
+func NewDeleteApiAdminWebhooksIdRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAdminWebhooksIdDeliveriesRequest generates requests for GetApiAdminWebhooksIdDeliveries
+func NewGetApiAdminWebhooksIdDeliveriesRequest(server string, id int64, params *GetApiAdminWebhooksIdDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/webhooks/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAuctionsRequest generates requests for GetApiAuctions
+func NewGetApiAuctionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auctions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAuctionsIdRequest generates requests for GetApiAuctionsId
+func NewGetApiAuctionsIdRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auctions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAuctionsIdBidsRequest calls the generic PostApiAuctionsIdBids builder with application/json body
+func NewPostApiAuctionsIdBidsRequest(server string, id int64, body PostApiAuctionsIdBidsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuctionsIdBidsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiAuctionsIdBidsRequestWithBody generates requests for PostApiAuctionsIdBids with any type of body
+func NewPostApiAuctionsIdBidsRequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auctions/%s/bids", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAuthRequest calls the generic PostApiAuth builder with application/json body
+func NewPostApiAuthRequest(server string, body PostApiAuthJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuthRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAuthRequestWithBody generates requests for PostApiAuth with any type of body
+func NewPostApiAuthRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiAuth2faRequest generates requests for GetApiAuth2fa
+func NewGetApiAuth2faRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/2fa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAuth2faConfirmRequest calls the generic PostApiAuth2faConfirm builder with application/json body
+func NewPostApiAuth2faConfirmRequest(server string, body PostApiAuth2faConfirmJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuth2faConfirmRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAuth2faConfirmRequestWithBody generates requests for PostApiAuth2faConfirm with any type of body
+func NewPostApiAuth2faConfirmRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/2fa/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAuth2faDisableRequest calls the generic PostApiAuth2faDisable builder with application/json body
+func NewPostApiAuth2faDisableRequest(server string, body PostApiAuth2faDisableJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuth2faDisableRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAuth2faDisableRequestWithBody generates requests for PostApiAuth2faDisable with any type of body
+func NewPostApiAuth2faDisableRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/2fa/disable")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAuth2faEnrollRequest generates requests for PostApiAuth2faEnroll
+func NewPostApiAuth2faEnrollRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/2fa/enroll")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAuthForgotRequest calls the generic PostApiAuthForgot builder with application/json body
+func NewPostApiAuthForgotRequest(server string, body PostApiAuthForgotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuthForgotRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAuthForgotRequestWithBody generates requests for PostApiAuthForgot with any type of body
+func NewPostApiAuthForgotRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/forgot")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAuthOtpRequest calls the generic PostApiAuthOtp builder with application/json body
+func NewPostApiAuthOtpRequest(server string, body PostApiAuthOtpJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuthOtpRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAuthOtpRequestWithBody generates requests for PostApiAuthOtp with any type of body
+func NewPostApiAuthOtpRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/otp")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAuthResetRequest calls the generic PostApiAuthReset builder with application/json body
+func NewPostApiAuthResetRequest(server string, body PostApiAuthResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuthResetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAuthResetRequestWithBody generates requests for PostApiAuthReset with any type of body
+func NewPostApiAuthResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/reset")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAuthVerifyRequest calls the generic PostApiAuthVerify builder with application/json body
+func NewPostApiAuthVerifyRequest(server string, body PostApiAuthVerifyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuthVerifyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAuthVerifyRequestWithBody generates requests for PostApiAuthVerify with any type of body
+func NewPostApiAuthVerifyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAuthVerifySendRequest generates requests for PostApiAuthVerifySend
+func NewPostApiAuthVerifySendRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/verify/send")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiBundlesRequest generates requests for GetApiBundles
+func NewGetApiBundlesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/bundles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiBundlesBundleBuyRequest generates requests for PostApiBundlesBundleBuy
+func NewPostApiBundlesBundleBuyRequest(server string, bundle string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bundle", runtime.ParamLocationPath, bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/bundles/%s/buy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiBuyItemRequest generates requests for GetApiBuyItem
+func NewGetApiBuyItemRequest(server string, item string, params *GetApiBuyItemParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buy/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Variant != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "variant", runtime.ParamLocationQuery, *params.Variant); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PromoCode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "promoCode", runtime.ParamLocationQuery, *params.PromoCode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiBuyItemRequest calls the generic PostApiBuyItem builder with application/json body
+func NewPostApiBuyItemRequest(server string, item string, body PostApiBuyItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiBuyItemRequestWithBody(server, item, "application/json", bodyReader)
+}
+
+// NewPostApiBuyItemRequestWithBody generates requests for PostApiBuyItem with any type of body
+func NewPostApiBuyItemRequestWithBody(server string, item string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buy/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiInfoRequest generates requests for GetApiInfo
+func NewGetApiInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/info")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiMarketListingsRequest generates requests for GetApiMarketListings
+func NewGetApiMarketListingsRequest(server string, params *GetApiMarketListingsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/market/listings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Item != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "item", runtime.ParamLocationQuery, *params.Item); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiMarketListingsRequest calls the generic PostApiMarketListings builder with application/json body
+func NewPostApiMarketListingsRequest(server string, body PostApiMarketListingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiMarketListingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiMarketListingsRequestWithBody generates requests for PostApiMarketListings with any type of body
+func NewPostApiMarketListingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/market/listings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiMarketListingsIdBuyRequest calls the generic PostApiMarketListingsIdBuy builder with application/json body
+func NewPostApiMarketListingsIdBuyRequest(server string, id int64, body PostApiMarketListingsIdBuyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiMarketListingsIdBuyRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiMarketListingsIdBuyRequestWithBody generates requests for PostApiMarketListingsIdBuy with any type of body
+func NewPostApiMarketListingsIdBuyRequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/market/listings/%s/buy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiMarketListingsIdCancelRequest generates requests for PostApiMarketListingsIdCancel
+func NewPostApiMarketListingsIdCancelRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/market/listings/%s/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiMeWishlistRequest generates requests for GetApiMeWishlist
+func NewGetApiMeWishlistRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/wishlist")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiMeWishlistRequest calls the generic PostApiMeWishlist builder with application/json body
+func NewPostApiMeWishlistRequest(server string, body PostApiMeWishlistJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiMeWishlistRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiMeWishlistRequestWithBody generates requests for PostApiMeWishlist with any type of body
+func NewPostApiMeWishlistRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/wishlist")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiMeWishlistItemRequest generates requests for DeleteApiMeWishlistItem
+func NewDeleteApiMeWishlistItemRequest(server string, item string, params *DeleteApiMeWishlistItemParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/me/wishlist/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Variant != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "variant", runtime.ParamLocationQuery, *params.Variant); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiMerchRequest generates requests for GetApiMerch
+func NewGetApiMerchRequest(server string, params *GetApiMerchParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/merch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Category != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category", runtime.ParamLocationQuery, *params.Category); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minPrice", runtime.ParamLocationQuery, *params.MinPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxPrice", runtime.ParamLocationQuery, *params.MaxPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiMerchCategoriesRequest generates requests for GetApiMerchCategories
+func NewGetApiMerchCategoriesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/merch/categories")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiNotificationsRequest generates requests for GetApiNotifications
+func NewGetApiNotificationsRequest(server string, params *GetApiNotificationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Unread != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unread", runtime.ParamLocationQuery, *params.Unread); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiNotificationsPreferencesRequest generates requests for GetApiNotificationsPreferences
+func NewGetApiNotificationsPreferencesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notifications/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiNotificationsPreferencesRequest calls the generic PutApiNotificationsPreferences builder with application/json body
+func NewPutApiNotificationsPreferencesRequest(server string, body PutApiNotificationsPreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiNotificationsPreferencesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutApiNotificationsPreferencesRequestWithBody generates requests for PutApiNotificationsPreferences with any type of body
+func NewPutApiNotificationsPreferencesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notifications/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiNotificationsReadAllRequest generates requests for PostApiNotificationsReadAll
+func NewPostApiNotificationsReadAllRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notifications/read-all")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiNotificationsIdReadRequest generates requests for PostApiNotificationsIdRead
+func NewPostApiNotificationsIdReadRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notifications/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiOrdersRequest generates requests for GetApiOrders
+func NewGetApiOrdersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/orders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiOrdersIdCancelRequest generates requests for PostApiOrdersIdCancel
+func NewPostApiOrdersIdCancelRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/orders/%s/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiRafflesRequest generates requests for GetApiRaffles
+func NewGetApiRafflesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/raffles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiRafflesIdRequest generates requests for GetApiRafflesId
+func NewGetApiRafflesIdRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/raffles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiRafflesIdTicketsRequest generates requests for GetApiRafflesIdTickets
+func NewGetApiRafflesIdTicketsRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/raffles/%s/tickets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiRafflesIdTicketsRequest calls the generic PostApiRafflesIdTickets builder with application/json body
+func NewPostApiRafflesIdTicketsRequest(server string, id int64, body PostApiRafflesIdTicketsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiRafflesIdTicketsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiRafflesIdTicketsRequestWithBody generates requests for PostApiRafflesIdTickets with any type of body
+func NewPostApiRafflesIdTicketsRequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/raffles/%s/tickets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiRefundItemRequest generates requests for PostApiRefundItem
+func NewPostApiRefundItemRequest(server string, item string, params *PostApiRefundItemParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/refund/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Variant != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "variant", runtime.ParamLocationQuery, *params.Variant); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiSendCoinRequest calls the generic PostApiSendCoin builder with application/json body
+func NewPostApiSendCoinRequest(server string, body PostApiSendCoinJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiSendCoinRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiSendCoinRequestWithBody generates requests for PostApiSendCoin with any type of body
+func NewPostApiSendCoinRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sendCoin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV2MerchRequest generates requests for GetApiV2Merch
+func NewGetApiV2MerchRequest(server string, params *GetApiV2MerchParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/merch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Category != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category", runtime.ParamLocationQuery, *params.Category); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minPrice", runtime.ParamLocationQuery, *params.MinPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxPrice", runtime.ParamLocationQuery, *params.MaxPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV2OrdersRequest calls the generic PostApiV2Orders builder with application/json body
+func NewPostApiV2OrdersRequest(server string, body PostApiV2OrdersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV2OrdersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV2OrdersRequestWithBody generates requests for PostApiV2Orders with any type of body
+func NewPostApiV2OrdersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/orders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV2OrdersIdRequest generates requests for GetApiV2OrdersId
+func NewGetApiV2OrdersIdRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/orders/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV2TransfersRequest calls the generic PostApiV2Transfers builder with application/json body
+func NewPostApiV2TransfersRequest(server string, body PostApiV2TransfersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV2TransfersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV2TransfersRequestWithBody generates requests for PostApiV2Transfers with any type of body
+func NewPostApiV2TransfersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/transfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV2TransfersIdRequest generates requests for GetApiV2TransfersId
+func NewGetApiV2TransfersIdRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/transfers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV2UsersMeRequest generates requests for GetApiV2UsersMe
+func NewGetApiV2UsersMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV2UsersMeTransactionsRequest generates requests for GetApiV2UsersMeTransactions
+func NewGetApiV2UsersMeTransactionsRequest(server string, params *GetApiV2UsersMeTransactionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/me/transactions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PostApiAdminAuctionsWithBodyWithResponse request with any body
+	PostApiAdminAuctionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminAuctionsResponse, error)
+
+	PostApiAdminAuctionsWithResponse(ctx context.Context, body PostApiAdminAuctionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminAuctionsResponse, error)
+
+	// PostApiAdminBundlesWithBodyWithResponse request with any body
+	PostApiAdminBundlesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminBundlesResponse, error)
+
+	PostApiAdminBundlesWithResponse(ctx context.Context, body PostApiAdminBundlesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminBundlesResponse, error)
+
+	// PostApiAdminMerchItemVariantsVariantRestockWithBodyWithResponse request with any body
+	PostApiAdminMerchItemVariantsVariantRestockWithBodyWithResponse(ctx context.Context, item string, variant string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminMerchItemVariantsVariantRestockResponse, error)
+
+	PostApiAdminMerchItemVariantsVariantRestockWithResponse(ctx context.Context, item string, variant string, body PostApiAdminMerchItemVariantsVariantRestockJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminMerchItemVariantsVariantRestockResponse, error)
+
+	// GetApiAdminOrdersWithResponse request
+	GetApiAdminOrdersWithResponse(ctx context.Context, params *GetApiAdminOrdersParams, reqEditors ...RequestEditorFn) (*GetApiAdminOrdersResponse, error)
+
+	// PostApiAdminOrdersIdCancelWithResponse request
+	PostApiAdminOrdersIdCancelWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiAdminOrdersIdCancelResponse, error)
+
+	// PostApiAdminOrdersIdHandoverWithBodyWithResponse request with any body
+	PostApiAdminOrdersIdHandoverWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminOrdersIdHandoverResponse, error)
+
+	PostApiAdminOrdersIdHandoverWithResponse(ctx context.Context, id int64, body PostApiAdminOrdersIdHandoverJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminOrdersIdHandoverResponse, error)
+
+	// PostApiAdminOrdersIdReadyWithResponse request
+	PostApiAdminOrdersIdReadyWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiAdminOrdersIdReadyResponse, error)
+
+	// GetApiAdminPromotionsWithResponse request
+	GetApiAdminPromotionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminPromotionsResponse, error)
+
+	// PostApiAdminPromotionsWithBodyWithResponse request with any body
+	PostApiAdminPromotionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminPromotionsResponse, error)
+
+	PostApiAdminPromotionsWithResponse(ctx context.Context, body PostApiAdminPromotionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminPromotionsResponse, error)
+
+	// PostApiAdminRafflesWithBodyWithResponse request with any body
+	PostApiAdminRafflesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminRafflesResponse, error)
+
+	PostApiAdminRafflesWithResponse(ctx context.Context, body PostApiAdminRafflesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminRafflesResponse, error)
+
+	// PostApiAdminRafflesIdDrawWithResponse request
+	PostApiAdminRafflesIdDrawWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiAdminRafflesIdDrawResponse, error)
+
+	// GetApiAdminRefundsWithResponse request
+	GetApiAdminRefundsWithResponse(ctx context.Context, params *GetApiAdminRefundsParams, reqEditors ...RequestEditorFn) (*GetApiAdminRefundsResponse, error)
+
+	// PostApiAdminRefundsIdApproveWithResponse request
+	PostApiAdminRefundsIdApproveWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiAdminRefundsIdApproveResponse, error)
+
+	// PostApiAdminRefundsIdRejectWithResponse request
+	PostApiAdminRefundsIdRejectWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiAdminRefundsIdRejectResponse, error)
+
+	// PostApiAdminWebhookDeliveriesIdRedeliverWithResponse request
+	PostApiAdminWebhookDeliveriesIdRedeliverWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiAdminWebhookDeliveriesIdRedeliverResponse, error)
+
+	// GetApiAdminWebhooksWithResponse request
+	GetApiAdminWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminWebhooksResponse, error)
+
+	// PostApiAdminWebhooksWithBodyWithResponse request with any body
+	PostApiAdminWebhooksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminWebhooksResponse, error)
+
+	PostApiAdminWebhooksWithResponse(ctx context.Context, body PostApiAdminWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminWebhooksResponse, error)
+
+	// DeleteApiAdminWebhooksIdWithResponse request
+	DeleteApiAdminWebhooksIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteApiAdminWebhooksIdResponse, error)
+
+	// GetApiAdminWebhooksIdDeliveriesWithResponse request
+	GetApiAdminWebhooksIdDeliveriesWithResponse(ctx context.Context, id int64, params *GetApiAdminWebhooksIdDeliveriesParams, reqEditors ...RequestEditorFn) (*GetApiAdminWebhooksIdDeliveriesResponse, error)
+
+	// GetApiAuctionsWithResponse request
+	GetApiAuctionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAuctionsResponse, error)
+
+	// GetApiAuctionsIdWithResponse request
+	GetApiAuctionsIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetApiAuctionsIdResponse, error)
+
+	// PostApiAuctionsIdBidsWithBodyWithResponse request with any body
+	PostApiAuctionsIdBidsWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuctionsIdBidsResponse, error)
+
+	PostApiAuctionsIdBidsWithResponse(ctx context.Context, id int64, body PostApiAuctionsIdBidsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuctionsIdBidsResponse, error)
+
+	// PostApiAuthWithBodyWithResponse request with any body
+	PostApiAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error)
+
+	PostApiAuthWithResponse(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error)
+
+	// GetApiAuth2faWithResponse request
+	GetApiAuth2faWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAuth2faResponse, error)
+
+	// PostApiAuth2faConfirmWithBodyWithResponse request with any body
+	PostApiAuth2faConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuth2faConfirmResponse, error)
+
+	PostApiAuth2faConfirmWithResponse(ctx context.Context, body PostApiAuth2faConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuth2faConfirmResponse, error)
+
+	// PostApiAuth2faDisableWithBodyWithResponse request with any body
+	PostApiAuth2faDisableWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuth2faDisableResponse, error)
+
+	PostApiAuth2faDisableWithResponse(ctx context.Context, body PostApiAuth2faDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuth2faDisableResponse, error)
+
+	// PostApiAuth2faEnrollWithResponse request
+	PostApiAuth2faEnrollWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiAuth2faEnrollResponse, error)
+
+	// PostApiAuthForgotWithBodyWithResponse request with any body
+	PostApiAuthForgotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthForgotResponse, error)
+
+	PostApiAuthForgotWithResponse(ctx context.Context, body PostApiAuthForgotJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthForgotResponse, error)
+
+	// PostApiAuthOtpWithBodyWithResponse request with any body
+	PostApiAuthOtpWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthOtpResponse, error)
+
+	PostApiAuthOtpWithResponse(ctx context.Context, body PostApiAuthOtpJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthOtpResponse, error)
+
+	// PostApiAuthResetWithBodyWithResponse request with any body
+	PostApiAuthResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthResetResponse, error)
+
+	PostApiAuthResetWithResponse(ctx context.Context, body PostApiAuthResetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthResetResponse, error)
+
+	// PostApiAuthVerifyWithBodyWithResponse request with any body
+	PostApiAuthVerifyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthVerifyResponse, error)
+
+	PostApiAuthVerifyWithResponse(ctx context.Context, body PostApiAuthVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthVerifyResponse, error)
+
+	// PostApiAuthVerifySendWithResponse request
+	PostApiAuthVerifySendWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiAuthVerifySendResponse, error)
+
+	// GetApiBundlesWithResponse request
+	GetApiBundlesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiBundlesResponse, error)
+
+	// PostApiBundlesBundleBuyWithResponse request
+	PostApiBundlesBundleBuyWithResponse(ctx context.Context, bundle string, reqEditors ...RequestEditorFn) (*PostApiBundlesBundleBuyResponse, error)
+
+	// GetApiBuyItemWithResponse request
+	GetApiBuyItemWithResponse(ctx context.Context, item string, params *GetApiBuyItemParams, reqEditors ...RequestEditorFn) (*GetApiBuyItemResponse, error)
+
+	// PostApiBuyItemWithBodyWithResponse request with any body
+	PostApiBuyItemWithBodyWithResponse(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiBuyItemResponse, error)
+
+	PostApiBuyItemWithResponse(ctx context.Context, item string, body PostApiBuyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiBuyItemResponse, error)
+
+	// GetApiInfoWithResponse request
+	GetApiInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiInfoResponse, error)
+
+	// GetApiMarketListingsWithResponse request
+	GetApiMarketListingsWithResponse(ctx context.Context, params *GetApiMarketListingsParams, reqEditors ...RequestEditorFn) (*GetApiMarketListingsResponse, error)
+
+	// PostApiMarketListingsWithBodyWithResponse request with any body
+	PostApiMarketListingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMarketListingsResponse, error)
+
+	PostApiMarketListingsWithResponse(ctx context.Context, body PostApiMarketListingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMarketListingsResponse, error)
+
+	// PostApiMarketListingsIdBuyWithBodyWithResponse request with any body
+	PostApiMarketListingsIdBuyWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMarketListingsIdBuyResponse, error)
+
+	PostApiMarketListingsIdBuyWithResponse(ctx context.Context, id int64, body PostApiMarketListingsIdBuyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMarketListingsIdBuyResponse, error)
+
+	// PostApiMarketListingsIdCancelWithResponse request
+	PostApiMarketListingsIdCancelWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiMarketListingsIdCancelResponse, error)
+
+	// GetApiMeWishlistWithResponse request
+	GetApiMeWishlistWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiMeWishlistResponse, error)
+
+	// PostApiMeWishlistWithBodyWithResponse request with any body
+	PostApiMeWishlistWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMeWishlistResponse, error)
+
+	PostApiMeWishlistWithResponse(ctx context.Context, body PostApiMeWishlistJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMeWishlistResponse, error)
+
+	// DeleteApiMeWishlistItemWithResponse request
+	DeleteApiMeWishlistItemWithResponse(ctx context.Context, item string, params *DeleteApiMeWishlistItemParams, reqEditors ...RequestEditorFn) (*DeleteApiMeWishlistItemResponse, error)
+
+	// GetApiMerchWithResponse request
+	GetApiMerchWithResponse(ctx context.Context, params *GetApiMerchParams, reqEditors ...RequestEditorFn) (*GetApiMerchResponse, error)
+
+	// GetApiMerchCategoriesWithResponse request
+	GetApiMerchCategoriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiMerchCategoriesResponse, error)
+
+	// GetApiNotificationsWithResponse request
+	GetApiNotificationsWithResponse(ctx context.Context, params *GetApiNotificationsParams, reqEditors ...RequestEditorFn) (*GetApiNotificationsResponse, error)
+
+	// GetApiNotificationsPreferencesWithResponse request
+	GetApiNotificationsPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiNotificationsPreferencesResponse, error)
+
+	// PutApiNotificationsPreferencesWithBodyWithResponse request with any body
+	PutApiNotificationsPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiNotificationsPreferencesResponse, error)
+
+	PutApiNotificationsPreferencesWithResponse(ctx context.Context, body PutApiNotificationsPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiNotificationsPreferencesResponse, error)
+
+	// PostApiNotificationsReadAllWithResponse request
+	PostApiNotificationsReadAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiNotificationsReadAllResponse, error)
+
+	// PostApiNotificationsIdReadWithResponse request
+	PostApiNotificationsIdReadWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiNotificationsIdReadResponse, error)
+
+	// GetApiOrdersWithResponse request
+	GetApiOrdersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiOrdersResponse, error)
+
+	// PostApiOrdersIdCancelWithResponse request
+	PostApiOrdersIdCancelWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiOrdersIdCancelResponse, error)
+
+	// GetApiRafflesWithResponse request
+	GetApiRafflesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiRafflesResponse, error)
+
+	// GetApiRafflesIdWithResponse request
+	GetApiRafflesIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetApiRafflesIdResponse, error)
+
+	// GetApiRafflesIdTicketsWithResponse request
+	GetApiRafflesIdTicketsWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetApiRafflesIdTicketsResponse, error)
+
+	// PostApiRafflesIdTicketsWithBodyWithResponse request with any body
+	PostApiRafflesIdTicketsWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiRafflesIdTicketsResponse, error)
+
+	PostApiRafflesIdTicketsWithResponse(ctx context.Context, id int64, body PostApiRafflesIdTicketsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiRafflesIdTicketsResponse, error)
+
+	// PostApiRefundItemWithResponse request
+	PostApiRefundItemWithResponse(ctx context.Context, item string, params *PostApiRefundItemParams, reqEditors ...RequestEditorFn) (*PostApiRefundItemResponse, error)
+
+	// PostApiSendCoinWithBodyWithResponse request with any body
+	PostApiSendCoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error)
+
+	PostApiSendCoinWithResponse(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error)
+
+	// GetApiV2MerchWithResponse request
+	GetApiV2MerchWithResponse(ctx context.Context, params *GetApiV2MerchParams, reqEditors ...RequestEditorFn) (*GetApiV2MerchResponse, error)
+
+	// PostApiV2OrdersWithBodyWithResponse request with any body
+	PostApiV2OrdersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV2OrdersResponse, error)
+
+	PostApiV2OrdersWithResponse(ctx context.Context, body PostApiV2OrdersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV2OrdersResponse, error)
+
+	// GetApiV2OrdersIdWithResponse request
+	GetApiV2OrdersIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetApiV2OrdersIdResponse, error)
+
+	// PostApiV2TransfersWithBodyWithResponse request with any body
+	PostApiV2TransfersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV2TransfersResponse, error)
+
+	PostApiV2TransfersWithResponse(ctx context.Context, body PostApiV2TransfersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV2TransfersResponse, error)
+
+	// GetApiV2TransfersIdWithResponse request
+	GetApiV2TransfersIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetApiV2TransfersIdResponse, error)
+
+	// GetApiV2UsersMeWithResponse request
+	GetApiV2UsersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV2UsersMeResponse, error)
+
+	// GetApiV2UsersMeTransactionsWithResponse request
+	GetApiV2UsersMeTransactionsWithResponse(ctx context.Context, params *GetApiV2UsersMeTransactionsParams, reqEditors ...RequestEditorFn) (*GetApiV2UsersMeTransactionsResponse, error)
+}
+
+type PostApiAdminAuctionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Auction
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminAuctionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminAuctionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminBundlesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Bundle
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminBundlesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminBundlesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminMerchItemVariantsVariantRestockResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *RestockResponse
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminMerchItemVariantsVariantRestockResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminMerchItemVariantsVariantRestockResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminOrdersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Order
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminOrdersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminOrdersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminOrdersIdCancelResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Order
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminOrdersIdCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminOrdersIdCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminOrdersIdHandoverResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Order
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminOrdersIdHandoverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminOrdersIdHandoverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminOrdersIdReadyResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Order
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminOrdersIdReadyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminOrdersIdReadyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminPromotionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Promotion
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminPromotionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminPromotionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminPromotionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Promotion
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminPromotionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminPromotionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminRafflesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Raffle
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminRafflesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminRafflesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminRafflesIdDrawResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Raffle
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminRafflesIdDrawResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminRafflesIdDrawResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminRefundsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Refund
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminRefundsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminRefundsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminRefundsIdApproveResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Refund
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminRefundsIdApproveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminRefundsIdApproveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminRefundsIdRejectResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Refund
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminRefundsIdRejectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminRefundsIdRejectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminWebhookDeliveriesIdRedeliverResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *WebhookDelivery
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminWebhookDeliveriesIdRedeliverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminWebhookDeliveriesIdRedeliverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminWebhooksResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]WebhookSubscription
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminWebhooksResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *WebhookSubscription
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiAdminWebhooksIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiAdminWebhooksIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiAdminWebhooksIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminWebhooksIdDeliveriesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]WebhookDelivery
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminWebhooksIdDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminWebhooksIdDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAuctionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Auction
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAuctionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAuctionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAuctionsIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Auction
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAuctionsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAuctionsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuctionsIdBidsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Auction
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuctionsIdBidsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuctionsIdBidsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuthResponse
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAuth2faResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TwoFactorStatus
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAuth2faResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAuth2faResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuth2faConfirmResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TwoFactorRecoveryCodes
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuth2faConfirmResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuth2faConfirmResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuth2faDisableResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuth2faDisableResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuth2faDisableResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuth2faEnrollResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TwoFactorEnrollment
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuth2faEnrollResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuth2faEnrollResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthForgotResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON429                   *ErrorResponse
+	ApplicationproblemJSON429 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthForgotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthForgotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthOtpResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuthResponse
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthOtpResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthOtpResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthResetResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthVerifyResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthVerifyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthVerifyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthVerifySendResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON429                   *ErrorResponse
+	ApplicationproblemJSON429 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthVerifySendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthVerifySendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiBundlesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Bundle
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiBundlesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiBundlesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiBundlesBundleBuyResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiBundlesBundleBuyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiBundlesBundleBuyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiBuyItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiBuyItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiBuyItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiBuyItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiBuyItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiBuyItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiInfoResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *InfoResponse
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMarketListingsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Listing
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMarketListingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMarketListingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiMarketListingsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Listing
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiMarketListingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiMarketListingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiMarketListingsIdBuyResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiMarketListingsIdBuyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiMarketListingsIdBuyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiMarketListingsIdCancelResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Listing
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiMarketListingsIdCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiMarketListingsIdCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMeWishlistResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Wishlist
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMeWishlistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMeWishlistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiMeWishlistResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Wishlist
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiMeWishlistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiMeWishlistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiMeWishlistItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Wishlist
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiMeWishlistItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiMeWishlistItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMerchResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *MerchPage
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMerchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMerchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiMerchCategoriesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]MerchCategory
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiMerchCategoriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiMerchCategoriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiNotificationsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Notification
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiNotificationsPreferencesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *NotificationPreferences
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiNotificationsPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiNotificationsPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiNotificationsPreferencesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *NotificationPreferences
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiNotificationsPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiNotificationsPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiNotificationsReadAllResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiNotificationsReadAllResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiNotificationsReadAllResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiNotificationsIdReadResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Notification
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiNotificationsIdReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiNotificationsIdReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiOrdersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Order
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiOrdersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiOrdersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiOrdersIdCancelResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Order
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiOrdersIdCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiOrdersIdCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiRafflesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Raffle
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiRafflesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiRafflesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiRafflesIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Raffle
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiRafflesIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiRafflesIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiRafflesIdTicketsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]RaffleTicket
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiRafflesIdTicketsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiRafflesIdTicketsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiRafflesIdTicketsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]RaffleTicket
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON409                   *ErrorResponse
+	ApplicationproblemJSON409 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiRafflesIdTicketsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiRafflesIdTicketsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiRefundItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Refund
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiRefundItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiRefundItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiSendCoinResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiSendCoinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiSendCoinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV2MerchResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *MerchPage
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV2MerchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV2MerchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV2OrdersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Order
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV2OrdersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV2OrdersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV2OrdersIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Order
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV2OrdersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV2OrdersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV2TransfersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Transfer
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON403                   *ErrorResponse
+	ApplicationproblemJSON403 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV2TransfersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV2TransfersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV2TransfersIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Transfer
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON404                   *ErrorResponse
+	ApplicationproblemJSON404 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV2TransfersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV2TransfersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV2UsersMeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *User
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV2UsersMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV2UsersMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV2UsersMeTransactionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TransferPage
+	JSON400                   *ErrorResponse
+	ApplicationproblemJSON400 *ProblemDetails
+	JSON401                   *ErrorResponse
+	ApplicationproblemJSON401 *ProblemDetails
+	JSON500                   *ErrorResponse
+	ApplicationproblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV2UsersMeTransactionsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV2UsersMeTransactionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// PostApiAdminAuctionsWithBodyWithResponse request with arbitrary body returning *PostApiAdminAuctionsResponse
+func (c *ClientWithResponses) PostApiAdminAuctionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminAuctionsResponse, error) {
+	rsp, err := c.PostApiAdminAuctionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminAuctionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminAuctionsWithResponse(ctx context.Context, body PostApiAdminAuctionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminAuctionsResponse, error) {
+	rsp, err := c.PostApiAdminAuctions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminAuctionsResponse(rsp)
+}
+
+// PostApiAdminBundlesWithBodyWithResponse request with arbitrary body returning *PostApiAdminBundlesResponse
+func (c *ClientWithResponses) PostApiAdminBundlesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminBundlesResponse, error) {
+	rsp, err := c.PostApiAdminBundlesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminBundlesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminBundlesWithResponse(ctx context.Context, body PostApiAdminBundlesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminBundlesResponse, error) {
+	rsp, err := c.PostApiAdminBundles(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminBundlesResponse(rsp)
+}
+
+// PostApiAdminMerchItemVariantsVariantRestockWithBodyWithResponse request with arbitrary body returning *PostApiAdminMerchItemVariantsVariantRestockResponse
+func (c *ClientWithResponses) PostApiAdminMerchItemVariantsVariantRestockWithBodyWithResponse(ctx context.Context, item string, variant string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminMerchItemVariantsVariantRestockResponse, error) {
+	rsp, err := c.PostApiAdminMerchItemVariantsVariantRestockWithBody(ctx, item, variant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminMerchItemVariantsVariantRestockResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminMerchItemVariantsVariantRestockWithResponse(ctx context.Context, item string, variant string, body PostApiAdminMerchItemVariantsVariantRestockJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminMerchItemVariantsVariantRestockResponse, error) {
+	rsp, err := c.PostApiAdminMerchItemVariantsVariantRestock(ctx, item, variant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminMerchItemVariantsVariantRestockResponse(rsp)
+}
+
+// GetApiAdminOrdersWithResponse request returning *GetApiAdminOrdersResponse
+func (c *ClientWithResponses) GetApiAdminOrdersWithResponse(ctx context.Context, params *GetApiAdminOrdersParams, reqEditors ...RequestEditorFn) (*GetApiAdminOrdersResponse, error) {
+	rsp, err := c.GetApiAdminOrders(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminOrdersResponse(rsp)
+}
+
+// PostApiAdminOrdersIdCancelWithResponse request returning *PostApiAdminOrdersIdCancelResponse
+func (c *ClientWithResponses) PostApiAdminOrdersIdCancelWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiAdminOrdersIdCancelResponse, error) {
+	rsp, err := c.PostApiAdminOrdersIdCancel(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminOrdersIdCancelResponse(rsp)
+}
+
+// PostApiAdminOrdersIdHandoverWithBodyWithResponse request with arbitrary body returning *PostApiAdminOrdersIdHandoverResponse
+func (c *ClientWithResponses) PostApiAdminOrdersIdHandoverWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminOrdersIdHandoverResponse, error) {
+	rsp, err := c.PostApiAdminOrdersIdHandoverWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminOrdersIdHandoverResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminOrdersIdHandoverWithResponse(ctx context.Context, id int64, body PostApiAdminOrdersIdHandoverJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminOrdersIdHandoverResponse, error) {
+	rsp, err := c.PostApiAdminOrdersIdHandover(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminOrdersIdHandoverResponse(rsp)
+}
+
+// PostApiAdminOrdersIdReadyWithResponse request returning *PostApiAdminOrdersIdReadyResponse
+func (c *ClientWithResponses) PostApiAdminOrdersIdReadyWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiAdminOrdersIdReadyResponse, error) {
+	rsp, err := c.PostApiAdminOrdersIdReady(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminOrdersIdReadyResponse(rsp)
+}
+
+// GetApiAdminPromotionsWithResponse request returning *GetApiAdminPromotionsResponse
+func (c *ClientWithResponses) GetApiAdminPromotionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminPromotionsResponse, error) {
+	rsp, err := c.GetApiAdminPromotions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminPromotionsResponse(rsp)
+}
+
+// PostApiAdminPromotionsWithBodyWithResponse request with arbitrary body returning *PostApiAdminPromotionsResponse
+func (c *ClientWithResponses) PostApiAdminPromotionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminPromotionsResponse, error) {
+	rsp, err := c.PostApiAdminPromotionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminPromotionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminPromotionsWithResponse(ctx context.Context, body PostApiAdminPromotionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminPromotionsResponse, error) {
+	rsp, err := c.PostApiAdminPromotions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminPromotionsResponse(rsp)
+}
+
+// PostApiAdminRafflesWithBodyWithResponse request with arbitrary body returning *PostApiAdminRafflesResponse
+func (c *ClientWithResponses) PostApiAdminRafflesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminRafflesResponse, error) {
+	rsp, err := c.PostApiAdminRafflesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminRafflesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminRafflesWithResponse(ctx context.Context, body PostApiAdminRafflesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminRafflesResponse, error) {
+	rsp, err := c.PostApiAdminRaffles(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminRafflesResponse(rsp)
+}
+
+// PostApiAdminRafflesIdDrawWithResponse request returning *PostApiAdminRafflesIdDrawResponse
+func (c *ClientWithResponses) PostApiAdminRafflesIdDrawWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiAdminRafflesIdDrawResponse, error) {
+	rsp, err := c.PostApiAdminRafflesIdDraw(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminRafflesIdDrawResponse(rsp)
+}
+
+// GetApiAdminRefundsWithResponse request returning *GetApiAdminRefundsResponse
+func (c *ClientWithResponses) GetApiAdminRefundsWithResponse(ctx context.Context, params *GetApiAdminRefundsParams, reqEditors ...RequestEditorFn) (*GetApiAdminRefundsResponse, error) {
+	rsp, err := c.GetApiAdminRefunds(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminRefundsResponse(rsp)
+}
+
+// PostApiAdminRefundsIdApproveWithResponse request returning *PostApiAdminRefundsIdApproveResponse
+func (c *ClientWithResponses) PostApiAdminRefundsIdApproveWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiAdminRefundsIdApproveResponse, error) {
+	rsp, err := c.PostApiAdminRefundsIdApprove(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminRefundsIdApproveResponse(rsp)
+}
+
+// PostApiAdminRefundsIdRejectWithResponse request returning *PostApiAdminRefundsIdRejectResponse
+func (c *ClientWithResponses) PostApiAdminRefundsIdRejectWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiAdminRefundsIdRejectResponse, error) {
+	rsp, err := c.PostApiAdminRefundsIdReject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminRefundsIdRejectResponse(rsp)
+}
+
+// PostApiAdminWebhookDeliveriesIdRedeliverWithResponse request returning *PostApiAdminWebhookDeliveriesIdRedeliverResponse
+func (c *ClientWithResponses) PostApiAdminWebhookDeliveriesIdRedeliverWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiAdminWebhookDeliveriesIdRedeliverResponse, error) {
+	rsp, err := c.PostApiAdminWebhookDeliveriesIdRedeliver(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminWebhookDeliveriesIdRedeliverResponse(rsp)
+}
+
+// GetApiAdminWebhooksWithResponse request returning *GetApiAdminWebhooksResponse
+func (c *ClientWithResponses) GetApiAdminWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminWebhooksResponse, error) {
+	rsp, err := c.GetApiAdminWebhooks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminWebhooksResponse(rsp)
+}
+
+// PostApiAdminWebhooksWithBodyWithResponse request with arbitrary body returning *PostApiAdminWebhooksResponse
+func (c *ClientWithResponses) PostApiAdminWebhooksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminWebhooksResponse, error) {
+	rsp, err := c.PostApiAdminWebhooksWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminWebhooksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminWebhooksWithResponse(ctx context.Context, body PostApiAdminWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminWebhooksResponse, error) {
+	rsp, err := c.PostApiAdminWebhooks(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminWebhooksResponse(rsp)
+}
+
+// DeleteApiAdminWebhooksIdWithResponse request returning *DeleteApiAdminWebhooksIdResponse
+func (c *ClientWithResponses) DeleteApiAdminWebhooksIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteApiAdminWebhooksIdResponse, error) {
+	rsp, err := c.DeleteApiAdminWebhooksId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiAdminWebhooksIdResponse(rsp)
+}
+
+// GetApiAdminWebhooksIdDeliveriesWithResponse request returning *GetApiAdminWebhooksIdDeliveriesResponse
+func (c *ClientWithResponses) GetApiAdminWebhooksIdDeliveriesWithResponse(ctx context.Context, id int64, params *GetApiAdminWebhooksIdDeliveriesParams, reqEditors ...RequestEditorFn) (*GetApiAdminWebhooksIdDeliveriesResponse, error) {
+	rsp, err := c.GetApiAdminWebhooksIdDeliveries(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminWebhooksIdDeliveriesResponse(rsp)
+}
+
+// GetApiAuctionsWithResponse request returning *GetApiAuctionsResponse
+func (c *ClientWithResponses) GetApiAuctionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAuctionsResponse, error) {
+	rsp, err := c.GetApiAuctions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAuctionsResponse(rsp)
+}
+
+// GetApiAuctionsIdWithResponse request returning *GetApiAuctionsIdResponse
+func (c *ClientWithResponses) GetApiAuctionsIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetApiAuctionsIdResponse, error) {
+	rsp, err := c.GetApiAuctionsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAuctionsIdResponse(rsp)
+}
+
+// PostApiAuctionsIdBidsWithBodyWithResponse request with arbitrary body returning *PostApiAuctionsIdBidsResponse
+func (c *ClientWithResponses) PostApiAuctionsIdBidsWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuctionsIdBidsResponse, error) {
+	rsp, err := c.PostApiAuctionsIdBidsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuctionsIdBidsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuctionsIdBidsWithResponse(ctx context.Context, id int64, body PostApiAuctionsIdBidsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuctionsIdBidsResponse, error) {
+	rsp, err := c.PostApiAuctionsIdBids(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuctionsIdBidsResponse(rsp)
+}
+
+// PostApiAuthWithBodyWithResponse request with arbitrary body returning *PostApiAuthResponse
+func (c *ClientWithResponses) PostApiAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error) {
+	rsp, err := c.PostApiAuthWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuthWithResponse(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error) {
+	rsp, err := c.PostApiAuth(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthResponse(rsp)
+}
+
+// GetApiAuth2faWithResponse request returning *GetApiAuth2faResponse
+func (c *ClientWithResponses) GetApiAuth2faWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAuth2faResponse, error) {
+	rsp, err := c.GetApiAuth2fa(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAuth2faResponse(rsp)
+}
+
+// PostApiAuth2faConfirmWithBodyWithResponse request with arbitrary body returning *PostApiAuth2faConfirmResponse
+func (c *ClientWithResponses) PostApiAuth2faConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuth2faConfirmResponse, error) {
+	rsp, err := c.PostApiAuth2faConfirmWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuth2faConfirmResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuth2faConfirmWithResponse(ctx context.Context, body PostApiAuth2faConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuth2faConfirmResponse, error) {
+	rsp, err := c.PostApiAuth2faConfirm(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuth2faConfirmResponse(rsp)
+}
+
+// PostApiAuth2faDisableWithBodyWithResponse request with arbitrary body returning *PostApiAuth2faDisableResponse
+func (c *ClientWithResponses) PostApiAuth2faDisableWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuth2faDisableResponse, error) {
+	rsp, err := c.PostApiAuth2faDisableWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuth2faDisableResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuth2faDisableWithResponse(ctx context.Context, body PostApiAuth2faDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuth2faDisableResponse, error) {
+	rsp, err := c.PostApiAuth2faDisable(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuth2faDisableResponse(rsp)
+}
+
+// PostApiAuth2faEnrollWithResponse request returning *PostApiAuth2faEnrollResponse
+func (c *ClientWithResponses) PostApiAuth2faEnrollWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiAuth2faEnrollResponse, error) {
+	rsp, err := c.PostApiAuth2faEnroll(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuth2faEnrollResponse(rsp)
+}
+
+// PostApiAuthForgotWithBodyWithResponse request with arbitrary body returning *PostApiAuthForgotResponse
+func (c *ClientWithResponses) PostApiAuthForgotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthForgotResponse, error) {
+	rsp, err := c.PostApiAuthForgotWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthForgotResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuthForgotWithResponse(ctx context.Context, body PostApiAuthForgotJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthForgotResponse, error) {
+	rsp, err := c.PostApiAuthForgot(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthForgotResponse(rsp)
+}
+
+// PostApiAuthOtpWithBodyWithResponse request with arbitrary body returning *PostApiAuthOtpResponse
+func (c *ClientWithResponses) PostApiAuthOtpWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthOtpResponse, error) {
+	rsp, err := c.PostApiAuthOtpWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthOtpResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuthOtpWithResponse(ctx context.Context, body PostApiAuthOtpJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthOtpResponse, error) {
+	rsp, err := c.PostApiAuthOtp(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthOtpResponse(rsp)
+}
+
+// PostApiAuthResetWithBodyWithResponse request with arbitrary body returning *PostApiAuthResetResponse
+func (c *ClientWithResponses) PostApiAuthResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthResetResponse, error) {
+	rsp, err := c.PostApiAuthResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuthResetWithResponse(ctx context.Context, body PostApiAuthResetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthResetResponse, error) {
+	rsp, err := c.PostApiAuthReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthResetResponse(rsp)
+}
+
+// PostApiAuthVerifyWithBodyWithResponse request with arbitrary body returning *PostApiAuthVerifyResponse
+func (c *ClientWithResponses) PostApiAuthVerifyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthVerifyResponse, error) {
+	rsp, err := c.PostApiAuthVerifyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthVerifyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuthVerifyWithResponse(ctx context.Context, body PostApiAuthVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthVerifyResponse, error) {
+	rsp, err := c.PostApiAuthVerify(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthVerifyResponse(rsp)
+}
+
+// PostApiAuthVerifySendWithResponse request returning *PostApiAuthVerifySendResponse
+func (c *ClientWithResponses) PostApiAuthVerifySendWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiAuthVerifySendResponse, error) {
+	rsp, err := c.PostApiAuthVerifySend(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthVerifySendResponse(rsp)
+}
+
+// GetApiBundlesWithResponse request returning *GetApiBundlesResponse
+func (c *ClientWithResponses) GetApiBundlesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiBundlesResponse, error) {
+	rsp, err := c.GetApiBundles(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiBundlesResponse(rsp)
+}
+
+// PostApiBundlesBundleBuyWithResponse request returning *PostApiBundlesBundleBuyResponse
+func (c *ClientWithResponses) PostApiBundlesBundleBuyWithResponse(ctx context.Context, bundle string, reqEditors ...RequestEditorFn) (*PostApiBundlesBundleBuyResponse, error) {
+	rsp, err := c.PostApiBundlesBundleBuy(ctx, bundle, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiBundlesBundleBuyResponse(rsp)
+}
+
+// GetApiBuyItemWithResponse request returning *GetApiBuyItemResponse
+func (c *ClientWithResponses) GetApiBuyItemWithResponse(ctx context.Context, item string, params *GetApiBuyItemParams, reqEditors ...RequestEditorFn) (*GetApiBuyItemResponse, error) {
+	rsp, err := c.GetApiBuyItem(ctx, item, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiBuyItemResponse(rsp)
+}
+
+// PostApiBuyItemWithBodyWithResponse request with arbitrary body returning *PostApiBuyItemResponse
+func (c *ClientWithResponses) PostApiBuyItemWithBodyWithResponse(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiBuyItemResponse, error) {
+	rsp, err := c.PostApiBuyItemWithBody(ctx, item, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiBuyItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiBuyItemWithResponse(ctx context.Context, item string, body PostApiBuyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiBuyItemResponse, error) {
+	rsp, err := c.PostApiBuyItem(ctx, item, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiBuyItemResponse(rsp)
+}
+
+// GetApiInfoWithResponse request returning *GetApiInfoResponse
+func (c *ClientWithResponses) GetApiInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiInfoResponse, error) {
+	rsp, err := c.GetApiInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiInfoResponse(rsp)
+}
+
+// GetApiMarketListingsWithResponse request returning *GetApiMarketListingsResponse
+func (c *ClientWithResponses) GetApiMarketListingsWithResponse(ctx context.Context, params *GetApiMarketListingsParams, reqEditors ...RequestEditorFn) (*GetApiMarketListingsResponse, error) {
+	rsp, err := c.GetApiMarketListings(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMarketListingsResponse(rsp)
+}
+
+// PostApiMarketListingsWithBodyWithResponse request with arbitrary body returning *PostApiMarketListingsResponse
+func (c *ClientWithResponses) PostApiMarketListingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMarketListingsResponse, error) {
+	rsp, err := c.PostApiMarketListingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMarketListingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiMarketListingsWithResponse(ctx context.Context, body PostApiMarketListingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMarketListingsResponse, error) {
+	rsp, err := c.PostApiMarketListings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMarketListingsResponse(rsp)
+}
+
+// PostApiMarketListingsIdBuyWithBodyWithResponse request with arbitrary body returning *PostApiMarketListingsIdBuyResponse
+func (c *ClientWithResponses) PostApiMarketListingsIdBuyWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMarketListingsIdBuyResponse, error) {
+	rsp, err := c.PostApiMarketListingsIdBuyWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMarketListingsIdBuyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiMarketListingsIdBuyWithResponse(ctx context.Context, id int64, body PostApiMarketListingsIdBuyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMarketListingsIdBuyResponse, error) {
+	rsp, err := c.PostApiMarketListingsIdBuy(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMarketListingsIdBuyResponse(rsp)
+}
+
+// PostApiMarketListingsIdCancelWithResponse request returning *PostApiMarketListingsIdCancelResponse
+func (c *ClientWithResponses) PostApiMarketListingsIdCancelWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiMarketListingsIdCancelResponse, error) {
+	rsp, err := c.PostApiMarketListingsIdCancel(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMarketListingsIdCancelResponse(rsp)
+}
+
+// GetApiMeWishlistWithResponse request returning *GetApiMeWishlistResponse
+func (c *ClientWithResponses) GetApiMeWishlistWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiMeWishlistResponse, error) {
+	rsp, err := c.GetApiMeWishlist(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMeWishlistResponse(rsp)
+}
+
+// PostApiMeWishlistWithBodyWithResponse request with arbitrary body returning *PostApiMeWishlistResponse
+func (c *ClientWithResponses) PostApiMeWishlistWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMeWishlistResponse, error) {
+	rsp, err := c.PostApiMeWishlistWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMeWishlistResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiMeWishlistWithResponse(ctx context.Context, body PostApiMeWishlistJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMeWishlistResponse, error) {
+	rsp, err := c.PostApiMeWishlist(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMeWishlistResponse(rsp)
+}
+
+// DeleteApiMeWishlistItemWithResponse request returning *DeleteApiMeWishlistItemResponse
+func (c *ClientWithResponses) DeleteApiMeWishlistItemWithResponse(ctx context.Context, item string, params *DeleteApiMeWishlistItemParams, reqEditors ...RequestEditorFn) (*DeleteApiMeWishlistItemResponse, error) {
+	rsp, err := c.DeleteApiMeWishlistItem(ctx, item, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiMeWishlistItemResponse(rsp)
+}
+
+// GetApiMerchWithResponse request returning *GetApiMerchResponse
+func (c *ClientWithResponses) GetApiMerchWithResponse(ctx context.Context, params *GetApiMerchParams, reqEditors ...RequestEditorFn) (*GetApiMerchResponse, error) {
+	rsp, err := c.GetApiMerch(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMerchResponse(rsp)
+}
+
+// GetApiMerchCategoriesWithResponse request returning *GetApiMerchCategoriesResponse
+func (c *ClientWithResponses) GetApiMerchCategoriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiMerchCategoriesResponse, error) {
+	rsp, err := c.GetApiMerchCategories(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiMerchCategoriesResponse(rsp)
+}
+
+// GetApiNotificationsWithResponse request returning *GetApiNotificationsResponse
+func (c *ClientWithResponses) GetApiNotificationsWithResponse(ctx context.Context, params *GetApiNotificationsParams, reqEditors ...RequestEditorFn) (*GetApiNotificationsResponse, error) {
+	rsp, err := c.GetApiNotifications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiNotificationsResponse(rsp)
+}
+
+// GetApiNotificationsPreferencesWithResponse request returning *GetApiNotificationsPreferencesResponse
+func (c *ClientWithResponses) GetApiNotificationsPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiNotificationsPreferencesResponse, error) {
+	rsp, err := c.GetApiNotificationsPreferences(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiNotificationsPreferencesResponse(rsp)
+}
+
+// PutApiNotificationsPreferencesWithBodyWithResponse request with arbitrary body returning *PutApiNotificationsPreferencesResponse
+func (c *ClientWithResponses) PutApiNotificationsPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiNotificationsPreferencesResponse, error) {
+	rsp, err := c.PutApiNotificationsPreferencesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiNotificationsPreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiNotificationsPreferencesWithResponse(ctx context.Context, body PutApiNotificationsPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiNotificationsPreferencesResponse, error) {
+	rsp, err := c.PutApiNotificationsPreferences(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiNotificationsPreferencesResponse(rsp)
+}
+
+// PostApiNotificationsReadAllWithResponse request returning *PostApiNotificationsReadAllResponse
+func (c *ClientWithResponses) PostApiNotificationsReadAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiNotificationsReadAllResponse, error) {
+	rsp, err := c.PostApiNotificationsReadAll(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiNotificationsReadAllResponse(rsp)
+}
+
+// PostApiNotificationsIdReadWithResponse request returning *PostApiNotificationsIdReadResponse
+func (c *ClientWithResponses) PostApiNotificationsIdReadWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiNotificationsIdReadResponse, error) {
+	rsp, err := c.PostApiNotificationsIdRead(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiNotificationsIdReadResponse(rsp)
+}
+
+// GetApiOrdersWithResponse request returning *GetApiOrdersResponse
+func (c *ClientWithResponses) GetApiOrdersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiOrdersResponse, error) {
+	rsp, err := c.GetApiOrders(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiOrdersResponse(rsp)
+}
+
+// PostApiOrdersIdCancelWithResponse request returning *PostApiOrdersIdCancelResponse
+func (c *ClientWithResponses) PostApiOrdersIdCancelWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostApiOrdersIdCancelResponse, error) {
+	rsp, err := c.PostApiOrdersIdCancel(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOrdersIdCancelResponse(rsp)
+}
+
+// GetApiRafflesWithResponse request returning *GetApiRafflesResponse
+func (c *ClientWithResponses) GetApiRafflesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiRafflesResponse, error) {
+	rsp, err := c.GetApiRaffles(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiRafflesResponse(rsp)
+}
+
+// GetApiRafflesIdWithResponse request returning *GetApiRafflesIdResponse
+func (c *ClientWithResponses) GetApiRafflesIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetApiRafflesIdResponse, error) {
+	rsp, err := c.GetApiRafflesId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiRafflesIdResponse(rsp)
+}
+
+// GetApiRafflesIdTicketsWithResponse request returning *GetApiRafflesIdTicketsResponse
+func (c *ClientWithResponses) GetApiRafflesIdTicketsWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetApiRafflesIdTicketsResponse, error) {
+	rsp, err := c.GetApiRafflesIdTickets(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiRafflesIdTicketsResponse(rsp)
+}
+
+// PostApiRafflesIdTicketsWithBodyWithResponse request with arbitrary body returning *PostApiRafflesIdTicketsResponse
+func (c *ClientWithResponses) PostApiRafflesIdTicketsWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiRafflesIdTicketsResponse, error) {
+	rsp, err := c.PostApiRafflesIdTicketsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiRafflesIdTicketsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiRafflesIdTicketsWithResponse(ctx context.Context, id int64, body PostApiRafflesIdTicketsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiRafflesIdTicketsResponse, error) {
+	rsp, err := c.PostApiRafflesIdTickets(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiRafflesIdTicketsResponse(rsp)
+}
+
+// PostApiRefundItemWithResponse request returning *PostApiRefundItemResponse
+func (c *ClientWithResponses) PostApiRefundItemWithResponse(ctx context.Context, item string, params *PostApiRefundItemParams, reqEditors ...RequestEditorFn) (*PostApiRefundItemResponse, error) {
+	rsp, err := c.PostApiRefundItem(ctx, item, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiRefundItemResponse(rsp)
+}
+
+// PostApiSendCoinWithBodyWithResponse request with arbitrary body returning *PostApiSendCoinResponse
+func (c *ClientWithResponses) PostApiSendCoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error) {
+	rsp, err := c.PostApiSendCoinWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSendCoinResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiSendCoinWithResponse(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error) {
+	rsp, err := c.PostApiSendCoin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSendCoinResponse(rsp)
+}
+
+// GetApiV2MerchWithResponse request returning *GetApiV2MerchResponse
+func (c *ClientWithResponses) GetApiV2MerchWithResponse(ctx context.Context, params *GetApiV2MerchParams, reqEditors ...RequestEditorFn) (*GetApiV2MerchResponse, error) {
+	rsp, err := c.GetApiV2Merch(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV2MerchResponse(rsp)
+}
+
+// PostApiV2OrdersWithBodyWithResponse request with arbitrary body returning *PostApiV2OrdersResponse
+func (c *ClientWithResponses) PostApiV2OrdersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV2OrdersResponse, error) {
+	rsp, err := c.PostApiV2OrdersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV2OrdersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV2OrdersWithResponse(ctx context.Context, body PostApiV2OrdersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV2OrdersResponse, error) {
+	rsp, err := c.PostApiV2Orders(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV2OrdersResponse(rsp)
+}
+
+// GetApiV2OrdersIdWithResponse request returning *GetApiV2OrdersIdResponse
+func (c *ClientWithResponses) GetApiV2OrdersIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetApiV2OrdersIdResponse, error) {
+	rsp, err := c.GetApiV2OrdersId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV2OrdersIdResponse(rsp)
+}
+
+// PostApiV2TransfersWithBodyWithResponse request with arbitrary body returning *PostApiV2TransfersResponse
+func (c *ClientWithResponses) PostApiV2TransfersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV2TransfersResponse, error) {
+	rsp, err := c.PostApiV2TransfersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV2TransfersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV2TransfersWithResponse(ctx context.Context, body PostApiV2TransfersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV2TransfersResponse, error) {
+	rsp, err := c.PostApiV2Transfers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV2TransfersResponse(rsp)
+}
+
+// GetApiV2TransfersIdWithResponse request returning *GetApiV2TransfersIdResponse
+func (c *ClientWithResponses) GetApiV2TransfersIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetApiV2TransfersIdResponse, error) {
+	rsp, err := c.GetApiV2TransfersId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV2TransfersIdResponse(rsp)
+}
+
+// GetApiV2UsersMeWithResponse request returning *GetApiV2UsersMeResponse
+func (c *ClientWithResponses) GetApiV2UsersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV2UsersMeResponse, error) {
+	rsp, err := c.GetApiV2UsersMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV2UsersMeResponse(rsp)
+}
+
+// GetApiV2UsersMeTransactionsWithResponse request returning *GetApiV2UsersMeTransactionsResponse
+func (c *ClientWithResponses) GetApiV2UsersMeTransactionsWithResponse(ctx context.Context, params *GetApiV2UsersMeTransactionsParams, reqEditors ...RequestEditorFn) (*GetApiV2UsersMeTransactionsResponse, error) {
+	rsp, err := c.GetApiV2UsersMeTransactions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV2UsersMeTransactionsResponse(rsp)
+}
+
+// ParsePostApiAdminAuctionsResponse parses an HTTP response from a PostApiAdminAuctionsWithResponse call
+func ParsePostApiAdminAuctionsResponse(rsp *http.Response) (*PostApiAdminAuctionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminAuctionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Auction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminBundlesResponse parses an HTTP response from a PostApiAdminBundlesWithResponse call
+func ParsePostApiAdminBundlesResponse(rsp *http.Response) (*PostApiAdminBundlesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminBundlesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Bundle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminMerchItemVariantsVariantRestockResponse parses an HTTP response from a PostApiAdminMerchItemVariantsVariantRestockWithResponse call
+func ParsePostApiAdminMerchItemVariantsVariantRestockResponse(rsp *http.Response) (*PostApiAdminMerchItemVariantsVariantRestockResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminMerchItemVariantsVariantRestockResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RestockResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminOrdersResponse parses an HTTP response from a GetApiAdminOrdersWithResponse call
+func ParseGetApiAdminOrdersResponse(rsp *http.Response) (*GetApiAdminOrdersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminOrdersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Order
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminOrdersIdCancelResponse parses an HTTP response from a PostApiAdminOrdersIdCancelWithResponse call
+func ParsePostApiAdminOrdersIdCancelResponse(rsp *http.Response) (*PostApiAdminOrdersIdCancelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminOrdersIdCancelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Order
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminOrdersIdHandoverResponse parses an HTTP response from a PostApiAdminOrdersIdHandoverWithResponse call
+func ParsePostApiAdminOrdersIdHandoverResponse(rsp *http.Response) (*PostApiAdminOrdersIdHandoverResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminOrdersIdHandoverResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Order
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminOrdersIdReadyResponse parses an HTTP response from a PostApiAdminOrdersIdReadyWithResponse call
+func ParsePostApiAdminOrdersIdReadyResponse(rsp *http.Response) (*PostApiAdminOrdersIdReadyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminOrdersIdReadyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Order
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminPromotionsResponse parses an HTTP response from a GetApiAdminPromotionsWithResponse call
+func ParseGetApiAdminPromotionsResponse(rsp *http.Response) (*GetApiAdminPromotionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminPromotionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Promotion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminPromotionsResponse parses an HTTP response from a PostApiAdminPromotionsWithResponse call
+func ParsePostApiAdminPromotionsResponse(rsp *http.Response) (*PostApiAdminPromotionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminPromotionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Promotion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminRafflesResponse parses an HTTP response from a PostApiAdminRafflesWithResponse call
+func ParsePostApiAdminRafflesResponse(rsp *http.Response) (*PostApiAdminRafflesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminRafflesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Raffle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminRafflesIdDrawResponse parses an HTTP response from a PostApiAdminRafflesIdDrawWithResponse call
+func ParsePostApiAdminRafflesIdDrawResponse(rsp *http.Response) (*PostApiAdminRafflesIdDrawResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminRafflesIdDrawResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Raffle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminRefundsResponse parses an HTTP response from a GetApiAdminRefundsWithResponse call
+func ParseGetApiAdminRefundsResponse(rsp *http.Response) (*GetApiAdminRefundsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminRefundsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Refund
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminRefundsIdApproveResponse parses an HTTP response from a PostApiAdminRefundsIdApproveWithResponse call
+func ParsePostApiAdminRefundsIdApproveResponse(rsp *http.Response) (*PostApiAdminRefundsIdApproveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminRefundsIdApproveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Refund
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminRefundsIdRejectResponse parses an HTTP response from a PostApiAdminRefundsIdRejectWithResponse call
+func ParsePostApiAdminRefundsIdRejectResponse(rsp *http.Response) (*PostApiAdminRefundsIdRejectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminRefundsIdRejectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Refund
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminWebhookDeliveriesIdRedeliverResponse parses an HTTP response from a PostApiAdminWebhookDeliveriesIdRedeliverWithResponse call
+func ParsePostApiAdminWebhookDeliveriesIdRedeliverResponse(rsp *http.Response) (*PostApiAdminWebhookDeliveriesIdRedeliverResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminWebhookDeliveriesIdRedeliverResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminWebhooksResponse parses an HTTP response from a GetApiAdminWebhooksWithResponse call
+func ParseGetApiAdminWebhooksResponse(rsp *http.Response) (*GetApiAdminWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminWebhooksResponse parses an HTTP response from a PostApiAdminWebhooksWithResponse call
+func ParsePostApiAdminWebhooksResponse(rsp *http.Response) (*PostApiAdminWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WebhookSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiAdminWebhooksIdResponse parses an HTTP response from a DeleteApiAdminWebhooksIdWithResponse call
+func ParseDeleteApiAdminWebhooksIdResponse(rsp *http.Response) (*DeleteApiAdminWebhooksIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiAdminWebhooksIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminWebhooksIdDeliveriesResponse parses an HTTP response from a GetApiAdminWebhooksIdDeliveriesWithResponse call
+func ParseGetApiAdminWebhooksIdDeliveriesResponse(rsp *http.Response) (*GetApiAdminWebhooksIdDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminWebhooksIdDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAuctionsResponse parses an HTTP response from a GetApiAuctionsWithResponse call
+func ParseGetApiAuctionsResponse(rsp *http.Response) (*GetApiAuctionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAuctionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Auction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAuctionsIdResponse parses an HTTP response from a GetApiAuctionsIdWithResponse call
+func ParseGetApiAuctionsIdResponse(rsp *http.Response) (*GetApiAuctionsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAuctionsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Auction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuctionsIdBidsResponse parses an HTTP response from a PostApiAuctionsIdBidsWithResponse call
+func ParsePostApiAuctionsIdBidsResponse(rsp *http.Response) (*PostApiAuctionsIdBidsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuctionsIdBidsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Auction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthResponse parses an HTTP response from a PostApiAuthWithResponse call
+func ParsePostApiAuthResponse(rsp *http.Response) (*PostApiAuthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAuth2faResponse parses an HTTP response from a GetApiAuth2faWithResponse call
+func ParseGetApiAuth2faResponse(rsp *http.Response) (*GetApiAuth2faResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAuth2faResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TwoFactorStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuth2faConfirmResponse parses an HTTP response from a PostApiAuth2faConfirmWithResponse call
+func ParsePostApiAuth2faConfirmResponse(rsp *http.Response) (*PostApiAuth2faConfirmResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuth2faConfirmResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TwoFactorRecoveryCodes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuth2faDisableResponse parses an HTTP response from a PostApiAuth2faDisableWithResponse call
+func ParsePostApiAuth2faDisableResponse(rsp *http.Response) (*PostApiAuth2faDisableResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuth2faDisableResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuth2faEnrollResponse parses an HTTP response from a PostApiAuth2faEnrollWithResponse call
+func ParsePostApiAuth2faEnrollResponse(rsp *http.Response) (*PostApiAuth2faEnrollResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuth2faEnrollResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TwoFactorEnrollment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthForgotResponse parses an HTTP response from a PostApiAuthForgotWithResponse call
+func ParsePostApiAuthForgotResponse(rsp *http.Response) (*PostApiAuthForgotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthForgotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthOtpResponse parses an HTTP response from a PostApiAuthOtpWithResponse call
+func ParsePostApiAuthOtpResponse(rsp *http.Response) (*PostApiAuthOtpResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthOtpResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthResetResponse parses an HTTP response from a PostApiAuthResetWithResponse call
+func ParsePostApiAuthResetResponse(rsp *http.Response) (*PostApiAuthResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthVerifyResponse parses an HTTP response from a PostApiAuthVerifyWithResponse call
+func ParsePostApiAuthVerifyResponse(rsp *http.Response) (*PostApiAuthVerifyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthVerifyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthVerifySendResponse parses an HTTP response from a PostApiAuthVerifySendWithResponse call
+func ParsePostApiAuthVerifySendResponse(rsp *http.Response) (*PostApiAuthVerifySendResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthVerifySendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiBundlesResponse parses an HTTP response from a GetApiBundlesWithResponse call
+func ParseGetApiBundlesResponse(rsp *http.Response) (*GetApiBundlesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiBundlesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Bundle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiBundlesBundleBuyResponse parses an HTTP response from a PostApiBundlesBundleBuyWithResponse call
+func ParsePostApiBundlesBundleBuyResponse(rsp *http.Response) (*PostApiBundlesBundleBuyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiBundlesBundleBuyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiBuyItemResponse parses an HTTP response from a GetApiBuyItemWithResponse call
+func ParseGetApiBuyItemResponse(rsp *http.Response) (*GetApiBuyItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiBuyItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiBuyItemResponse parses an HTTP response from a PostApiBuyItemWithResponse call
+func ParsePostApiBuyItemResponse(rsp *http.Response) (*PostApiBuyItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiBuyItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiInfoResponse parses an HTTP response from a GetApiInfoWithResponse call
+func ParseGetApiInfoResponse(rsp *http.Response) (*GetApiInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InfoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMarketListingsResponse parses an HTTP response from a GetApiMarketListingsWithResponse call
+func ParseGetApiMarketListingsResponse(rsp *http.Response) (*GetApiMarketListingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMarketListingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Listing
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiMarketListingsResponse parses an HTTP response from a PostApiMarketListingsWithResponse call
+func ParsePostApiMarketListingsResponse(rsp *http.Response) (*PostApiMarketListingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiMarketListingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Listing
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiMarketListingsIdBuyResponse parses an HTTP response from a PostApiMarketListingsIdBuyWithResponse call
+func ParsePostApiMarketListingsIdBuyResponse(rsp *http.Response) (*PostApiMarketListingsIdBuyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiMarketListingsIdBuyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiMarketListingsIdCancelResponse parses an HTTP response from a PostApiMarketListingsIdCancelWithResponse call
+func ParsePostApiMarketListingsIdCancelResponse(rsp *http.Response) (*PostApiMarketListingsIdCancelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiMarketListingsIdCancelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Listing
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMeWishlistResponse parses an HTTP response from a GetApiMeWishlistWithResponse call
+func ParseGetApiMeWishlistResponse(rsp *http.Response) (*GetApiMeWishlistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMeWishlistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Wishlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiMeWishlistResponse parses an HTTP response from a PostApiMeWishlistWithResponse call
+func ParsePostApiMeWishlistResponse(rsp *http.Response) (*PostApiMeWishlistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiMeWishlistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Wishlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiMeWishlistItemResponse parses an HTTP response from a DeleteApiMeWishlistItemWithResponse call
+func ParseDeleteApiMeWishlistItemResponse(rsp *http.Response) (*DeleteApiMeWishlistItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiMeWishlistItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Wishlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMerchResponse parses an HTTP response from a GetApiMerchWithResponse call
+func ParseGetApiMerchResponse(rsp *http.Response) (*GetApiMerchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMerchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MerchPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiMerchCategoriesResponse parses an HTTP response from a GetApiMerchCategoriesWithResponse call
+func ParseGetApiMerchCategoriesResponse(rsp *http.Response) (*GetApiMerchCategoriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiMerchCategoriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []MerchCategory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiNotificationsResponse parses an HTTP response from a GetApiNotificationsWithResponse call
+func ParseGetApiNotificationsResponse(rsp *http.Response) (*GetApiNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Notification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiNotificationsPreferencesResponse parses an HTTP response from a GetApiNotificationsPreferencesWithResponse call
+func ParseGetApiNotificationsPreferencesResponse(rsp *http.Response) (*GetApiNotificationsPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiNotificationsPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationPreferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiNotificationsPreferencesResponse parses an HTTP response from a PutApiNotificationsPreferencesWithResponse call
+func ParsePutApiNotificationsPreferencesResponse(rsp *http.Response) (*PutApiNotificationsPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiNotificationsPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationPreferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiNotificationsReadAllResponse parses an HTTP response from a PostApiNotificationsReadAllWithResponse call
+func ParsePostApiNotificationsReadAllResponse(rsp *http.Response) (*PostApiNotificationsReadAllResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiNotificationsReadAllResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiNotificationsIdReadResponse parses an HTTP response from a PostApiNotificationsIdReadWithResponse call
+func ParsePostApiNotificationsIdReadResponse(rsp *http.Response) (*PostApiNotificationsIdReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiNotificationsIdReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Notification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiOrdersResponse parses an HTTP response from a GetApiOrdersWithResponse call
+func ParseGetApiOrdersResponse(rsp *http.Response) (*GetApiOrdersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiOrdersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Order
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiOrdersIdCancelResponse parses an HTTP response from a PostApiOrdersIdCancelWithResponse call
+func ParsePostApiOrdersIdCancelResponse(rsp *http.Response) (*PostApiOrdersIdCancelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiOrdersIdCancelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Order
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiRafflesResponse parses an HTTP response from a GetApiRafflesWithResponse call
+func ParseGetApiRafflesResponse(rsp *http.Response) (*GetApiRafflesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiRafflesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Raffle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiRafflesIdResponse parses an HTTP response from a GetApiRafflesIdWithResponse call
+func ParseGetApiRafflesIdResponse(rsp *http.Response) (*GetApiRafflesIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiRafflesIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Raffle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiRafflesIdTicketsResponse parses an HTTP response from a GetApiRafflesIdTicketsWithResponse call
+func ParseGetApiRafflesIdTicketsResponse(rsp *http.Response) (*GetApiRafflesIdTicketsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiRafflesIdTicketsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RaffleTicket
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiBuyItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParsePostApiRafflesIdTicketsResponse parses an HTTP response from a PostApiRafflesIdTicketsWithResponse call
+func ParsePostApiRafflesIdTicketsResponse(rsp *http.Response) (*PostApiRafflesIdTicketsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *InfoResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
+	response := &PostApiRafflesIdTicketsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RaffleTicket
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePostApiRefundItemResponse parses an HTTP response from a PostApiRefundItemWithResponse call
+func ParsePostApiRefundItemResponse(rsp *http.Response) (*PostApiRefundItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &PostApiRefundItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Refund
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParsePostApiSendCoinResponse parses an HTTP response from a PostApiSendCoinWithResponse call
+func ParsePostApiSendCoinResponse(rsp *http.Response) (*PostApiSendCoinResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &PostApiSendCoinResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-type PostApiSendCoinResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+// ParseGetApiV2MerchResponse parses an HTTP response from a GetApiV2MerchWithResponse call
+func ParseGetApiV2MerchResponse(rsp *http.Response) (*GetApiV2MerchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV2MerchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PostApiSendCoinResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MerchPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiSendCoinResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-// PostApiAuthWithBodyWithResponse request with arbitrary body returning *PostApiAuthResponse
-func (c *ClientWithResponses) PostApiAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error) {
-	rsp, err := c.PostApiAuthWithBody(ctx, contentType, body, reqEditors...)
+// ParsePostApiV2OrdersResponse parses an HTTP response from a PostApiV2OrdersWithResponse call
+func ParsePostApiV2OrdersResponse(rsp *http.Response) (*PostApiV2OrdersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiAuthResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostApiAuthWithResponse(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error) {
-	rsp, err := c.PostApiAuth(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostApiV2OrdersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostApiAuthResponse(rsp)
-}
 
-// GetApiBuyItemWithResponse request returning *GetApiBuyItemResponse
-func (c *ClientWithResponses) GetApiBuyItemWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*GetApiBuyItemResponse, error) {
-	rsp, err := c.GetApiBuyItem(ctx, item, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Order
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ParseGetApiBuyItemResponse(rsp)
+
+	return response, nil
 }
 
-// GetApiInfoWithResponse request returning *GetApiInfoResponse
-func (c *ClientWithResponses) GetApiInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiInfoResponse, error) {
-	rsp, err := c.GetApiInfo(ctx, reqEditors...)
+// ParseGetApiV2OrdersIdResponse parses an HTTP response from a GetApiV2OrdersIdWithResponse call
+func ParseGetApiV2OrdersIdResponse(rsp *http.Response) (*GetApiV2OrdersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiInfoResponse(rsp)
-}
 
-// PostApiSendCoinWithBodyWithResponse request with arbitrary body returning *PostApiSendCoinResponse
-func (c *ClientWithResponses) PostApiSendCoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error) {
-	rsp, err := c.PostApiSendCoinWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetApiV2OrdersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostApiSendCoinResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostApiSendCoinWithResponse(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error) {
-	rsp, err := c.PostApiSendCoin(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Order
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParsePostApiSendCoinResponse(rsp)
+
+	return response, nil
 }
 
-// ParsePostApiAuthResponse parses an HTTP response from a PostApiAuthWithResponse call
-func ParsePostApiAuthResponse(rsp *http.Response) (*PostApiAuthResponse, error) {
+// ParsePostApiV2TransfersResponse parses an HTTP response from a PostApiV2TransfersWithResponse call
+func ParsePostApiV2TransfersResponse(rsp *http.Response) (*PostApiV2TransfersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiAuthResponse{
+	response := &PostApiV2TransfersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthResponse
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON500 = &dest
 
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Transfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetApiBuyItemResponse parses an HTTP response from a GetApiBuyItemWithResponse call
-func ParseGetApiBuyItemResponse(rsp *http.Response) (*GetApiBuyItemResponse, error) {
+// ParseGetApiV2TransfersIdResponse parses an HTTP response from a GetApiV2TransfersIdWithResponse call
+func ParseGetApiV2TransfersIdResponse(rsp *http.Response) (*GetApiV2TransfersIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiBuyItemResponse{
+	response := &GetApiV2TransfersIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON500 = &dest
 
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Transfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetApiInfoResponse parses an HTTP response from a GetApiInfoWithResponse call
-func ParseGetApiInfoResponse(rsp *http.Response) (*GetApiInfoResponse, error) {
+// ParseGetApiV2UsersMeResponse parses an HTTP response from a GetApiV2UsersMeWithResponse call
+func ParseGetApiV2UsersMeResponse(rsp *http.Response) (*GetApiV2UsersMeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiInfoResponse{
+	response := &GetApiV2UsersMeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InfoResponse
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.ApplicationproblemJSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePostApiSendCoinResponse parses an HTTP response from a PostApiSendCoinWithResponse call
-func ParsePostApiSendCoinResponse(rsp *http.Response) (*PostApiSendCoinResponse, error) {
+// ParseGetApiV2UsersMeTransactionsResponse parses an HTTP response from a GetApiV2UsersMeTransactionsWithResponse call
+func ParseGetApiV2UsersMeTransactionsResponse(rsp *http.Response) (*GetApiV2UsersMeTransactionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiSendCoinResponse{
+	response := &GetApiV2UsersMeTransactionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON500 = &dest
 
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
 
 	return response, nil
@@ -0,0 +1,92 @@
+package testclient
+
+import (
+	"bufio"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type EventsParams struct {
+	Auth        string
+	LastEventID int64
+}
+
+type Event struct {
+	ID   int64
+	Kind string
+	Data string
+}
+
+// EventStream reads server-sent events of GET /api/events until the test ends
+type EventStream struct {
+	StatusCode int
+	events     chan Event
+}
+
+const eventWaitTimeout = 5 * time.Second
+
+func (r HttpClient) Events(t *testing.T, p EventsParams) *EventStream {
+	t.Helper()
+	t.Logf("Events send request with id %s", uuid.New().String())
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, r.BaseUrl+"/api/events", nil)
+	require.NoError(t, err)
+	if p.Auth != "" {
+		req.Header.Set("Authorization", "Bearer "+p.Auth)
+	}
+	if p.LastEventID > 0 {
+		req.Header.Set("Last-Event-ID", strconv.FormatInt(p.LastEventID, 10))
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { res.Body.Close() })
+
+	stream := &EventStream{
+		StatusCode: res.StatusCode,
+		events:     make(chan Event, 16),
+	}
+	go func() {
+		defer close(stream.events)
+
+		var event Event
+		scanner := bufio.NewScanner(res.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "":
+				if event.Kind != "" {
+					stream.events <- event
+				}
+				event = Event{}
+			case strings.HasPrefix(line, "id: "):
+				event.ID, _ = strconv.ParseInt(strings.TrimPrefix(line, "id: "), 10, 64)
+			case strings.HasPrefix(line, "event: "):
+				event.Kind = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				event.Data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}()
+	return stream
+}
+
+// Next waits for the next event, heartbeats are skipped
+func (s *EventStream) Next(t *testing.T) Event {
+	t.Helper()
+
+	select {
+	case event, ok := <-s.events:
+		require.True(t, ok, "event stream closed")
+		return event
+	case <-time.After(eventWaitTimeout):
+		require.FailNow(t, "no event in "+eventWaitTimeout.String())
+	}
+	return Event{}
+}
@@ -0,0 +1,57 @@
+package testclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type GraphQLParams struct {
+	Auth      string
+	Query     string
+	Variables map[string]any
+}
+
+type GraphQLError struct {
+	Message    string         `json:"message"`
+	Extensions map[string]any `json:"extensions"`
+}
+
+type GraphQLResponse struct {
+	StatusCode int
+	Data       json.RawMessage `json:"data"`
+	Errors     []GraphQLError  `json:"errors"`
+}
+
+// GraphQL posts the query to /api/graphql, the route is missing in the spec
+func (r HttpClient) GraphQL(t *testing.T, p GraphQLParams) GraphQLResponse {
+	t.Helper()
+	t.Logf("GraphQL send request with id %s", uuid.New().String())
+
+	body, err := json.Marshal(map[string]any{
+		"query":     p.Query,
+		"variables": p.Variables,
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, r.BaseUrl+"/api/graphql", bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if p.Auth != "" {
+		req.Header.Set("Authorization", "Bearer "+p.Auth)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	gqlRes := GraphQLResponse{StatusCode: res.StatusCode}
+	if res.StatusCode == http.StatusOK {
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&gqlRes))
+	}
+	return gqlRes
+}
@@ -0,0 +1,44 @@
+package testclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/maximmihin/aw25/internal/grpccontroller/merchpb"
+)
+
+func NewGrpcTestClient(t *testing.T, addr string) merchpb.MerchShopClient {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err, "fail to create grpc client for user service")
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	t.Logf("created user service grpc client with addr %s", addr)
+	return merchpb.NewMerchShopClient(conn)
+}
+
+// GrpcAuth adds the token to outgoing metadata as the grpc api expects it
+func GrpcAuth(ctx context.Context, accessToken string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+accessToken)
+}
+
+// GrpcErrorReason returns the api error code sent in ErrorInfo of the grpc status
+func GrpcErrorReason(t *testing.T, err error) string {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok, "not a grpc status error: %v", err)
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+	return ""
+}
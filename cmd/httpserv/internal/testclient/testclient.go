@@ -4,12 +4,15 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 )
 
 type HttpClient struct {
-	Client *ClientWithResponses
+	Client  *ClientWithResponses
+	BaseUrl string
 }
 
 func NewTestClient(t *testing.T, baseUrl string) HttpClient {
@@ -18,10 +21,37 @@ func NewTestClient(t *testing.T, baseUrl string) HttpClient {
 
 	t.Logf("created user service http client with baseUrl %s", baseUrl)
 	return HttpClient{
-		Client: usClient,
+		Client:  usClient,
+		BaseUrl: baseUrl,
 	}
 }
 
+// Get sends plain request to routes missing in the spec, like docs
+func (r HttpClient) Get(t *testing.T, path string) (*http.Response, []byte) {
+	t.Helper()
+	return r.GetWithAuth(t, "", path)
+}
+
+// GetWithAuth requests routes the generated client does not know, like ones missing in the spec
+func (r HttpClient) GetWithAuth(t *testing.T, auth string, path string) (*http.Response, []byte) {
+	t.Helper()
+	t.Logf("Get %s send request with id %s", path, uuid.New().String())
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, r.BaseUrl+path, nil)
+	require.NoError(t, err)
+	if auth != "" {
+		req.Header.Set("Authorization", "Bearer "+auth)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	return res, body
+}
+
 type AuthParams struct {
 	Password string
 	Username string
@@ -39,16 +69,152 @@ func (r HttpClient) Auth(t *testing.T, p AuthParams) *PostApiAuthResponse {
 	return res
 }
 
+func (r HttpClient) VerifyEmail(t *testing.T, token string) *PostApiAuthVerifyResponse {
+	t.Helper()
+	t.Logf("VerifyEmail send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAuthVerifyWithResponse(t.Context(), PostApiAuthVerifyJSONRequestBody{
+		Token: token,
+	})
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) SendVerifyEmail(t *testing.T, auth string) *PostApiAuthVerifySendResponse {
+	t.Helper()
+	t.Logf("SendVerifyEmail send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAuthVerifySendWithResponse(t.Context(), WithBearer(auth))
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) ForgotPassword(t *testing.T, username string) *PostApiAuthForgotResponse {
+	t.Helper()
+	t.Logf("ForgotPassword send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAuthForgotWithResponse(t.Context(), PostApiAuthForgotJSONRequestBody{
+		Username: username,
+	})
+	require.NoError(t, err)
+	return res
+}
+
+type ResetPasswordParams struct {
+	Token    string
+	Password string
+}
+
+func (r HttpClient) ResetPassword(t *testing.T, p ResetPasswordParams) *PostApiAuthResetResponse {
+	t.Helper()
+	t.Logf("ResetPassword send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAuthResetWithResponse(t.Context(), PostApiAuthResetJSONRequestBody{
+		Token:    p.Token,
+		Password: p.Password,
+	})
+	require.NoError(t, err)
+	return res
+}
+
+type LoginOTPParams struct {
+	Challenge string
+	Code      string
+}
+
+func (r HttpClient) LoginOTP(t *testing.T, p LoginOTPParams) *PostApiAuthOtpResponse {
+	t.Helper()
+	t.Logf("LoginOTP send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAuthOtpWithResponse(t.Context(), PostApiAuthOtpJSONRequestBody{
+		Challenge: p.Challenge,
+		Code:      p.Code,
+	})
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) GetTwoFactor(t *testing.T, auth string) *GetApiAuth2faResponse {
+	t.Helper()
+	t.Logf("GetTwoFactor send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiAuth2faWithResponse(t.Context(), WithBearer(auth))
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) EnrollTwoFactor(t *testing.T, auth string) *PostApiAuth2faEnrollResponse {
+	t.Helper()
+	t.Logf("EnrollTwoFactor send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAuth2faEnrollWithResponse(t.Context(), WithBearer(auth))
+	require.NoError(t, err)
+	return res
+}
+
+type TwoFactorCodeParams struct {
+	Auth string
+	Code string
+}
+
+func (r HttpClient) ConfirmTwoFactor(t *testing.T, p TwoFactorCodeParams) *PostApiAuth2faConfirmResponse {
+	t.Helper()
+	t.Logf("ConfirmTwoFactor send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAuth2faConfirmWithResponse(t.Context(), PostApiAuth2faConfirmJSONRequestBody{
+		Code: p.Code,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) DisableTwoFactor(t *testing.T, p TwoFactorCodeParams) *PostApiAuth2faDisableResponse {
+	t.Helper()
+	t.Logf("DisableTwoFactor send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAuth2faDisableWithResponse(t.Context(), PostApiAuth2faDisableJSONRequestBody{
+		Code: p.Code,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
 type BuyMerchParams struct {
 	Auth      string
 	MerchItem string
+	Variant   *string
+	PromoCode *string
 }
 
 func (r HttpClient) BuyMerch(t *testing.T, p BuyMerchParams) *GetApiBuyItemResponse {
 	t.Helper()
 	t.Logf("BuyMerch send request with id %s", uuid.New().String())
 
-	res, err := r.Client.GetApiBuyItemWithResponse(context.TODO(), p.MerchItem, WithBearer(p.Auth))
+	res, err := r.Client.GetApiBuyItemWithResponse(context.TODO(), p.MerchItem, &GetApiBuyItemParams{
+		Variant:   p.Variant,
+		PromoCode: p.PromoCode,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type GiftMerchParams struct {
+	Auth      string
+	MerchItem string
+	Variant   *string
+	ForUser   string
+	Message   *string
+}
+
+func (r HttpClient) GiftMerch(t *testing.T, p GiftMerchParams) *PostApiBuyItemResponse {
+	t.Helper()
+	t.Logf("GiftMerch send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiBuyItemWithResponse(context.TODO(), p.MerchItem, PostApiBuyItemJSONRequestBody{
+		ForUser: &p.ForUser,
+		Variant: p.Variant,
+		Message: p.Message,
+	}, WithBearer(p.Auth))
 	require.NoError(t, err)
 	return res
 }
@@ -57,16 +223,35 @@ type SendCoinParams struct {
 	Auth   string
 	Amount int
 	ToUser string
+	// Accept is sent as Accept header if set
+	Accept string
 }
 
 func (r HttpClient) SendCoins(t *testing.T, p SendCoinParams) *PostApiSendCoinResponse {
 	t.Helper()
 	t.Logf("SendCoins send request with id %s", uuid.New().String())
 
+	reqEditors := []RequestEditorFn{WithBearer(p.Auth)}
+	if p.Accept != "" {
+		reqEditors = append(reqEditors, WithAccept(p.Accept))
+	}
+
 	res, err := r.Client.PostApiSendCoinWithResponse(context.TODO(), PostApiSendCoinJSONRequestBody{
 		Amount: p.Amount,
 		ToUser: p.ToUser,
-	}, WithBearer(p.Auth))
+	}, reqEditors...)
+
+	require.NoError(t, err)
+	return res
+}
+
+// SendCoinsRaw sends body as is, to check requests not matching the spec
+func (r HttpClient) SendCoinsRaw(t *testing.T, auth string, body string) *PostApiSendCoinResponse {
+	t.Helper()
+	t.Logf("SendCoinsRaw send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiSendCoinWithBodyWithResponse(context.TODO(), "application/json",
+		strings.NewReader(body), WithBearer(auth))
 
 	require.NoError(t, err)
 	return res
@@ -91,3 +276,658 @@ func WithBearer(accessToken string) RequestEditorFn {
 		return nil
 	}
 }
+
+func WithAccept(mime string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Accept", mime)
+		return nil
+	}
+}
+
+func WithAcceptLanguage(lang string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Accept-Language", lang)
+		return nil
+	}
+}
+
+type RefundParams struct {
+	Auth      string
+	MerchItem string
+	Variant   *string
+}
+
+func (r HttpClient) RequestRefund(t *testing.T, p RefundParams) *PostApiRefundItemResponse {
+	t.Helper()
+	t.Logf("RequestRefund send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiRefundItemWithResponse(context.TODO(), p.MerchItem, &PostApiRefundItemParams{
+		Variant: p.Variant,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type ListRefundsParams struct {
+	Auth   string
+	Status *RefundStatus
+}
+
+func (r HttpClient) ListRefunds(t *testing.T, p ListRefundsParams) *GetApiAdminRefundsResponse {
+	t.Helper()
+	t.Logf("ListRefunds send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiAdminRefundsWithResponse(context.TODO(), &GetApiAdminRefundsParams{
+		Status: p.Status,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type ResolveRefundParams struct {
+	Auth     string
+	RefundId int64
+}
+
+func (r HttpClient) ApproveRefund(t *testing.T, p ResolveRefundParams) *PostApiAdminRefundsIdApproveResponse {
+	t.Helper()
+	t.Logf("ApproveRefund send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAdminRefundsIdApproveWithResponse(context.TODO(), p.RefundId, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) RejectRefund(t *testing.T, p ResolveRefundParams) *PostApiAdminRefundsIdRejectResponse {
+	t.Helper()
+	t.Logf("RejectRefund send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAdminRefundsIdRejectWithResponse(context.TODO(), p.RefundId, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type CreatePromotionParams struct {
+	Auth      string
+	Promotion CreatePromotionRequest
+}
+
+func (r HttpClient) CreatePromotion(t *testing.T, p CreatePromotionParams) *PostApiAdminPromotionsResponse {
+	t.Helper()
+	t.Logf("CreatePromotion send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAdminPromotionsWithResponse(context.TODO(), p.Promotion, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type CreateBundleParams struct {
+	Auth   string
+	Bundle Bundle
+}
+
+func (r HttpClient) CreateBundle(t *testing.T, p CreateBundleParams) *PostApiAdminBundlesResponse {
+	t.Helper()
+	t.Logf("CreateBundle send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAdminBundlesWithResponse(context.TODO(), p.Bundle, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type BuyBundleParams struct {
+	Auth   string
+	Bundle string
+}
+
+func (r HttpClient) BuyBundle(t *testing.T, p BuyBundleParams) *PostApiBundlesBundleBuyResponse {
+	t.Helper()
+	t.Logf("BuyBundle send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiBundlesBundleBuyWithResponse(context.TODO(), p.Bundle, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type ListMyOrdersParams struct {
+	Auth string
+}
+
+func (r HttpClient) ListMyOrders(t *testing.T, p ListMyOrdersParams) *GetApiOrdersResponse {
+	t.Helper()
+	t.Logf("ListMyOrders send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiOrdersWithResponse(context.TODO(), WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type ListOrdersParams struct {
+	Auth   string
+	Status *OrderStatus
+}
+
+func (r HttpClient) ListOrders(t *testing.T, p ListOrdersParams) *GetApiAdminOrdersResponse {
+	t.Helper()
+	t.Logf("ListOrders send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiAdminOrdersWithResponse(context.TODO(), &GetApiAdminOrdersParams{
+		Status: p.Status,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type OrderParams struct {
+	Auth    string
+	OrderId int64
+}
+
+func (r HttpClient) CancelMyOrder(t *testing.T, p OrderParams) *PostApiOrdersIdCancelResponse {
+	t.Helper()
+	t.Logf("CancelMyOrder send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiOrdersIdCancelWithResponse(context.TODO(), p.OrderId, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) MarkOrderReady(t *testing.T, p OrderParams) *PostApiAdminOrdersIdReadyResponse {
+	t.Helper()
+	t.Logf("MarkOrderReady send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAdminOrdersIdReadyWithResponse(context.TODO(), p.OrderId, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) CancelOrder(t *testing.T, p OrderParams) *PostApiAdminOrdersIdCancelResponse {
+	t.Helper()
+	t.Logf("CancelOrder send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAdminOrdersIdCancelWithResponse(context.TODO(), p.OrderId, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type HandOverOrderParams struct {
+	Auth       string
+	OrderId    int64
+	PickupCode string
+}
+
+func (r HttpClient) HandOverOrder(t *testing.T, p HandOverOrderParams) *PostApiAdminOrdersIdHandoverResponse {
+	t.Helper()
+	t.Logf("HandOverOrder send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAdminOrdersIdHandoverWithResponse(context.TODO(), p.OrderId, PostApiAdminOrdersIdHandoverJSONRequestBody{
+		PickupCode: p.PickupCode,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type CreateListingParams struct {
+	Auth    string
+	Listing CreateListingRequest
+}
+
+func (r HttpClient) CreateListing(t *testing.T, p CreateListingParams) *PostApiMarketListingsResponse {
+	t.Helper()
+	t.Logf("CreateListing send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiMarketListingsWithResponse(context.TODO(), p.Listing, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type BuyListingParams struct {
+	Auth      string
+	ListingId int64
+	Quantity  *int64
+}
+
+func (r HttpClient) BuyListing(t *testing.T, p BuyListingParams) *PostApiMarketListingsIdBuyResponse {
+	t.Helper()
+	t.Logf("BuyListing send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiMarketListingsIdBuyWithResponse(context.TODO(), p.ListingId, PostApiMarketListingsIdBuyJSONRequestBody{
+		Quantity: p.Quantity,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type CancelListingParams struct {
+	Auth      string
+	ListingId int64
+}
+
+func (r HttpClient) CancelListing(t *testing.T, p CancelListingParams) *PostApiMarketListingsIdCancelResponse {
+	t.Helper()
+	t.Logf("CancelListing send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiMarketListingsIdCancelWithResponse(context.TODO(), p.ListingId, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) ListMerch(t *testing.T, p GetApiMerchParams, reqEditors ...RequestEditorFn) *GetApiMerchResponse {
+	t.Helper()
+	t.Logf("ListMerch send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiMerchWithResponse(context.TODO(), &p, reqEditors...)
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) ListMerchCategories(t *testing.T, reqEditors ...RequestEditorFn) *GetApiMerchCategoriesResponse {
+	t.Helper()
+	t.Logf("ListMerchCategories send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiMerchCategoriesWithResponse(context.TODO(), reqEditors...)
+	require.NoError(t, err)
+	return res
+}
+
+type WishlistParams struct {
+	Auth string
+}
+
+func (r HttpClient) GetWishlist(t *testing.T, p WishlistParams) *GetApiMeWishlistResponse {
+	t.Helper()
+	t.Logf("GetWishlist send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiMeWishlistWithResponse(context.TODO(), WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type WishlistItemParams struct {
+	Auth      string
+	MerchItem string
+	Variant   *string
+}
+
+func (r HttpClient) AddWishlistItem(t *testing.T, p WishlistItemParams) *PostApiMeWishlistResponse {
+	t.Helper()
+	t.Logf("AddWishlistItem send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiMeWishlistWithResponse(context.TODO(), PostApiMeWishlistJSONRequestBody{
+		Item:    p.MerchItem,
+		Variant: p.Variant,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) DeleteWishlistItem(t *testing.T, p WishlistItemParams) *DeleteApiMeWishlistItemResponse {
+	t.Helper()
+	t.Logf("DeleteWishlistItem send request with id %s", uuid.New().String())
+
+	res, err := r.Client.DeleteApiMeWishlistItemWithResponse(context.TODO(), p.MerchItem, &DeleteApiMeWishlistItemParams{
+		Variant: p.Variant,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type ListNotificationsParams struct {
+	Auth   string
+	Unread *bool
+	Limit  *int32
+	Offset *int32
+}
+
+func (r HttpClient) ListNotifications(t *testing.T, p ListNotificationsParams) *GetApiNotificationsResponse {
+	t.Helper()
+	t.Logf("ListNotifications send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiNotificationsWithResponse(context.TODO(), &GetApiNotificationsParams{
+		Unread: p.Unread,
+		Limit:  p.Limit,
+		Offset: p.Offset,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type NotificationParams struct {
+	Auth           string
+	NotificationId int64
+}
+
+func (r HttpClient) ReadNotification(t *testing.T, p NotificationParams) *PostApiNotificationsIdReadResponse {
+	t.Helper()
+	t.Logf("ReadNotification send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiNotificationsIdReadWithResponse(context.TODO(), p.NotificationId, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) ReadAllNotifications(t *testing.T, auth string) *PostApiNotificationsReadAllResponse {
+	t.Helper()
+	t.Logf("ReadAllNotifications send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiNotificationsReadAllWithResponse(context.TODO(), WithBearer(auth))
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) GetNotificationPreferences(t *testing.T, auth string) *GetApiNotificationsPreferencesResponse {
+	t.Helper()
+	t.Logf("GetNotificationPreferences send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiNotificationsPreferencesWithResponse(context.TODO(), WithBearer(auth))
+	require.NoError(t, err)
+	return res
+}
+
+type SetNotificationPreferencesParams struct {
+	Auth  string
+	Muted []NotificationKind
+	Email *EmailPreferences
+}
+
+func (r HttpClient) SetNotificationPreferences(t *testing.T, p SetNotificationPreferencesParams) *PutApiNotificationsPreferencesResponse {
+	t.Helper()
+	t.Logf("SetNotificationPreferences send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PutApiNotificationsPreferencesWithResponse(context.TODO(), PutApiNotificationsPreferencesJSONRequestBody{
+		Muted: p.Muted,
+		Email: p.Email,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type RestockParams struct {
+	Auth      string
+	MerchItem string
+	Variant   string
+	Quantity  int64
+}
+
+func (r HttpClient) RestockMerchVariant(t *testing.T, p RestockParams) *PostApiAdminMerchItemVariantsVariantRestockResponse {
+	t.Helper()
+	t.Logf("RestockMerchVariant send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAdminMerchItemVariantsVariantRestockWithResponse(context.TODO(), p.MerchItem, p.Variant, RestockRequest{
+		Quantity: p.Quantity,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type CreateAuctionParams struct {
+	Auth    string
+	Auction CreateAuctionRequest
+}
+
+func (r HttpClient) CreateAuction(t *testing.T, p CreateAuctionParams) *PostApiAdminAuctionsResponse {
+	t.Helper()
+	t.Logf("CreateAuction send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAdminAuctionsWithResponse(context.TODO(), p.Auction, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type AuctionParams struct {
+	Auth string
+	Id   int64
+}
+
+func (r HttpClient) GetAuction(t *testing.T, p AuctionParams) *GetApiAuctionsIdResponse {
+	t.Helper()
+	t.Logf("GetAuction send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiAuctionsIdWithResponse(context.TODO(), p.Id, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type PlaceBidParams struct {
+	Auth   string
+	Id     int64
+	Amount int64
+}
+
+func (r HttpClient) PlaceBid(t *testing.T, p PlaceBidParams) *PostApiAuctionsIdBidsResponse {
+	t.Helper()
+	t.Logf("PlaceBid send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAuctionsIdBidsWithResponse(context.TODO(), p.Id, PlaceBidRequest{
+		Amount: p.Amount,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type CreateRaffleParams struct {
+	Auth   string
+	Raffle CreateRaffleRequest
+}
+
+func (r HttpClient) CreateRaffle(t *testing.T, p CreateRaffleParams) *PostApiAdminRafflesResponse {
+	t.Helper()
+	t.Logf("CreateRaffle send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAdminRafflesWithResponse(context.TODO(), p.Raffle, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type RaffleParams struct {
+	Auth string
+	Id   int64
+}
+
+func (r HttpClient) GetRaffle(t *testing.T, p RaffleParams) *GetApiRafflesIdResponse {
+	t.Helper()
+	t.Logf("GetRaffle send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiRafflesIdWithResponse(context.TODO(), p.Id, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) ListRaffleTickets(t *testing.T, p RaffleParams) *GetApiRafflesIdTicketsResponse {
+	t.Helper()
+	t.Logf("ListRaffleTickets send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiRafflesIdTicketsWithResponse(context.TODO(), p.Id, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) DrawRaffle(t *testing.T, p RaffleParams) *PostApiAdminRafflesIdDrawResponse {
+	t.Helper()
+	t.Logf("DrawRaffle send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAdminRafflesIdDrawWithResponse(context.TODO(), p.Id, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type BuyRaffleTicketsParams struct {
+	Auth     string
+	Id       int64
+	Quantity *int64
+}
+
+func (r HttpClient) BuyRaffleTickets(t *testing.T, p BuyRaffleTicketsParams) *PostApiRafflesIdTicketsResponse {
+	t.Helper()
+	t.Logf("BuyRaffleTickets send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiRafflesIdTicketsWithResponse(context.TODO(), p.Id, BuyRaffleTicketsRequest{
+		Quantity: p.Quantity,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type GetMeParams struct {
+	Auth string
+}
+
+func (r HttpClient) GetMe(t *testing.T, p GetMeParams) *GetApiV2UsersMeResponse {
+	t.Helper()
+	t.Logf("GetMe send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiV2UsersMeWithResponse(context.TODO(), WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type ListMyTransactionsParams struct {
+	Auth   string
+	Limit  *int32
+	Offset *int32
+}
+
+func (r HttpClient) ListMyTransactions(t *testing.T, p ListMyTransactionsParams) *GetApiV2UsersMeTransactionsResponse {
+	t.Helper()
+	t.Logf("ListMyTransactions send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiV2UsersMeTransactionsWithResponse(context.TODO(), &GetApiV2UsersMeTransactionsParams{
+		Limit:  p.Limit,
+		Offset: p.Offset,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type CreateTransferParams struct {
+	Auth   string
+	Amount int
+	ToUser string
+}
+
+func (r HttpClient) CreateTransfer(t *testing.T, p CreateTransferParams) *PostApiV2TransfersResponse {
+	t.Helper()
+	t.Logf("CreateTransfer send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiV2TransfersWithResponse(context.TODO(), PostApiV2TransfersJSONRequestBody{
+		Amount: p.Amount,
+		ToUser: p.ToUser,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type TransferParams struct {
+	Auth       string
+	TransferId int64
+}
+
+func (r HttpClient) GetTransfer(t *testing.T, p TransferParams) *GetApiV2TransfersIdResponse {
+	t.Helper()
+	t.Logf("GetTransfer send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiV2TransfersIdWithResponse(context.TODO(), p.TransferId, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type CreateOrderParams struct {
+	Auth      string
+	MerchItem string
+	Variant   *string
+	ForUser   *string
+}
+
+func (r HttpClient) CreateOrder(t *testing.T, p CreateOrderParams) *PostApiV2OrdersResponse {
+	t.Helper()
+	t.Logf("CreateOrder send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiV2OrdersWithResponse(context.TODO(), PostApiV2OrdersJSONRequestBody{
+		Item:    p.MerchItem,
+		Variant: p.Variant,
+		ForUser: p.ForUser,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+func (r HttpClient) GetOrder(t *testing.T, p OrderParams) *GetApiV2OrdersIdResponse {
+	t.Helper()
+	t.Logf("GetOrder send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiV2OrdersIdWithResponse(context.TODO(), p.OrderId, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type CreateWebhookParams struct {
+	Auth    string
+	Webhook CreateWebhookSubscriptionRequest
+}
+
+func (r HttpClient) CreateWebhook(t *testing.T, p CreateWebhookParams) *PostApiAdminWebhooksResponse {
+	t.Helper()
+	t.Logf("CreateWebhook send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAdminWebhooksWithResponse(context.TODO(), p.Webhook, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type ListWebhooksParams struct {
+	Auth string
+}
+
+func (r HttpClient) ListWebhooks(t *testing.T, p ListWebhooksParams) *GetApiAdminWebhooksResponse {
+	t.Helper()
+	t.Logf("ListWebhooks send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiAdminWebhooksWithResponse(context.TODO(), WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type WebhookParams struct {
+	Auth      string
+	WebhookId int64
+}
+
+func (r HttpClient) DeleteWebhook(t *testing.T, p WebhookParams) *DeleteApiAdminWebhooksIdResponse {
+	t.Helper()
+	t.Logf("DeleteWebhook send request with id %s", uuid.New().String())
+
+	res, err := r.Client.DeleteApiAdminWebhooksIdWithResponse(context.TODO(), p.WebhookId, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type ListWebhookDeliveriesParams struct {
+	Auth      string
+	WebhookId int64
+	Status    *WebhookDeliveryStatus
+}
+
+func (r HttpClient) ListWebhookDeliveries(t *testing.T, p ListWebhookDeliveriesParams) *GetApiAdminWebhooksIdDeliveriesResponse {
+	t.Helper()
+	t.Logf("ListWebhookDeliveries send request with id %s", uuid.New().String())
+
+	res, err := r.Client.GetApiAdminWebhooksIdDeliveriesWithResponse(context.TODO(), p.WebhookId, &GetApiAdminWebhooksIdDeliveriesParams{
+		Status: p.Status,
+	}, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
+
+type RedeliverWebhookParams struct {
+	Auth       string
+	DeliveryId int64
+}
+
+func (r HttpClient) RedeliverWebhook(t *testing.T, p RedeliverWebhookParams) *PostApiAdminWebhookDeliveriesIdRedeliverResponse {
+	t.Helper()
+	t.Logf("RedeliverWebhook send request with id %s", uuid.New().String())
+
+	res, err := r.Client.PostApiAdminWebhookDeliveriesIdRedeliverWithResponse(context.TODO(), p.DeliveryId, WithBearer(p.Auth))
+	require.NoError(t, err)
+	return res
+}
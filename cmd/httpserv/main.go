@@ -2,6 +2,9 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	jwtware "github.com/gofiber/contrib/jwt"
 	"github.com/gofiber/fiber/v2"
@@ -9,13 +12,22 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/requestid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+	"github.com/maximmihin/aw25/internal/eventbus"
+	"github.com/maximmihin/aw25/internal/graphqlcontroller"
+	"github.com/maximmihin/aw25/internal/grpccontroller"
 	handlers "github.com/maximmihin/aw25/internal/httpcontroller"
+	"github.com/maximmihin/aw25/internal/mailer"
 	slogfiber "github.com/samber/slog-fiber"
+	"google.golang.org/grpc"
 	"log/slog"
 	"net"
+	"net/url"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
@@ -27,9 +39,80 @@ type Config struct {
 	HttpServiceHost string
 	HttpServicePort string
 
+	// GrpcServiceHost and GrpcServicePort are the address of the grpc api, it is off if the port is empty
+	GrpcServiceHost string
+	GrpcServicePort string
+
 	LogLevel string
+
+	// AdminUsers is a comma separated list of user names with admin rights
+	AdminUsers string
+
+	// RefundWindow is a time.ParseDuration string, defaultRefundWindow if empty
+	RefundWindow string
+
+	// MarketFeePercent is an integer percent of marketplace sales taken by the shop, 0 if empty
+	MarketFeePercent string
+	// HouseAccount is a user name receiving marketplace fees, created on start if missing
+	HouseAccount string
+
+	// AuctionCloseInterval is a time.ParseDuration string, defaultAuctionCloseInterval if empty
+	AuctionCloseInterval string
+
+	// WebhookDispatchInterval is a time.ParseDuration string, defaultWebhookDispatchInterval if empty
+	WebhookDispatchInterval string
+	// WebhookRetryDelay is a time.ParseDuration string of the delay after the first failed delivery, defaultWebhookRetryDelay if empty
+	WebhookRetryDelay string
+
+	// EventBus is "memory" or "nats" to publish domain events of the outbox, the bus is off if empty
+	EventBus string
+	// NatsURL is the nats server of the "nats" event bus, jetstream must be enabled on it
+	NatsURL string
+	// EventBusPublishInterval is a time.ParseDuration string, defaultEventBusPublishInterval if empty
+	EventBusPublishInterval string
+
+	// SmtpAddr is the host:port of the smtp server for email notifications, emails are off if empty
+	SmtpAddr string
+	// SmtpFrom is the sender address of emails, required with SmtpAddr
+	SmtpFrom string
+	// SmtpUsername and SmtpPassword enable PLAIN auth if the username is not empty
+	SmtpUsername string
+	SmtpPassword string
+	// EmailSendInterval is a time.ParseDuration string, defaultEmailSendInterval if empty
+	EmailSendInterval string
+	// EmailRetryDelay is a time.ParseDuration string of the delay after the first failed email, defaultEmailRetryDelay if empty
+	EmailRetryDelay string
+	// RequireEmailVerification is "true" to forbid sending coins until the user verifies the email
+	RequireEmailVerification string
+
+	// ValidateResponses is "true" to check every response against the openapi spec, for tests
+	ValidateResponses string
+
+	// DisableDocs is "true" to hide the openapi spec and swagger ui, for production
+	DisableDocs string
+	// PublicURL is the absolute url clients reach the http api at, like https://shop.example.com behind a proxy.
+	// Docs send "try it out" requests to it, or to http://HttpServiceHost:HttpServicePort if empty
+	PublicURL string
 }
 
+const defaultRefundWindow = 14 * 24 * time.Hour
+
+const defaultAuctionCloseInterval = 5 * time.Second
+
+const eventListenerRetryInterval = time.Second
+
+const (
+	defaultWebhookDispatchInterval = time.Second
+	defaultWebhookRetryDelay       = 10 * time.Second
+)
+
+const defaultEventBusPublishInterval = time.Second
+
+const (
+	defaultEmailSendInterval = time.Second
+	defaultEmailRetryDelay   = 30 * time.Second
+)
+
 func main() {
 	cfg := Config{
 		PostgresConnString: fmt.Sprintf("user=%s password=%s dbname=%s host=%s port=%s sslmode=disable",
@@ -45,7 +128,38 @@ func main() {
 		HttpServiceHost: os.Getenv("HTTP_SERVICE_HOST"),
 		HttpServicePort: os.Getenv("HTTP_SERVICE_PORT"),
 
+		GrpcServiceHost: os.Getenv("GRPC_SERVICE_HOST"),
+		GrpcServicePort: os.Getenv("GRPC_SERVICE_PORT"),
+
 		LogLevel: os.Getenv("LOG_LEVEL"),
+
+		AdminUsers:   os.Getenv("ADMIN_USERS"),
+		RefundWindow: os.Getenv("REFUND_WINDOW"),
+
+		MarketFeePercent: os.Getenv("MARKET_FEE_PERCENT"),
+		HouseAccount:     os.Getenv("HOUSE_ACCOUNT"),
+
+		AuctionCloseInterval: os.Getenv("AUCTION_CLOSE_INTERVAL"),
+
+		WebhookDispatchInterval: os.Getenv("WEBHOOK_DISPATCH_INTERVAL"),
+		WebhookRetryDelay:       os.Getenv("WEBHOOK_RETRY_DELAY"),
+
+		EventBus:                os.Getenv("EVENT_BUS"),
+		NatsURL:                 os.Getenv("NATS_URL"),
+		EventBusPublishInterval: os.Getenv("EVENT_BUS_PUBLISH_INTERVAL"),
+
+		SmtpAddr:          os.Getenv("SMTP_ADDR"),
+		SmtpFrom:          os.Getenv("SMTP_FROM"),
+		SmtpUsername:      os.Getenv("SMTP_USERNAME"),
+		SmtpPassword:      os.Getenv("SMTP_PASSWORD"),
+		EmailSendInterval: os.Getenv("EMAIL_SEND_INTERVAL"),
+		EmailRetryDelay:   os.Getenv("EMAIL_RETRY_DELAY"),
+
+		RequireEmailVerification: os.Getenv("REQUIRE_EMAIL_VERIFICATION"),
+
+		ValidateResponses: os.Getenv("VALIDATE_RESPONSES"),
+		DisableDocs:       os.Getenv("DISABLE_DOCS"),
+		PublicURL:         os.Getenv("PUBLIC_URL"),
 	}
 
 	err := Run(cfg)
@@ -59,6 +173,12 @@ var merchShopHttpServiceFiberOnListenFunc fiber.OnListenHandler
 
 var merchShopHttpServiceLogger *slog.Logger
 
+// merchShopGrpcServiceOnListenFunc gets the address of the grpc api, e2e tests use it to find a random port
+var merchShopGrpcServiceOnListenFunc func(addr net.Addr)
+
+// merchShopEventBus replaces the publisher of Config.EventBus, e2e tests read published events from it
+var merchShopEventBus eventbus.Publisher
+
 func Run(cfg Config) error {
 
 	ctx := context.TODO()
@@ -68,6 +188,141 @@ func Run(cfg Config) error {
 		return fmt.Errorf("log level need to be valid int")
 	}
 
+	refundWindow := defaultRefundWindow
+	if cfg.RefundWindow != "" {
+		refundWindow, err = time.ParseDuration(cfg.RefundWindow)
+		if err != nil || refundWindow <= 0 {
+			return fmt.Errorf("refund window need to be valid positive duration")
+		}
+	}
+
+	var marketFeePercent int64
+	if cfg.MarketFeePercent != "" {
+		marketFeePercent, err = strconv.ParseInt(cfg.MarketFeePercent, 10, 64)
+		if err != nil || marketFeePercent < 0 || marketFeePercent > 100 {
+			return fmt.Errorf("market fee percent need to be valid int from 0 to 100")
+		}
+	}
+	if marketFeePercent > 0 && cfg.HouseAccount == "" {
+		return fmt.Errorf("house account need to be set to collect market fee")
+	}
+
+	auctionCloseInterval := defaultAuctionCloseInterval
+	if cfg.AuctionCloseInterval != "" {
+		auctionCloseInterval, err = time.ParseDuration(cfg.AuctionCloseInterval)
+		if err != nil || auctionCloseInterval <= 0 {
+			return fmt.Errorf("auction close interval need to be valid positive duration")
+		}
+	}
+
+	webhookDispatchInterval := defaultWebhookDispatchInterval
+	if cfg.WebhookDispatchInterval != "" {
+		webhookDispatchInterval, err = time.ParseDuration(cfg.WebhookDispatchInterval)
+		if err != nil || webhookDispatchInterval <= 0 {
+			return fmt.Errorf("webhook dispatch interval need to be valid positive duration")
+		}
+	}
+
+	webhookRetryDelay := defaultWebhookRetryDelay
+	if cfg.WebhookRetryDelay != "" {
+		webhookRetryDelay, err = time.ParseDuration(cfg.WebhookRetryDelay)
+		if err != nil || webhookRetryDelay <= 0 {
+			return fmt.Errorf("webhook retry delay need to be valid positive duration")
+		}
+	}
+
+	switch cfg.EventBus {
+	case "", "memory":
+	case "nats":
+		if cfg.NatsURL == "" {
+			return fmt.Errorf("nats url need to be set for nats event bus")
+		}
+	default:
+		return fmt.Errorf("event bus need to be \"memory\", \"nats\" or empty")
+	}
+
+	eventBusPublishInterval := defaultEventBusPublishInterval
+	if cfg.EventBusPublishInterval != "" {
+		eventBusPublishInterval, err = time.ParseDuration(cfg.EventBusPublishInterval)
+		if err != nil || eventBusPublishInterval <= 0 {
+			return fmt.Errorf("event bus publish interval need to be valid positive duration")
+		}
+	}
+
+	var mail *mailer.SMTP
+	if cfg.SmtpAddr != "" {
+		if cfg.SmtpFrom == "" {
+			return fmt.Errorf("smtp from need to be set for emails")
+		}
+		if mail, err = mailer.NewSMTP(cfg.SmtpAddr, cfg.SmtpFrom, cfg.SmtpUsername, cfg.SmtpPassword); err != nil {
+			return err
+		}
+	}
+
+	emailSendInterval := defaultEmailSendInterval
+	if cfg.EmailSendInterval != "" {
+		emailSendInterval, err = time.ParseDuration(cfg.EmailSendInterval)
+		if err != nil || emailSendInterval <= 0 {
+			return fmt.Errorf("email send interval need to be valid positive duration")
+		}
+	}
+
+	emailRetryDelay := defaultEmailRetryDelay
+	if cfg.EmailRetryDelay != "" {
+		emailRetryDelay, err = time.ParseDuration(cfg.EmailRetryDelay)
+		if err != nil || emailRetryDelay <= 0 {
+			return fmt.Errorf("email retry delay need to be valid positive duration")
+		}
+	}
+
+	var requireEmailVerification bool
+	if cfg.RequireEmailVerification != "" {
+		requireEmailVerification, err = strconv.ParseBool(cfg.RequireEmailVerification)
+		if err != nil {
+			return fmt.Errorf("require email verification need to be valid bool")
+		}
+	}
+	if requireEmailVerification && cfg.SmtpAddr == "" {
+		return fmt.Errorf("smtp addr need to be set to require email verification")
+	}
+
+	var validateResponses bool
+	if cfg.ValidateResponses != "" {
+		validateResponses, err = strconv.ParseBool(cfg.ValidateResponses)
+		if err != nil {
+			return fmt.Errorf("validate responses need to be valid bool")
+		}
+	}
+
+	var disableDocs bool
+	if cfg.DisableDocs != "" {
+		disableDocs, err = strconv.ParseBool(cfg.DisableDocs)
+		if err != nil {
+			return fmt.Errorf("disable docs need to be valid bool")
+		}
+	}
+
+	// the listen address is reachable from the host itself, servers listening on all interfaces too
+	publicHost := cfg.HttpServiceHost
+	if ip := net.ParseIP(publicHost); publicHost == "" || ip != nil && ip.IsUnspecified() {
+		publicHost = "localhost"
+	}
+	publicURL := "http://" + net.JoinHostPort(publicHost, cfg.HttpServicePort)
+	if cfg.PublicURL != "" {
+		parsed, err := url.Parse(cfg.PublicURL)
+		if err != nil || !parsed.IsAbs() || parsed.Host == "" {
+			return fmt.Errorf("public url need to be valid absolute url")
+		}
+		publicURL = cfg.PublicURL
+	}
+
+	var admins []string
+	for _, admin := range strings.Split(cfg.AdminUsers, ",") {
+		if admin = strings.TrimSpace(admin); admin != "" {
+			admins = append(admins, admin)
+		}
+	}
+
 	if merchShopHttpServiceLogger == nil {
 		merchShopHttpServiceLogger = slog.New(
 			slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
@@ -94,6 +349,8 @@ func Run(cfg Config) error {
 		WithRequestHeader:  true,
 		WithResponseBody:   true,
 		WithResponseHeader: true,
+		// reading the body of an event stream waits for its end
+		Filters: []slogfiber.Filter{slogfiber.IgnorePath("/api/events")},
 	}))
 	app.Use(recover.New())
 	app.Use(requestid.New())
@@ -109,25 +366,96 @@ func Run(cfg Config) error {
 		return err
 	}
 
+	if cfg.HouseAccount != "" {
+		if err = ensureHouseAccount(ctx, db, cfg.HouseAccount); err != nil {
+			return err
+		}
+	}
+
+	eventBus := merchShopEventBus
+	if eventBus == nil {
+		switch cfg.EventBus {
+		case "memory":
+			eventBus = eventbus.NewMemory()
+		case "nats":
+			if eventBus, err = eventbus.NewNATS(ctx, cfg.NatsURL); err != nil {
+				return err
+			}
+		}
+	}
+	if eventBus != nil {
+		defer func() {
+			if err := eventBus.Close(); err != nil {
+				log.Error("fail to close event bus: " + err.Error())
+			}
+		}()
+	}
+
 	h := handlers.Handlers{
 		Dal:           db,
 		Logger:        log,
 		JWTPrivateKey: cfg.JwtPrivateKey,
+		Admins:        admins,
+		RefundWindow:  refundWindow,
+
+		MarketFeePercent: marketFeePercent,
+		HouseAccount:     cfg.HouseAccount,
+
+		Events: handlers.NewEventHub(),
+
+		WebhookRetryDelay: webhookRetryDelay,
+
+		EventBus: eventBus,
+
+		EmailRetryDelay:      emailRetryDelay,
+		RequireVerifiedEmail: requireEmailVerification,
+	}
+	// a nil *mailer.SMTP in the interface would not be nil
+	if mail != nil {
+		h.Mailer = mail
 	}
 
-	api := app.Group("/api")
-	api.Post("/auth", h.Auth)
+	openAPI, err := handlers.NewOpenAPI(log, validateResponses)
+	if err != nil {
+		return err
+	}
 
-	secured := api.Group("")
-	secured.Use(jwtware.New(jwtware.Config{
+	jwtConfig := jwtware.Config{
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
-			return handlers.ResErr(c, 401, "problems with token")
+			return handlers.ResErr(c, 401, handlers.ErrorCodeUNAUTHORIZED, "problems with token")
 		},
 		SigningKey: jwtware.SigningKey{Key: []byte(cfg.JwtPublicKey)},
+	}
+
+	// routes outside of the spec are registered before the spec middlewares, they never reach them
+	if !disableDocs {
+		docs, err := handlers.NewDocs(publicURL)
+		if err != nil {
+			return err
+		}
+		docs.Register(app)
+	}
+
+	gql, err := graphqlcontroller.New(h, log)
+	if err != nil {
+		return err
+	}
+	app.Post("/api/graphql", jwtware.New(jwtConfig), gql.Handle)
+	app.Get("/api/events", jwtware.New(jwtConfig), h.GetEvents)
+
+	app.Use(openAPI.Deprecation)
+	// everything but operations declared public in the spec needs a token, unknown routes too
+	specJwtConfig := jwtConfig
+	specJwtConfig.Filter = openAPI.IsPublic
+	app.Use(jwtware.New(specJwtConfig))
+	app.Use("/api/admin", h.AdminOnly)
+	app.Use(openAPI.Validate)
+
+	// the last strict middleware is the outermost one, so StrictErrors sees errors of RequestContext too
+	handlers.RegisterHandlers(app, handlers.NewStrictHandler(h, []handlers.StrictMiddlewareFunc{
+		h.RequestContext,
+		h.StrictErrors,
 	}))
-	secured.Get("/buy/:item", h.BuyMerch)
-	secured.Post("/sendCoin", h.CoinTransfer)
-	secured.Get("/info", h.Info)
 
 	if merchShopHttpServiceFiberOnListenFunc != nil {
 		app.Hooks().OnListen(merchShopHttpServiceFiberOnListenFunc)
@@ -141,7 +469,46 @@ func Run(cfg Config) error {
 	gc := make(chan os.Signal, 1)
 	signal.Notify(gc, os.Interrupt)
 
-	errC := make(chan error, 1)
+	workersCtx, stopWorkers := context.WithCancel(ctx)
+	defer stopWorkers()
+
+	go h.RunAuctionCloser(workersCtx, auctionCloseInterval)
+	go h.RunEventListener(workersCtx, eventListenerRetryInterval)
+	go h.RunWebhookDispatcher(workersCtx, webhookDispatchInterval)
+	if eventBus != nil {
+		go h.RunEventPublisher(workersCtx, eventBusPublishInterval)
+	}
+	if h.Mailer != nil {
+		go h.RunEmailSender(workersCtx, emailSendInterval)
+	}
+
+	errC := make(chan error, 2)
+
+	var grpcServer *grpc.Server
+	if cfg.GrpcServicePort != "" {
+		grpcListener, err := net.Listen("tcp", net.JoinHostPort(cfg.GrpcServiceHost, cfg.GrpcServicePort))
+		if err != nil {
+			return fmt.Errorf("fail to listen grpc address: %w", err)
+		}
+		grpcServer = (&grpccontroller.Server{
+			Handlers: h,
+			Logger: merchShopHttpServiceLogger.With(
+				slog.String("service", "merch_shop"),
+				slog.String("app", "grpc_server"),
+			),
+			JWTPublicKey: cfg.JwtPublicKey,
+		}).NewGRPCServer()
+
+		if merchShopGrpcServiceOnListenFunc != nil {
+			merchShopGrpcServiceOnListenFunc(grpcListener.Addr())
+		}
+		go func() {
+			if err := grpcServer.Serve(grpcListener); err != nil {
+				log.Error(err.Error())
+				errC <- err
+			}
+		}()
+	}
 
 	go func() {
 		err = app.Listen(net.JoinHostPort(cfg.HttpServiceHost, cfg.HttpServicePort))
@@ -152,15 +519,43 @@ func Run(cfg Config) error {
 	}()
 
 	select {
-	case <-errC:
+	case err = <-errC:
 	case <-gc:
 	}
 
+	stopWorkers()
+	h.Events.Close()
+
 	errDown := app.Shutdown()
 	if errDown != nil {
 		log.Error(errDown.Error())
 	}
+	if grpcServer != nil {
+		grpcServer.GracefulStop()
+	}
 	log.Info("gracefully shutdown")
 	return err
 
 }
+
+// ensureHouseAccount creates the user collecting shop fees, nobody knows its password
+func ensureHouseAccount(ctx context.Context, db *dal.Dal, name string) error {
+	user, err := db.GetUserByName(ctx, name)
+	if err != nil || user != nil {
+		return err
+	}
+
+	password := make([]byte, 32)
+	if _, err = rand.Read(password); err != nil {
+		return err
+	}
+
+	_, err = db.AddNewUser(ctx, models.CreateUserParams{
+		Name:     name,
+		Password: hex.EncodeToString(password),
+	})
+	if errors.Is(err, dal.ErrUserAlreadyExists) {
+		return nil
+	}
+	return err
+}
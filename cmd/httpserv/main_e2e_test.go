@@ -2,14 +2,22 @@ package main
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -27,10 +35,15 @@ import (
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	. "github.com/maximmihin/aw25/cmd/httpserv/internal/testclient"
 	. "github.com/maximmihin/aw25/cmd/httpserv/internal/testdal"
 	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+	"github.com/maximmihin/aw25/internal/eventbus"
+	"github.com/maximmihin/aw25/internal/grpccontroller/merchpb"
+	"github.com/maximmihin/aw25/internal/totp"
 )
 
 // app config
@@ -45,6 +58,24 @@ const (
 	logLevel = "-4" // slog.LevelDebug
 
 	logFile = "test.log.json"
+
+	adminUser = "admin_teste2e@ya.ru"
+
+	houseAccount     = "house_teste2e@ya.ru"
+	marketFeePercent = "10"
+
+	auctionCloseInterval = "500ms"
+
+	webhookDispatchInterval = "100ms"
+	webhookRetryDelay       = "10ms"
+
+	eventBusPublishInterval = "100ms"
+
+	smtpFrom          = "shop_teste2e@ya.ru"
+	emailSendInterval = "100ms"
+	emailRetryDelay   = "10ms"
+
+	validateResponses = "true"
 )
 
 // test defaults
@@ -65,6 +96,8 @@ const (
 type TargetServer struct {
 	Type int
 	Url  string
+	// GrpcAddr is empty for external servers, grpc tests are skipped then
+	GrpcAddr string
 }
 
 func ParseServerConfig(t *testing.T, confStr string) TargetServer {
@@ -115,204 +148,3193 @@ func TestE2E(t *testing.T) {
 	tdb := NewTestDal(t, dbConnStr)
 
 	serverConfig := ParseServerConfig(t, os.Getenv("E2E_TESTING_SERVER"))
+	// emails of an external server can not be checked, the mail catcher stays empty then
+	var mail MailCatcher
 	switch serverConfig.Type {
 	case embed:
-		serverConfig.Url = RunEmbedHttpServ(t, dbConnStr)
+		var smtpAddr string
+		smtpAddr, _, mail.Url = RunDockerMailpit(t)
+		serverConfig.Url, serverConfig.GrpcAddr = RunEmbedHttpServ(t, dbConnStr, smtpAddr)
 	case docker:
-		serverConfig.Url = RunDockerHttpServ(t, dockerBridgeDbConnStr)
+		var dockerBridgeSmtpAddr string
+		_, dockerBridgeSmtpAddr, mail.Url = RunDockerMailpit(t)
+		serverConfig.Url, serverConfig.GrpcAddr = RunDockerHttpServ(t, dockerBridgeDbConnStr, dockerBridgeSmtpAddr)
 	case external:
 		// already set in ParseServerConfig()
 	}
 	tcl := NewTestClient(t, serverConfig.Url)
 
-	t.Run("auth", func(t *testing.T) {
-		t.Parallel()
+	adminAuth := tcl.Auth(t, AuthParams{
+		Username: adminUser,
+		Password: defPass,
+	})
+	require.Equal(t, 200, adminAuth.StatusCode())
+
+	// admins must use two-factor authentication, the password only token is not enough
+	adminRes := tcl.ListRefunds(t, ListRefundsParams{
+		Auth: *adminAuth.JSON200.Token,
+	})
+	require.Equal(t, 403, adminRes.StatusCode())
+	require.Equal(t, ErrorCodeTWOFACTORREQUIRED, adminRes.JSON403.Code)
+
+	adminToken := EnableTwoFactor(t, tcl, *adminAuth.JSON200.Token, AuthParams{
+		Username: adminUser,
+		Password: defPass,
+	})
+
+	t.Run("auth", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("green", func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("new user", func(t *testing.T) {
+				t.Parallel()
+
+				res := tcl.Auth(t, AuthParams{
+					Username: NewEmail(t),
+					Password: defPass,
+				})
+				require.Equal(t, 200, res.StatusCode())
+
+			})
+
+			t.Run("repeated login", func(t *testing.T) {
+				t.Parallel()
+
+				mail := NewEmail(t)
+
+				res := tcl.Auth(t, AuthParams{
+					Username: mail,
+					Password: defPass,
+				})
+				require.Equal(t, 200, res.StatusCode())
+
+				res2 := tcl.Auth(t, AuthParams{
+					Username: mail,
+					Password: defPass,
+				})
+				require.Equal(t, 200, res2.StatusCode())
+
+			})
+		})
+
+		t.Run("wrong pass", func(t *testing.T) {
+			t.Parallel()
+
+			mail := NewEmail(t)
+
+			res := tcl.Auth(t, AuthParams{
+				Username: mail,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+
+			res2 := tcl.Auth(t, AuthParams{
+				Username: mail,
+				Password: "someWrongPassword",
+			})
+			require.Equal(t, 401, res2.StatusCode())
+
+		})
+
+	})
+
+	t.Run("buy", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("green", func(t *testing.T) {
+			t.Parallel()
+
+			res := tcl.Auth(t, AuthParams{
+				Password: defPass,
+				Username: NewEmail(t),
+			})
+			require.Equal(t, 200, res.StatusCode())
+
+			res2 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      *res.JSON200.Token,
+				MerchItem: "t-shirt",
+			})
+			require.Equal(t, 200, res2.StatusCode())
+		})
+
+		t.Run("empty jwt", func(t *testing.T) {
+			t.Parallel()
+
+			res := tcl.Auth(t, AuthParams{
+				Password: defPass,
+				Username: NewEmail(t),
+			})
+			require.Equal(t, 200, res.StatusCode())
+
+			res2 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      "",
+				MerchItem: "t-shirt",
+			})
+			require.Equal(t, 401, res2.StatusCode())
+		})
+
+		t.Run("not enough coins", func(t *testing.T) {
+			t.Parallel()
+
+			userName := NewEmail(t)
+			var userToken string
+
+			res := tcl.Auth(t, AuthParams{
+				Password: defPass,
+				Username: userName,
+			})
+			require.Equal(t, 200, res.StatusCode())
+			userToken = *res.JSON200.Token
+
+			res2 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "pink-hoody",
+			})
+			require.Equal(t, 200, res2.StatusCode())
+
+			res3 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "pink-hoody",
+			})
+			require.Equal(t, 200, res3.StatusCode())
+
+			res4 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "pink-hoody",
+			})
+			require.Equal(t, 400, res4.StatusCode())
+			require.Equal(t, ErrorCodeNOTENOUGHCOINS, res4.JSON400.Code)
+
+		})
+
+		t.Run("unavailable merch item", func(t *testing.T) {
+			t.Parallel()
+
+			res := tcl.Auth(t, AuthParams{
+				Password: defPass,
+				Username: NewEmail(t),
+			})
+			require.Equal(t, 200, res.StatusCode())
+
+			res2 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      *res.JSON200.Token,
+				MerchItem: "some-unavailable-merch-item",
+			})
+			require.Equal(t, 400, res2.StatusCode())
+			require.Equal(t, ErrorCodeUNKNOWNITEM, res2.JSON400.Code)
+		})
+	})
+
+	t.Run("buy variant", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("green", func(t *testing.T) {
+			t.Parallel()
+
+			res := tcl.Auth(t, AuthParams{
+				Username: NewEmail(t),
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+			userToken := *res.JSON200.Token
+
+			sizeXL := "xl"
+			res2 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "t-shirt",
+				Variant:   &sizeXL,
+			})
+			require.Equal(t, 200, res2.StatusCode())
+
+			// plain slug is still available for old clients
+			res3 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "t-shirt",
+			})
+			require.Equal(t, 200, res3.StatusCode())
+
+			res4 := tcl.Info(t, InfoParams{JwtToken: userToken})
+			require.Equal(t, 200, res4.StatusCode())
+			require.JSONEq(t, `{
+				"coins": 840,
+				"inventory": [
+					{ "type": "t-shirt", "quantity": 1 },
+					{ "type": "t-shirt", "variant": "xl", "quantity": 1 }
+				],
+				"coinHistory": { "received": [], "sent": [] },
+				"giftHistory": { "received": [], "sent": [] }
+			}`, string(res4.Body))
+		})
+
+		t.Run("unknown variant", func(t *testing.T) {
+			t.Parallel()
+
+			res := tcl.Auth(t, AuthParams{
+				Username: NewEmail(t),
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+
+			sizeXXXL := "xxxl"
+			res2 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      *res.JSON200.Token,
+				MerchItem: "t-shirt",
+				Variant:   &sizeXXXL,
+			})
+			require.Equal(t, 400, res2.StatusCode())
+			require.Equal(t, ErrorCodeUNKNOWNVARIANT, res2.JSON400.Code)
+		})
+
+		t.Run("out of stock", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tdb.PgxPool.Exec(context.TODO(), `
+INSERT INTO merch_variants (merch_item, variant, attributes, price, stock)
+VALUES ('hoody', 'limited-e2e', '{"color": "gold"}', 100, 1);
+`)
+			require.NoError(t, err)
+
+			res := tcl.Auth(t, AuthParams{
+				Username: NewEmail(t),
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+			userToken := *res.JSON200.Token
+
+			limited := "limited-e2e"
+			res2 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "hoody",
+				Variant:   &limited,
+			})
+			require.Equal(t, 200, res2.StatusCode())
+
+			res3 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "hoody",
+				Variant:   &limited,
+			})
+			require.Equal(t, 400, res3.StatusCode())
+
+			// variant price overrides merch price
+			res4 := tcl.Info(t, InfoParams{JwtToken: userToken})
+			require.Equal(t, 200, res4.StatusCode())
+			require.EqualValues(t, 900, *res4.JSON200.Coins)
+		})
+	})
+
+	t.Run("gift", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("green", func(t *testing.T) {
+			t.Parallel()
+
+			buyer := NewEmail(t, "buyer")
+			recipient := NewEmail(t, "recipient")
+
+			res := tcl.Auth(t, AuthParams{
+				Username: buyer,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+			buyerToken := *res.JSON200.Token
+
+			res2 := tcl.Auth(t, AuthParams{
+				Username: recipient,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res2.StatusCode())
+			recipientToken := *res2.JSON200.Token
+
+			message := "happy birthday"
+			res3 := tcl.GiftMerch(t, GiftMerchParams{
+				Auth:      buyerToken,
+				MerchItem: "cup",
+				ForUser:   recipient,
+				Message:   &message,
+			})
+			require.Equal(t, 200, res3.StatusCode())
+
+			res4 := tcl.Info(t, InfoParams{JwtToken: buyerToken})
+			require.Equal(t, 200, res4.StatusCode())
+			require.JSONEq(t, fmt.Sprintf(`{
+				"coins": 980,
+				"inventory": [],
+				"coinHistory": { "received": [], "sent": [] },
+				"giftHistory": {
+					"received": [],
+					"sent": [
+						{ "toUser": "%s", "item": "cup", "message": "happy birthday" }
+					]
+				}
+			}`, recipient), string(res4.Body))
+
+			res5 := tcl.Info(t, InfoParams{JwtToken: recipientToken})
+			require.Equal(t, 200, res5.StatusCode())
+			require.JSONEq(t, fmt.Sprintf(`{
+				"coins": 1000,
+				"inventory": [
+					{ "type": "cup", "quantity": 1 }
+				],
+				"coinHistory": { "received": [], "sent": [] },
+				"giftHistory": {
+					"received": [
+						{ "fromUser": "%s", "item": "cup", "message": "happy birthday" }
+					],
+					"sent": []
+				}
+			}`, buyer), string(res5.Body))
+		})
+
+		t.Run("unknown recipient", func(t *testing.T) {
+			t.Parallel()
+
+			userName := NewEmail(t)
+
+			res := tcl.Auth(t, AuthParams{
+				Username: userName,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+
+			res2 := tcl.GiftMerch(t, GiftMerchParams{
+				Auth:      *res.JSON200.Token,
+				MerchItem: "cup",
+				ForUser:   NewEmail(t, "unknown"),
+			})
+			require.Equal(t, 400, res2.StatusCode())
+
+			// coins must not be debited
+			u, err := tdb.Queries.GetUserByName(context.TODO(), userName)
+			require.NoError(t, err)
+			require.EqualValues(t, 1000, u.Coins)
+		})
+
+		t.Run("problem details", func(t *testing.T) {
+			t.Parallel()
+
+			userName := NewEmail(t)
+
+			res := tcl.Auth(t, AuthParams{
+				Username: userName,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+			userToken := *res.JSON200.Token
+
+			res2 := tcl.SendCoins(t, SendCoinParams{
+				Auth:   userToken,
+				Amount: 10,
+				ToUser: userName,
+				Accept: "application/problem+json",
+			})
+			require.Equal(t, 400, res2.StatusCode())
+			require.Equal(t, "application/problem+json", res2.HTTPResponse.Header.Get("Content-Type"))
+			problem := res2.ApplicationproblemJSON400
+			require.NotNil(t, problem)
+			require.Equal(t, "urn:merch-shop:error:SELF_TRANSFER", problem.Type)
+			require.Equal(t, "Bad Request", problem.Title)
+			require.Equal(t, 400, problem.Status)
+			require.Equal(t, ErrorCodeSELFTRANSFER, problem.Code)
+			require.Equal(t, res2.HTTPResponse.Header.Get("X-Request-ID"), *problem.Instance)
+			require.NotEmpty(t, *problem.Instance)
+
+			res3 := tcl.SendCoins(t, SendCoinParams{
+				Auth:   userToken,
+				Amount: 0,
+				ToUser: "",
+				Accept: "application/problem+json",
+			})
+			require.Equal(t, 400, res3.StatusCode())
+			require.Equal(t, ErrorCodeVALIDATIONFAILED, res3.ApplicationproblemJSON400.Code)
+			require.Len(t, *res3.ApplicationproblemJSON400.Fields, 2)
+
+			// legacy format stays the default
+			res4 := tcl.SendCoins(t, SendCoinParams{
+				Auth:   userToken,
+				Amount: 10,
+				ToUser: userName,
+			})
+			require.Equal(t, 400, res4.StatusCode())
+			require.Equal(t, "application/json", res4.HTTPResponse.Header.Get("Content-Type"))
+			require.Equal(t, ErrorCodeSELFTRANSFER, res4.JSON400.Code)
+		})
+	})
+
+	t.Run("send coins", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("green", func(t *testing.T) {
+			t.Parallel()
+
+			user1 := NewEmail(t, "one")
+			user2 := NewEmail(t, "two")
+
+			res := tcl.Auth(t, AuthParams{
+				Username: user1,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+
+			res2 := tcl.Auth(t, AuthParams{
+				Username: user2,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res2.StatusCode())
+
+			res3 := tcl.SendCoins(t, SendCoinParams{
+				Auth:   *res.JSON200.Token,
+				Amount: 10,
+				ToUser: user2,
+			})
+			require.Equal(t, 200, res3.StatusCode())
+
+			u1, err := tdb.Queries.GetUserByName(context.TODO(), user1)
+			require.NoError(t, err)
+			require.Equal(t, models.User{
+				Name:     user1,
+				Password: defPass,
+				Coins:    990,
+			}, u1)
+
+			u2, err := tdb.Queries.GetUserByName(context.TODO(), user2)
+			require.NoError(t, err)
+			require.Equal(t, models.User{
+				Name:     user2,
+				Password: defPass,
+				Coins:    1010,
+			}, u2)
+		})
+
+		t.Run("error codes", func(t *testing.T) {
+			t.Parallel()
+
+			userName := NewEmail(t)
+
+			res := tcl.Auth(t, AuthParams{
+				Username: userName,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+			userToken := *res.JSON200.Token
+
+			res2 := tcl.SendCoins(t, SendCoinParams{
+				Auth:   userToken,
+				Amount: 10,
+				ToUser: userName,
+			})
+			require.Equal(t, 400, res2.StatusCode())
+			require.Equal(t, ErrorCodeSELFTRANSFER, res2.JSON400.Code)
+
+			res3 := tcl.SendCoins(t, SendCoinParams{
+				Auth:   userToken,
+				Amount: 10,
+				ToUser: NewEmail(t, "nobody"),
+			})
+			require.Equal(t, 400, res3.StatusCode())
+			require.Equal(t, ErrorCodeINVALIDRECIPIENT, res3.JSON400.Code)
+
+			res4 := tcl.Auth(t, AuthParams{
+				Username: NewEmail(t, "recipient"),
+				Password: defPass,
+			})
+			require.Equal(t, 200, res4.StatusCode())
+
+			res5 := tcl.SendCoins(t, SendCoinParams{
+				Auth:   userToken,
+				Amount: 1001,
+				ToUser: NewEmail(t, "recipient"),
+			})
+			require.Equal(t, 400, res5.StatusCode())
+			require.Equal(t, ErrorCodeNOTENOUGHCOINS, res5.JSON400.Code)
+
+			res6 := tcl.SendCoins(t, SendCoinParams{
+				Auth:   userToken,
+				Amount: -10,
+				ToUser: "",
+			})
+			require.Equal(t, 400, res6.StatusCode())
+			require.Equal(t, ErrorCodeVALIDATIONFAILED, res6.JSON400.Code)
+			require.Equal(t, []string{"amount", "toUser"}, []string{
+				(*res6.JSON400.Fields)[0].Field,
+				(*res6.JSON400.Fields)[1].Field,
+			})
+
+			u, err := tdb.Queries.GetUserByName(context.TODO(), userName)
+			require.NoError(t, err)
+			require.EqualValues(t, 1000, u.Coins)
+		})
+
+		t.Run("request not matching spec", func(t *testing.T) {
+			t.Parallel()
+
+			userName := NewEmail(t)
+
+			res := tcl.Auth(t, AuthParams{
+				Username: userName,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+			userToken := *res.JSON200.Token
+
+			res2 := tcl.SendCoinsRaw(t, userToken, `{"toUser": "`+NewEmail(t, "recipient")+`", "amount": "ten"}`)
+			require.Equal(t, 400, res2.StatusCode())
+			require.Equal(t, ErrorCodeVALIDATIONFAILED, res2.JSON400.Code)
+			require.Len(t, *res2.JSON400.Fields, 1)
+			require.Equal(t, "amount", (*res2.JSON400.Fields)[0].Field)
+
+			res3 := tcl.SendCoinsRaw(t, userToken, `{"amount": 10}`)
+			require.Equal(t, 400, res3.StatusCode())
+			require.Equal(t, ErrorCodeVALIDATIONFAILED, res3.JSON400.Code)
+
+			// the token is not needed for public operations only
+			res4 := tcl.SendCoinsRaw(t, "", `{"toUser": "`+userName+`", "amount": 10}`)
+			require.Equal(t, 401, res4.StatusCode())
+			require.Equal(t, ErrorCodeUNAUTHORIZED, res4.JSON401.Code)
+
+			u, err := tdb.Queries.GetUserByName(context.TODO(), userName)
+			require.NoError(t, err)
+			require.EqualValues(t, 1000, u.Coins)
+		})
+	})
+
+	t.Run("refund", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("green", func(t *testing.T) {
+			t.Parallel()
+
+			userName := NewEmail(t)
+
+			res := tcl.Auth(t, AuthParams{
+				Username: userName,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+			userToken := *res.JSON200.Token
+
+			res2 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "hoody",
+			})
+			require.Equal(t, 200, res2.StatusCode())
+
+			res3 := tcl.RequestRefund(t, RefundParams{
+				Auth:      userToken,
+				MerchItem: "hoody",
+			})
+			require.Equal(t, 200, res3.StatusCode())
+			require.Equal(t, RefundStatusRequested, res3.JSON200.Status)
+			require.EqualValues(t, 300, res3.JSON200.Price)
+
+			res4 := tcl.ApproveRefund(t, ResolveRefundParams{
+				Auth:     adminToken,
+				RefundId: res3.JSON200.Id,
+			})
+			require.Equal(t, 200, res4.StatusCode())
+			require.Equal(t, RefundStatusApproved, res4.JSON200.Status)
+			require.Equal(t, adminUser, *res4.JSON200.ResolvedBy)
+
+			u, err := tdb.Queries.GetUserByName(context.TODO(), userName)
+			require.NoError(t, err)
+			require.EqualValues(t, 1000, u.Coins)
+
+			res5 := tcl.Info(t, InfoParams{JwtToken: userToken})
+			require.Equal(t, 200, res5.StatusCode())
+			require.JSONEq(t, `{
+				"coins": 1000,
+				"inventory": [],
+				"coinHistory": { "received": [], "sent": [] },
+				"giftHistory": { "received": [], "sent": [] }
+			}`, string(res5.Body))
+		})
+
+		t.Run("double approve", func(t *testing.T) {
+			t.Parallel()
+
+			res := tcl.Auth(t, AuthParams{
+				Username: NewEmail(t),
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+			userToken := *res.JSON200.Token
+
+			res2 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "cup",
+			})
+			require.Equal(t, 200, res2.StatusCode())
+
+			res3 := tcl.RequestRefund(t, RefundParams{
+				Auth:      userToken,
+				MerchItem: "cup",
+			})
+			require.Equal(t, 200, res3.StatusCode())
+
+			res4 := tcl.ApproveRefund(t, ResolveRefundParams{
+				Auth:     adminToken,
+				RefundId: res3.JSON200.Id,
+			})
+			require.Equal(t, 200, res4.StatusCode())
+
+			res5 := tcl.ApproveRefund(t, ResolveRefundParams{
+				Auth:     adminToken,
+				RefundId: res3.JSON200.Id,
+			})
+			require.Equal(t, 409, res5.StatusCode())
+
+			// purchase already refunded
+			res6 := tcl.RequestRefund(t, RefundParams{
+				Auth:      userToken,
+				MerchItem: "cup",
+			})
+			require.Equal(t, 400, res6.StatusCode())
+		})
+
+		t.Run("approve races cancel", func(t *testing.T) {
+			t.Parallel()
+
+			for range 10 {
+				userName := NewEmail(t)
+				res := tcl.Auth(t, AuthParams{
+					Username: userName,
+					Password: defPass,
+				})
+				require.Equal(t, 200, res.StatusCode())
+				userToken := *res.JSON200.Token
+
+				res2 := tcl.BuyMerch(t, BuyMerchParams{
+					Auth:      userToken,
+					MerchItem: "cup",
+				})
+				require.Equal(t, 200, res2.StatusCode())
+
+				res3 := tcl.RequestRefund(t, RefundParams{
+					Auth:      userToken,
+					MerchItem: "cup",
+				})
+				require.Equal(t, 200, res3.StatusCode())
+
+				res4 := tcl.ListMyOrders(t, ListMyOrdersParams{Auth: userToken})
+				require.Equal(t, 200, res4.StatusCode())
+				require.Len(t, *res4.JSON200, 1)
+
+				approved := make(chan int, 1)
+				go func() {
+					approved <- tcl.ApproveRefund(t, ResolveRefundParams{
+						Auth:     adminToken,
+						RefundId: res3.JSON200.Id,
+					}).StatusCode()
+				}()
+				res5 := tcl.CancelMyOrder(t, OrderParams{
+					Auth:    userToken,
+					OrderId: (*res4.JSON200)[0].Id,
+				})
+
+				// whichever comes first returns the coins, the other one finds nothing to return
+				require.ElementsMatch(t, []int{200, 409}, []int{res5.StatusCode(), <-approved})
+
+				u, err := tdb.Queries.GetUserByName(context.TODO(), userName)
+				require.NoError(t, err)
+				require.EqualValues(t, 1000, u.Coins)
+			}
+		})
+
+		t.Run("approve cancelled order", func(t *testing.T) {
+			t.Parallel()
+
+			userName := NewEmail(t)
+			res := tcl.Auth(t, AuthParams{
+				Username: userName,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+			userToken := *res.JSON200.Token
+
+			res2 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "cup",
+			})
+			require.Equal(t, 200, res2.StatusCode())
+
+			res3 := tcl.ListMyOrders(t, ListMyOrdersParams{Auth: userToken})
+			require.Equal(t, 200, res3.StatusCode())
+			orderID := (*res3.JSON200)[0].Id
+
+			res4 := tcl.CancelMyOrder(t, OrderParams{
+				Auth:    userToken,
+				OrderId: orderID,
+			})
+			require.Equal(t, 200, res4.StatusCode())
+
+			// a refund requested concurrently with the cancellation is not seen by it
+			var refundID int64
+			err := tdb.PgxPool.QueryRow(context.TODO(), `
+INSERT INTO merch_refunds (purchase_id)
+SELECT purchase_id FROM merch_orders WHERE id = $1
+RETURNING id`, orderID).Scan(&refundID)
+			require.NoError(t, err)
+
+			res5 := tcl.ApproveRefund(t, ResolveRefundParams{
+				Auth:     adminToken,
+				RefundId: refundID,
+			})
+			require.Equal(t, 409, res5.StatusCode())
+			require.Equal(t, ErrorCodeREFUNDORDERCANCELLED, res5.JSON409.Code)
+
+			u, err := tdb.Queries.GetUserByName(context.TODO(), userName)
+			require.NoError(t, err)
+			require.EqualValues(t, 1000, u.Coins)
+		})
+
+		t.Run("rejected", func(t *testing.T) {
+			t.Parallel()
+
+			userName := NewEmail(t)
+
+			res := tcl.Auth(t, AuthParams{
+				Username: userName,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+			userToken := *res.JSON200.Token
+
+			res2 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "socks",
+			})
+			require.Equal(t, 200, res2.StatusCode())
+
+			res3 := tcl.RequestRefund(t, RefundParams{
+				Auth:      userToken,
+				MerchItem: "socks",
+			})
+			require.Equal(t, 200, res3.StatusCode())
+
+			res4 := tcl.RejectRefund(t, ResolveRefundParams{
+				Auth:     adminToken,
+				RefundId: res3.JSON200.Id,
+			})
+			require.Equal(t, 200, res4.StatusCode())
+			require.Equal(t, RefundStatusRejected, res4.JSON200.Status)
+
+			u, err := tdb.Queries.GetUserByName(context.TODO(), userName)
+			require.NoError(t, err)
+			require.EqualValues(t, 990, u.Coins)
+		})
+
+		t.Run("nothing to refund", func(t *testing.T) {
+			t.Parallel()
+
+			res := tcl.Auth(t, AuthParams{
+				Username: NewEmail(t),
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+
+			res2 := tcl.RequestRefund(t, RefundParams{
+				Auth:      *res.JSON200.Token,
+				MerchItem: "pen",
+			})
+			require.Equal(t, 400, res2.StatusCode())
+		})
+
+		t.Run("not admin", func(t *testing.T) {
+			t.Parallel()
+
+			res := tcl.Auth(t, AuthParams{
+				Username: NewEmail(t),
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+
+			res2 := tcl.ListRefunds(t, ListRefundsParams{
+				Auth: *res.JSON200.Token,
+			})
+			require.Equal(t, 403, res2.StatusCode())
+		})
+	})
+
+	t.Run("promotions", func(t *testing.T) {
+		t.Parallel()
+
+		startsAt := time.Now().Add(-time.Minute)
+		endsAt := time.Now().Add(time.Hour)
+
+		t.Run("sale stacked with promo code", func(t *testing.T) {
+			t.Parallel()
+
+			umbrella := "umbrella"
+			percentOff := int32(20)
+			res := tcl.CreatePromotion(t, CreatePromotionParams{
+				Auth: adminToken,
+				Promotion: CreatePromotionRequest{
+					Item:       &umbrella,
+					PercentOff: &percentOff,
+					StartsAt:   startsAt,
+					EndsAt:     endsAt,
+				},
+			})
+			require.Equal(t, 200, res.StatusCode())
+
+			code := "UMBRELLA10"
+			amountOff := int64(10)
+			stackable := true
+			res2 := tcl.CreatePromotion(t, CreatePromotionParams{
+				Auth: adminToken,
+				Promotion: CreatePromotionRequest{
+					Code:      &code,
+					AmountOff: &amountOff,
+					Stackable: &stackable,
+					StartsAt:  startsAt,
+					EndsAt:    endsAt,
+				},
+			})
+			require.Equal(t, 200, res2.StatusCode())
+
+			userName := NewEmail(t)
+			res3 := tcl.Auth(t, AuthParams{
+				Username: userName,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res3.StatusCode())
+
+			// 200 - 20% - 10
+			res4 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      *res3.JSON200.Token,
+				MerchItem: "umbrella",
+				PromoCode: &code,
+			})
+			require.Equal(t, 200, res4.StatusCode())
+
+			u, err := tdb.Queries.GetUserByName(context.TODO(), userName)
+			require.NoError(t, err)
+			require.EqualValues(t, 850, u.Coins)
+
+			// refund returns what was actually paid
+			res5 := tcl.RequestRefund(t, RefundParams{
+				Auth:      *res3.JSON200.Token,
+				MerchItem: "umbrella",
+			})
+			require.Equal(t, 200, res5.StatusCode())
+			require.EqualValues(t, 150, res5.JSON200.Price)
+		})
+
+		t.Run("promo code once per user", func(t *testing.T) {
+			t.Parallel()
+
+			code := "WALLETHALF"
+			wallet := "wallet"
+			percentOff := int32(50)
+			maxPerUser := int64(1)
+			res := tcl.CreatePromotion(t, CreatePromotionParams{
+				Auth: adminToken,
+				Promotion: CreatePromotionRequest{
+					Code:                  &code,
+					Item:                  &wallet,
+					PercentOff:            &percentOff,
+					StartsAt:              startsAt,
+					EndsAt:                endsAt,
+					MaxRedemptionsPerUser: &maxPerUser,
+				},
+			})
+			require.Equal(t, 200, res.StatusCode())
+
+			userName := NewEmail(t)
+			res2 := tcl.Auth(t, AuthParams{
+				Username: userName,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res2.StatusCode())
+			userToken := *res2.JSON200.Token
+
+			res3 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "wallet",
+				PromoCode: &code,
+			})
+			require.Equal(t, 200, res3.StatusCode())
+
+			res4 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "wallet",
+				PromoCode: &code,
+			})
+			require.Equal(t, 400, res4.StatusCode())
+
+			// code is bound to the wallet
+			res5 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "cup",
+				PromoCode: &code,
+			})
+			require.Equal(t, 400, res5.StatusCode())
+
+			unknown := "NOSUCHCODE"
+			res6 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "wallet",
+				PromoCode: &unknown,
+			})
+			require.Equal(t, 400, res6.StatusCode())
+
+			u, err := tdb.Queries.GetUserByName(context.TODO(), userName)
+			require.NoError(t, err)
+			require.EqualValues(t, 975, u.Coins)
+		})
+
+		t.Run("bundle", func(t *testing.T) {
+			t.Parallel()
+
+			res := tcl.CreateBundle(t, CreateBundleParams{
+				Auth: adminToken,
+				Bundle: Bundle{
+					Slug:     "e2e-starter-pack",
+					Price:    200,
+					StartsAt: startsAt,
+					EndsAt:   endsAt,
+					Items: []BundleItem{
+						{Item: "book", Quantity: 1},
+						{Item: "powerbank", Quantity: 1},
+					},
+				},
+			})
+			require.Equal(t, 200, res.StatusCode())
+
+			userName := NewEmail(t)
+			res2 := tcl.Auth(t, AuthParams{
+				Username: userName,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res2.StatusCode())
+			userToken := *res2.JSON200.Token
+
+			res3 := tcl.BuyBundle(t, BuyBundleParams{
+				Auth:   userToken,
+				Bundle: "e2e-starter-pack",
+			})
+			require.Equal(t, 200, res3.StatusCode())
+
+			res4 := tcl.Info(t, InfoParams{JwtToken: userToken})
+			require.Equal(t, 200, res4.StatusCode())
+			require.JSONEq(t, `{
+				"coins": 800,
+				"inventory": [
+					{ "type": "book", "quantity": 1 },
+					{ "type": "powerbank", "quantity": 1 }
+				],
+				"coinHistory": { "received": [], "sent": [] },
+				"giftHistory": { "received": [], "sent": [] }
+			}`, string(res4.Body))
+
+			res5 := tcl.BuyBundle(t, BuyBundleParams{
+				Auth:   userToken,
+				Bundle: "no-such-bundle",
+			})
+			require.Equal(t, 400, res5.StatusCode())
+		})
+
+		t.Run("bundle more expensive than items", func(t *testing.T) {
+			t.Parallel()
+
+			res := tcl.CreateBundle(t, CreateBundleParams{
+				Auth: adminToken,
+				Bundle: Bundle{
+					Slug:     "e2e-overpriced-pack",
+					Price:    1000,
+					StartsAt: startsAt,
+					EndsAt:   endsAt,
+					Items: []BundleItem{
+						{Item: "pen", Quantity: 2},
+					},
+				},
+			})
+			require.Equal(t, 400, res.StatusCode())
+		})
+	})
+
+	t.Run("orders", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("green", func(t *testing.T) {
+			t.Parallel()
+
+			userName := NewEmail(t)
+			res := tcl.Auth(t, AuthParams{
+				Username: userName,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+			userToken := *res.JSON200.Token
+
+			res2 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "book",
+			})
+			require.Equal(t, 200, res2.StatusCode())
+
+			res3 := tcl.ListMyOrders(t, ListMyOrdersParams{Auth: userToken})
+			require.Equal(t, 200, res3.StatusCode())
+			require.Len(t, *res3.JSON200, 1)
+			order := (*res3.JSON200)[0]
+			require.Equal(t, OrderStatusPlaced, order.Status)
+			require.Equal(t, "book", order.Item)
+			require.NotNil(t, order.PickupCode)
+
+			// not ready yet
+			res4 := tcl.HandOverOrder(t, HandOverOrderParams{
+				Auth:       adminToken,
+				OrderId:    order.Id,
+				PickupCode: *order.PickupCode,
+			})
+			require.Equal(t, 409, res4.StatusCode())
+
+			res5 := tcl.MarkOrderReady(t, OrderParams{
+				Auth:    adminToken,
+				OrderId: order.Id,
+			})
+			require.Equal(t, 200, res5.StatusCode())
+			require.Equal(t, OrderStatusReady, res5.JSON200.Status)
+			require.Nil(t, res5.JSON200.PickupCode)
+
+			// user can not cancel ready order
+			res6 := tcl.CancelMyOrder(t, OrderParams{
+				Auth:    userToken,
+				OrderId: order.Id,
+			})
+			require.Equal(t, 409, res6.StatusCode())
+
+			res7 := tcl.HandOverOrder(t, HandOverOrderParams{
+				Auth:       adminToken,
+				OrderId:    order.Id,
+				PickupCode: "wrong",
+			})
+			require.Equal(t, 400, res7.StatusCode())
+
+			res8 := tcl.HandOverOrder(t, HandOverOrderParams{
+				Auth:       adminToken,
+				OrderId:    order.Id,
+				PickupCode: *order.PickupCode,
+			})
+			require.Equal(t, 200, res8.StatusCode())
+			require.Equal(t, OrderStatusHandedOver, res8.JSON200.Status)
+			require.Equal(t, adminUser, *res8.JSON200.UpdatedBy)
+
+			res9 := tcl.CancelOrder(t, OrderParams{
+				Auth:    adminToken,
+				OrderId: order.Id,
+			})
+			require.Equal(t, 409, res9.StatusCode())
+		})
+
+		t.Run("cancel by user", func(t *testing.T) {
+			t.Parallel()
+
+			userName := NewEmail(t)
+			res := tcl.Auth(t, AuthParams{
+				Username: userName,
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+			userToken := *res.JSON200.Token
+
+			res2 := tcl.BuyMerch(t, BuyMerchParams{
+				Auth:      userToken,
+				MerchItem: "book",
+			})
+			require.Equal(t, 200, res2.StatusCode())
+
+			res3 := tcl.ListMyOrders(t, ListMyOrdersParams{Auth: userToken})
+			require.Equal(t, 200, res3.StatusCode())
+			require.Len(t, *res3.JSON200, 1)
+
+			// other users do not see foreign orders
+			res4 := tcl.Auth(t, AuthParams{
+				Username: NewEmail(t, "other"),
+				Password: defPass,
+			})
+			require.Equal(t, 200, res4.StatusCode())
+			res5 := tcl.CancelMyOrder(t, OrderParams{
+				Auth:    *res4.JSON200.Token,
+				OrderId: (*res3.JSON200)[0].Id,
+			})
+			require.Equal(t, 404, res5.StatusCode())
+
+			res6 := tcl.CancelMyOrder(t, OrderParams{
+				Auth:    userToken,
+				OrderId: (*res3.JSON200)[0].Id,
+			})
+			require.Equal(t, 200, res6.StatusCode())
+			require.Equal(t, OrderStatusCancelled, res6.JSON200.Status)
+
+			res7 := tcl.Info(t, InfoParams{JwtToken: userToken})
+			require.Equal(t, 200, res7.StatusCode())
+			require.JSONEq(t, `{
+				"coins": 1000,
+				"inventory": [],
+				"coinHistory": { "received": [], "sent": [] },
+				"giftHistory": { "received": [], "sent": [] }
+			}`, string(res7.Body))
+
+			// cancelled purchase is not refundable anymore
+			res8 := tcl.RequestRefund(t, RefundParams{
+				Auth:      userToken,
+				MerchItem: "book",
+			})
+			require.Equal(t, 400, res8.StatusCode())
+		})
+	})
+
+	t.Run("v2", func(t *testing.T) {
+		t.Parallel()
+
+		sender := NewEmail(t, "one")
+		recipient := NewEmail(t, "two")
+		res := tcl.Auth(t, AuthParams{
+			Username: sender,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res.StatusCode())
+		senderToken := *res.JSON200.Token
+
+		res2 := tcl.Auth(t, AuthParams{
+			Username: recipient,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res2.StatusCode())
+		recipientToken := *res2.JSON200.Token
+
+		res3 := tcl.CreateTransfer(t, CreateTransferParams{
+			Auth:   senderToken,
+			Amount: 10,
+			ToUser: recipient,
+		})
+		require.Equal(t, 201, res3.StatusCode())
+		transfer := *res3.JSON201
+		require.Equal(t, sender, transfer.FromUser)
+		require.Equal(t, recipient, transfer.ToUser)
+		require.Equal(t, int64(10), transfer.Amount)
+		require.Equal(t, fmt.Sprintf("/api/v2/transfers/%d", transfer.Id), res3.HTTPResponse.Header.Get("Location"))
+
+		res4 := tcl.GetTransfer(t, TransferParams{
+			Auth:       recipientToken,
+			TransferId: transfer.Id,
+		})
+		require.Equal(t, 200, res4.StatusCode())
+		require.Equal(t, transfer.Id, res4.JSON200.Id)
+
+		// strangers do not see the transfer
+		res5 := tcl.GetTransfer(t, TransferParams{
+			Auth:       adminToken,
+			TransferId: transfer.Id,
+		})
+		require.Equal(t, 404, res5.StatusCode())
+		require.Equal(t, ErrorCodeTRANSFERNOTFOUND, res5.JSON404.Code)
+
+		res6 := tcl.ListMyTransactions(t, ListMyTransactionsParams{Auth: senderToken})
+		require.Equal(t, 200, res6.StatusCode())
+		require.Equal(t, int64(1), res6.JSON200.Total)
+		require.Equal(t, []Transfer{transfer}, res6.JSON200.Items)
+
+		res7 := tcl.CreateOrder(t, CreateOrderParams{
+			Auth:      senderToken,
+			MerchItem: "book",
+		})
+		require.Equal(t, 201, res7.StatusCode())
+		order := *res7.JSON201
+		require.Equal(t, "book", order.Item)
+		require.Equal(t, OrderStatusPlaced, order.Status)
+		require.Equal(t, fmt.Sprintf("/api/v2/orders/%d", order.Id), res7.HTTPResponse.Header.Get("Location"))
+
+		res8 := tcl.GetOrder(t, OrderParams{
+			Auth:    senderToken,
+			OrderId: order.Id,
+		})
+		require.Equal(t, 200, res8.StatusCode())
+		require.Equal(t, order.Id, res8.JSON200.Id)
+
+		res9 := tcl.GetOrder(t, OrderParams{
+			Auth:    recipientToken,
+			OrderId: order.Id,
+		})
+		require.Equal(t, 404, res9.StatusCode())
+
+		res10 := tcl.GetMe(t, GetMeParams{Auth: senderToken})
+		require.Equal(t, 200, res10.StatusCode())
+		require.Equal(t, User{
+			Name:      sender,
+			Coins:     940,
+			Inventory: []InventoryItem{{Item: "book", Quantity: 1}},
+		}, *res10.JSON200)
+
+		// v1 keeps working, but points to v2
+		res11 := tcl.SendCoins(t, SendCoinParams{
+			Auth:   senderToken,
+			Amount: 10,
+			ToUser: recipient,
+		})
+		require.Equal(t, 200, res11.StatusCode())
+		require.NotEmpty(t, res11.HTTPResponse.Header.Get("Deprecation"))
+		require.Equal(t, `</api/v2/transfers>; rel="successor-version"`, res11.HTTPResponse.Header.Get("Link"))
+
+		res12 := tcl.CreateTransfer(t, CreateTransferParams{
+			Auth:   senderToken,
+			Amount: 10,
+			ToUser: recipient,
+		})
+		require.Equal(t, 201, res12.StatusCode())
+		require.Empty(t, res12.HTTPResponse.Header.Get("Deprecation"))
+	})
+
+	t.Run("grpc", func(t *testing.T) {
+		t.Parallel()
+
+		if serverConfig.GrpcAddr == "" {
+			t.Skip("grpc address of external server is unknown")
+		}
+		gcl := NewGrpcTestClient(t, serverConfig.GrpcAddr)
+
+		sender := NewEmail(t, "one")
+		recipient := NewEmail(t, "two")
+		auth, err := gcl.Auth(t.Context(), &merchpb.AuthRequest{
+			Username: sender,
+			Password: defPass,
+		})
+		require.NoError(t, err)
+		ctx := GrpcAuth(t.Context(), auth.Token)
+
+		// the token from grpc works for http too
+		res := tcl.Info(t, InfoParams{JwtToken: auth.Token})
+		require.Equal(t, 200, res.StatusCode())
+
+		_, err = gcl.Auth(t.Context(), &merchpb.AuthRequest{
+			Username: recipient,
+			Password: defPass,
+		})
+		require.NoError(t, err)
+
+		_, err = gcl.GetInfo(t.Context(), &merchpb.GetInfoRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		require.Equal(t, string(ErrorCodeUNAUTHORIZED), GrpcErrorReason(t, err))
+
+		sent, err := gcl.SendCoin(ctx, &merchpb.SendCoinRequest{
+			ToUser: recipient,
+			Amount: 10,
+		})
+		require.NoError(t, err)
+		require.Equal(t, sender, sent.Transfer.FromUser)
+		require.Equal(t, int64(10), sent.Transfer.Amount)
+
+		_, err = gcl.SendCoin(ctx, &merchpb.SendCoinRequest{
+			ToUser: sender,
+			Amount: 10,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Equal(t, string(ErrorCodeSELFTRANSFER), GrpcErrorReason(t, err))
+
+		bought, err := gcl.Buy(ctx, &merchpb.BuyRequest{Item: "book"})
+		require.NoError(t, err)
+		require.Equal(t, "book", bought.Order.Item)
+		require.Equal(t, int64(50), bought.Order.Price)
+		require.Equal(t, string(OrderStatusPlaced), bought.Order.Status)
+		require.NotEmpty(t, bought.Order.PickupCode)
+
+		_, err = gcl.Buy(ctx, &merchpb.BuyRequest{Item: "car"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Equal(t, string(ErrorCodeUNKNOWNITEM), GrpcErrorReason(t, err))
+
+		info, err := gcl.GetInfo(ctx, &merchpb.GetInfoRequest{})
+		require.NoError(t, err)
+		require.Equal(t, int64(940), info.Coins)
+		require.Len(t, info.Inventory, 1)
+		require.Equal(t, "book", info.Inventory[0].Item)
+		require.Len(t, info.CoinHistory.Sent, 1)
+		require.Equal(t, recipient, info.CoinHistory.Sent[0].ToUser)
+
+		limit := int32(2)
+		merch, err := gcl.ListMerch(t.Context(), &merchpb.ListMerchRequest{
+			Limit:    &limit,
+			Language: "ru",
+		})
+		require.NoError(t, err)
+		require.Len(t, merch.Items, 2)
+		require.Equal(t, "ru", merch.Language)
+
+		// reset emails are rate limited only when the server sends emails
+		if mail.Url != "" {
+			for range 3 {
+				_, err = gcl.ForgotPassword(t.Context(), &merchpb.ForgotPasswordRequest{Username: recipient})
+				require.NoError(t, err)
+			}
+			_, err = gcl.ForgotPassword(t.Context(), &merchpb.ForgotPasswordRequest{Username: recipient})
+			require.Equal(t, codes.ResourceExhausted, status.Code(err))
+			require.Equal(t, string(ErrorCodeTOOMANYREQUESTS), GrpcErrorReason(t, err))
+		}
+	})
+
+	t.Run("graphql", func(t *testing.T) {
+		t.Parallel()
+
+		sender := NewEmail(t, "one")
+		recipient := NewEmail(t, "two")
+		res := tcl.Auth(t, AuthParams{
+			Username: sender,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res.StatusCode())
+		senderToken := *res.JSON200.Token
+
+		res2 := tcl.Auth(t, AuthParams{
+			Username: recipient,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res2.StatusCode())
+
+		res3 := tcl.GraphQL(t, GraphQLParams{Query: `{ viewer { name } }`})
+		require.Equal(t, 401, res3.StatusCode)
+
+		res4 := tcl.GraphQL(t, GraphQLParams{
+			Auth: senderToken,
+			Query: `mutation($to: String!) {
+				sendCoin(toUser: $to, amount: 10) { fromUser toUser amount }
+			}`,
+			Variables: map[string]any{"to": recipient},
+		})
+		require.Equal(t, 200, res4.StatusCode)
+		require.Empty(t, res4.Errors)
+		require.JSONEq(t, fmt.Sprintf(`{
+			"sendCoin": { "fromUser": %q, "toUser": %q, "amount": 10 }
+		}`, sender, recipient), string(res4.Data))
+
+		res5 := tcl.GraphQL(t, GraphQLParams{
+			Auth:  senderToken,
+			Query: `mutation { sendCoin(toUser: "nobody", amount: -1) { id } }`,
+		})
+		require.Equal(t, 200, res5.StatusCode)
+		require.Len(t, res5.Errors, 1)
+		require.Equal(t, string(ErrorCodeVALIDATIONFAILED), res5.Errors[0].Extensions["code"])
+
+		res6 := tcl.GraphQL(t, GraphQLParams{
+			Auth:  senderToken,
+			Query: `mutation { buy(item: "book") { payer price status item { slug price } } }`,
+		})
+		require.Equal(t, 200, res6.StatusCode)
+		require.Empty(t, res6.Errors)
+		require.JSONEq(t, fmt.Sprintf(`{
+			"buy": { "payer": %q, "price": 50, "status": "placed", "item": { "slug": "book", "price": 50 } }
+		}`, sender), string(res6.Data))
+
+		res7 := tcl.GraphQL(t, GraphQLParams{
+			Auth: senderToken,
+			Query: `{
+				viewer {
+					name
+					balance
+					inventory { quantity variant item { slug } }
+					transfers(limit: 1) { total items { toUser amount } }
+				}
+				merch(limit: 2, sort: PRICE) { total items { slug } }
+			}`,
+		})
+		require.Equal(t, 200, res7.StatusCode)
+		require.Empty(t, res7.Errors)
+		var data struct {
+			Viewer struct {
+				Name      string
+				Balance   int64
+				Inventory []struct {
+					Quantity int64
+					Variant  *string
+					Item     struct{ Slug string }
+				}
+				Transfers struct {
+					Total int64
+					Items []struct {
+						ToUser string
+						Amount int64
+					}
+				}
+			}
+			Merch struct {
+				Total int64
+				Items []struct{ Slug string }
+			}
+		}
+		require.NoError(t, json.Unmarshal(res7.Data, &data))
+		require.Equal(t, sender, data.Viewer.Name)
+		require.Equal(t, int64(940), data.Viewer.Balance)
+		require.Len(t, data.Viewer.Inventory, 1)
+		require.Equal(t, "book", data.Viewer.Inventory[0].Item.Slug)
+		require.Nil(t, data.Viewer.Inventory[0].Variant)
+		require.Equal(t, int64(1), data.Viewer.Transfers.Total)
+		require.Len(t, data.Viewer.Transfers.Items, 1)
+		require.Equal(t, recipient, data.Viewer.Transfers.Items[0].ToUser)
+		require.Len(t, data.Merch.Items, 2)
+		require.Greater(t, data.Merch.Total, int64(2))
+
+		// fragments count into limits as the fields they spread
+		res8 := tcl.GraphQL(t, GraphQLParams{
+			Auth: senderToken,
+			Query: `
+				query { viewer { ...a } }
+				fragment a on Viewer { inventory { item { slug } } transfers { items { id } } }
+			`,
+		})
+		require.Equal(t, 200, res8.StatusCode)
+		require.Empty(t, res8.Errors)
+
+		res9 := tcl.GraphQL(t, GraphQLParams{
+			Auth:      senderToken,
+			Query:     `query($limit: Int) { viewer { transfers(limit: $limit) { items { id amount } } } }`,
+			Variables: map[string]any{"limit": 500},
+		})
+		require.Equal(t, 200, res9.StatusCode)
+		require.Len(t, res9.Errors, 1)
+		require.Equal(t, string(ErrorCodeQUERYTOOCOMPLEX), res9.Errors[0].Extensions["code"])
+	})
+
+	t.Run("events", func(t *testing.T) {
+		t.Parallel()
+
+		sender := NewEmail(t, "one")
+		recipient := NewEmail(t, "two")
+		res := tcl.Auth(t, AuthParams{
+			Username: sender,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res.StatusCode())
+		senderToken := *res.JSON200.Token
+
+		res2 := tcl.Auth(t, AuthParams{
+			Username: recipient,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res2.StatusCode())
+		recipientToken := *res2.JSON200.Token
+
+		stream := tcl.Events(t, EventsParams{})
+		require.Equal(t, 401, stream.StatusCode)
+
+		stream = tcl.Events(t, EventsParams{Auth: recipientToken})
+		require.Equal(t, 200, stream.StatusCode)
+
+		res3 := tcl.CreateTransfer(t, CreateTransferParams{
+			Auth:   senderToken,
+			Amount: 10,
+			ToUser: recipient,
+		})
+		require.Equal(t, 201, res3.StatusCode())
+
+		// both events come from one transaction, their order is not defined
+		events := []Event{stream.Next(t), stream.Next(t)}
+		if events[0].ID > events[1].ID {
+			events[0], events[1] = events[1], events[0]
+		}
+		byKind := map[string]Event{events[0].Kind: events[0], events[1].Kind: events[1]}
+		require.JSONEq(t, `{ "coins": 1010, "delta": 10 }`, byKind["balance_changed"].Data)
+
+		var received Transfer
+		require.NoError(t, json.Unmarshal([]byte(byKind["transfer_received"].Data), &received))
+		require.Equal(t, res3.JSON201.Id, received.Id)
+		require.Equal(t, sender, received.FromUser)
+		require.Equal(t, int64(10), received.Amount)
+		require.WithinDuration(t, res3.JSON201.CreatedAt, received.CreatedAt, time.Millisecond)
+
+		// a reconnecting client gets what it missed
+		resumed := tcl.Events(t, EventsParams{
+			Auth:        recipientToken,
+			LastEventID: events[0].ID,
+		})
+		require.Equal(t, 200, resumed.StatusCode)
+		require.Equal(t, events[1], resumed.Next(t))
+
+		res4 := tcl.CreateOrder(t, CreateOrderParams{
+			Auth:      recipientToken,
+			MerchItem: "pen",
+		})
+		require.Equal(t, 201, res4.StatusCode())
+
+		res5 := tcl.MarkOrderReady(t, OrderParams{
+			Auth:    adminToken,
+			OrderId: res4.JSON201.Id,
+		})
+		require.Equal(t, 200, res5.StatusCode())
+
+		require.Equal(t, "balance_changed", stream.Next(t).Kind)
+		event := stream.Next(t)
+		require.Equal(t, "order_status_changed", event.Kind)
+		require.JSONEq(t, fmt.Sprintf(`{ "id": %d, "item": "pen", "status": "ready" }`, res4.JSON201.Id), event.Data)
+	})
+
+	t.Run("events resume after a gap", func(t *testing.T) {
+		t.Parallel()
+
+		userName := NewEmail(t)
+		res := tcl.Auth(t, AuthParams{
+			Username: userName,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res.StatusCode())
+		token := *res.JSON200.Token
+
+		stream := tcl.Events(t, EventsParams{Auth: token})
+		require.Equal(t, 200, stream.StatusCode)
+
+		res2 := tcl.CreateOrder(t, CreateOrderParams{
+			Auth:      token,
+			MerchItem: "pen",
+		})
+		require.Equal(t, 201, res2.StatusCode())
+		require.Equal(t, "balance_changed", stream.Next(t).Kind)
+
+		// the event of this transaction gets a lower id than the order event, but is committed after it
+		tx, err := tdb.PgxPool.Begin(context.TODO())
+		require.NoError(t, err)
+		defer tx.Rollback(context.TODO())
+		_, err = tx.Exec(context.TODO(), `UPDATE users SET coins = coins + 1 WHERE name = $1`, userName)
+		require.NoError(t, err)
+
+		res3 := tcl.MarkOrderReady(t, OrderParams{
+			Auth:    adminToken,
+			OrderId: res2.JSON201.Id,
+		})
+		require.Equal(t, 200, res3.StatusCode())
+		orderEvent := stream.Next(t)
+		require.Equal(t, "order_status_changed", orderEvent.Kind)
+
+		require.NoError(t, tx.Commit(context.TODO()))
+		lateEvent := stream.Next(t)
+		require.Equal(t, "balance_changed", lateEvent.Kind)
+		require.JSONEq(t, `{ "coins": 991, "delta": 1 }`, lateEvent.Data)
+		require.Greater(t, lateEvent.ID, orderEvent.ID)
+
+		// a client that saw the order event still gets the one committed later
+		resumed := tcl.Events(t, EventsParams{
+			Auth:        token,
+			LastEventID: orderEvent.ID,
+		})
+		require.Equal(t, 200, resumed.StatusCode)
+		require.Equal(t, lateEvent, resumed.Next(t))
+
+		// notifications sent while the listener reconnects are not lost
+		_, err = tdb.PgxPool.Exec(context.TODO(), `
+			SELECT pg_terminate_backend(pid) FROM pg_stat_activity
+			WHERE pid <> pg_backend_pid() AND query LIKE 'LISTEN %'`)
+		require.NoError(t, err)
+		_, err = tdb.PgxPool.Exec(context.TODO(), `UPDATE users SET coins = coins + 1 WHERE name = $1`, userName)
+		require.NoError(t, err)
+
+		for _, events := range []*EventStream{stream, resumed} {
+			event := events.Next(t)
+			require.Equal(t, "balance_changed", event.Kind)
+			require.JSONEq(t, `{ "coins": 992, "delta": 1 }`, event.Data)
+		}
+	})
+
+	t.Run("webhooks", func(t *testing.T) {
+		t.Parallel()
+
+		if serverConfig.Type != embed {
+			t.Skip("the server can not reach a webhook receiver of the test")
+		}
+
+		type webhookEvent struct {
+			Id        int64           `json:"id"`
+			Type      string          `json:"type"`
+			CreatedAt time.Time       `json:"createdAt"`
+			Data      json.RawMessage `json:"data"`
+		}
+
+		// subscriptions get events of all parallel tests, receivers keep every signed event
+		secret := "webhook_teste2e_secret"
+		received := make(chan webhookEvent, 1000)
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(400)
+				return
+			}
+			mac := hmac.New(sha256.New, []byte(secret))
+			mac.Write([]byte(r.Header.Get("X-Webhook-Timestamp") + "."))
+			mac.Write(body)
+			if r.Header.Get("X-Webhook-Signature") != "sha256="+hex.EncodeToString(mac.Sum(nil)) {
+				w.WriteHeader(401)
+				return
+			}
+			var event webhookEvent
+			if err = json.Unmarshal(body, &event); err != nil || r.Header.Get("X-Webhook-Event") != event.Type {
+				w.WriteHeader(400)
+				return
+			}
+			select {
+			case received <- event:
+			default:
+			}
+			w.WriteHeader(204)
+		}))
+		defer receiver.Close()
+
+		failing := make(chan webhookEvent, 1000)
+		failingReceiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var event webhookEvent
+			if json.NewDecoder(r.Body).Decode(&event) == nil {
+				select {
+				case failing <- event:
+				default:
+				}
+			}
+			w.WriteHeader(500)
+		}))
+		defer failingReceiver.Close()
+
+		// waitEvent returns the first event of the type with data of the id
+		waitEvent := func(t *testing.T, events chan webhookEvent, eventType string, id int64) webhookEvent {
+			t.Helper()
+			timeout := time.After(10 * time.Second)
+			for {
+				select {
+				case event := <-events:
+					var data struct {
+						Id int64 `json:"id"`
+					}
+					require.NoError(t, json.Unmarshal(event.Data, &data))
+					if event.Type == eventType && data.Id == id {
+						return event
+					}
+				case <-timeout:
+					t.Fatalf("no %s webhook of %d", eventType, id)
+				}
+			}
+		}
+
+		sender := NewEmail(t, "one")
+		res := tcl.Auth(t, AuthParams{
+			Username: sender,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res.StatusCode())
+		senderToken := *res.JSON200.Token
+
+		res2 := tcl.CreateWebhook(t, CreateWebhookParams{
+			Auth: senderToken,
+			Webhook: CreateWebhookSubscriptionRequest{
+				Url:    receiver.URL,
+				Events: []WebhookEventType{WebhookEventTypeCoinsTransferred},
+			},
+		})
+		require.Equal(t, 403, res2.StatusCode())
+
+		res3 := tcl.CreateWebhook(t, CreateWebhookParams{
+			Auth: adminToken,
+			Webhook: CreateWebhookSubscriptionRequest{
+				Url:    "ftp://example.com",
+				Events: []WebhookEventType{WebhookEventTypeCoinsTransferred},
+			},
+		})
+		require.Equal(t, 400, res3.StatusCode())
+
+		res4 := tcl.CreateWebhook(t, CreateWebhookParams{
+			Auth: adminToken,
+			Webhook: CreateWebhookSubscriptionRequest{
+				Url:    receiver.URL,
+				Events: []WebhookEventType{WebhookEventTypeCoinsTransferred, WebhookEventTypeMerchPurchased},
+				Secret: &secret,
+			},
+		})
+		require.Equal(t, 201, res4.StatusCode())
+		subscription := *res4.JSON201
+		require.Equal(t, secret, *subscription.Secret)
+		require.Equal(t, adminUser, subscription.CreatedBy)
+		defer tcl.DeleteWebhook(t, WebhookParams{Auth: adminToken, WebhookId: subscription.Id})
+
+		res5 := tcl.CreateWebhook(t, CreateWebhookParams{
+			Auth: adminToken,
+			Webhook: CreateWebhookSubscriptionRequest{
+				Url:    failingReceiver.URL,
+				Events: []WebhookEventType{WebhookEventTypeCoinsTransferred},
+			},
+		})
+		require.Equal(t, 201, res5.StatusCode())
+		failingSubscription := *res5.JSON201
+		// a generated secret is returned once
+		require.NotEmpty(t, *failingSubscription.Secret)
+		defer tcl.DeleteWebhook(t, WebhookParams{Auth: adminToken, WebhookId: failingSubscription.Id})
+
+		res6 := tcl.ListWebhooks(t, ListWebhooksParams{Auth: adminToken})
+		require.Equal(t, 200, res6.StatusCode())
+		var listed bool
+		for _, webhook := range *res6.JSON200 {
+			require.Nil(t, webhook.Secret)
+			if webhook.Id == subscription.Id {
+				listed = true
+				require.Equal(t, receiver.URL, webhook.Url)
+			}
+		}
+		require.True(t, listed)
+
+		recipient := NewEmail(t, "two")
+		require.Equal(t, 200, tcl.Auth(t, AuthParams{
+			Username: recipient,
+			Password: defPass,
+		}).StatusCode())
+
+		res7 := tcl.CreateTransfer(t, CreateTransferParams{
+			Auth:   senderToken,
+			Amount: 10,
+			ToUser: recipient,
+		})
+		require.Equal(t, 201, res7.StatusCode())
+
+		event := waitEvent(t, received, "coins.transferred", res7.JSON201.Id)
+		var transfer Transfer
+		require.NoError(t, json.Unmarshal(event.Data, &transfer))
+		require.Equal(t, sender, transfer.FromUser)
+		require.Equal(t, int64(10), transfer.Amount)
+
+		res8 := tcl.CreateOrder(t, CreateOrderParams{
+			Auth:      senderToken,
+			MerchItem: "pen",
+		})
+		require.Equal(t, 201, res8.StatusCode())
+
+		event = waitEvent(t, received, "merch.purchased", res8.JSON201.Id)
+		var order Order
+		require.NoError(t, json.Unmarshal(event.Data, &order))
+		require.Equal(t, sender, order.User)
+		require.Equal(t, "pen", order.Item)
+		require.Nil(t, order.PickupCode)
+
+		// the failing receiver gets every attempt until the delivery is dead
+		failedEvent := waitEvent(t, failing, "coins.transferred", res7.JSON201.Id)
+		deadStatus := WebhookDeliveryStatusDead
+		var dead WebhookDelivery
+		require.Eventually(t, func() bool {
+			res := tcl.ListWebhookDeliveries(t, ListWebhookDeliveriesParams{
+				Auth:      adminToken,
+				WebhookId: failingSubscription.Id,
+				Status:    &deadStatus,
+			})
+			if res.StatusCode() != 200 {
+				return false
+			}
+			for _, delivery := range *res.JSON200 {
+				if delivery.EventId == failedEvent.Id {
+					dead = delivery
+					return true
+				}
+			}
+			return false
+		}, 15*time.Second, 100*time.Millisecond)
+		require.Equal(t, int32(8), dead.Attempts)
+		require.Equal(t, int32(500), *dead.LastStatusCode)
+		require.NotNil(t, dead.LastError)
+
+		// the receiver got all attempts before the delivery became dead, only the redelivery is left to wait
+		for len(failing) > 0 {
+			<-failing
+		}
+
+		res9 := tcl.RedeliverWebhook(t, RedeliverWebhookParams{
+			Auth:       adminToken,
+			DeliveryId: dead.Id,
+		})
+		require.Equal(t, 200, res9.StatusCode())
+		require.Equal(t, WebhookDeliveryStatusPending, res9.JSON200.Status)
+		require.Equal(t, int32(0), res9.JSON200.Attempts)
+
+		waitEvent(t, failing, "coins.transferred", res7.JSON201.Id)
+
+		res10 := tcl.RedeliverWebhook(t, RedeliverWebhookParams{
+			Auth:       adminToken,
+			DeliveryId: -1,
+		})
+		require.Equal(t, 404, res10.StatusCode())
+		require.Equal(t, ErrorCodeWEBHOOKDELIVERYNOTFOUND, res10.JSON404.Code)
+
+		res11 := tcl.DeleteWebhook(t, WebhookParams{
+			Auth:      adminToken,
+			WebhookId: failingSubscription.Id,
+		})
+		require.Equal(t, 204, res11.StatusCode())
+
+		res12 := tcl.ListWebhookDeliveries(t, ListWebhookDeliveriesParams{
+			Auth:      adminToken,
+			WebhookId: failingSubscription.Id,
+		})
+		require.Equal(t, 404, res12.StatusCode())
+		require.Equal(t, ErrorCodeWEBHOOKNOTFOUND, res12.JSON404.Code)
+	})
+
+	t.Run("unknown webhooks", func(t *testing.T) {
+		t.Parallel()
+
+		// ids are identities, tests never get this far
+		unknownID := int64(1 << 40)
+
+		res := tcl.DeleteWebhook(t, WebhookParams{
+			Auth:      adminToken,
+			WebhookId: unknownID,
+		})
+		require.Equal(t, 404, res.StatusCode())
+		require.Equal(t, ErrorCodeWEBHOOKNOTFOUND, res.JSON404.Code)
+
+		res2 := tcl.ListWebhookDeliveries(t, ListWebhookDeliveriesParams{
+			Auth:      adminToken,
+			WebhookId: unknownID,
+		})
+		require.Equal(t, 404, res2.StatusCode())
+		require.Equal(t, ErrorCodeWEBHOOKNOTFOUND, res2.JSON404.Code)
+
+		res3 := tcl.RedeliverWebhook(t, RedeliverWebhookParams{
+			Auth:       adminToken,
+			DeliveryId: unknownID,
+		})
+		require.Equal(t, 404, res3.StatusCode())
+		require.Equal(t, ErrorCodeWEBHOOKDELIVERYNOTFOUND, res3.JSON404.Code)
+	})
+
+	t.Run("event bus", func(t *testing.T) {
+		t.Parallel()
+
+		bus, ok := merchShopEventBus.(*eventbus.Memory)
+		if serverConfig.Type != embed || !ok {
+			t.Skip("published events are readable only from the embed server")
+		}
+
+		// waitBusEvent returns the event of the type with data matching the fields, events of parallel tests are skipped
+		waitBusEvent := func(t *testing.T, eventType string, fields map[string]any) eventbus.Event {
+			t.Helper()
+			var found eventbus.Event
+			require.Eventually(t, func() bool {
+				for _, event := range bus.Events() {
+					if event.Type != eventType {
+						continue
+					}
+					var data map[string]any
+					if json.Unmarshal(event.Data, &data) != nil {
+						continue
+					}
+					matched := true
+					for k, v := range fields {
+						matched = matched && data[k] == v
+					}
+					if matched {
+						found = event
+						return true
+					}
+				}
+				return false
+			}, 10*time.Second, 50*time.Millisecond, "no %s event with %v", eventType, fields)
+
+			require.NoError(t, eventbus.Validate(found))
+			require.Equal(t, 1, found.Version)
+			require.Equal(t, "events."+eventType+".v1", found.Subject())
+			return found
+		}
+
+		sender := NewEmail(t, "one")
+		res := tcl.Auth(t, AuthParams{
+			Username: sender,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res.StatusCode())
+		senderToken := *res.JSON200.Token
+
+		registered := waitBusEvent(t, "UserRegistered", map[string]any{"name": sender})
+		require.JSONEq(t, fmt.Sprintf(`{ "name": %q, "coins": 1000 }`, sender), string(registered.Data))
+
+		// the next sign in is not a registration
+		res2 := tcl.Auth(t, AuthParams{
+			Username: sender,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res2.StatusCode())
+
+		recipient := NewEmail(t, "two")
+		res3 := tcl.Auth(t, AuthParams{
+			Username: recipient,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res3.StatusCode())
+
+		res4 := tcl.CreateTransfer(t, CreateTransferParams{
+			Auth:   senderToken,
+			Amount: 10,
+			ToUser: recipient,
+		})
+		require.Equal(t, 201, res4.StatusCode())
+
+		transferred := waitBusEvent(t, "CoinsTransferred", map[string]any{"id": float64(res4.JSON201.Id)})
+		var transfer Transfer
+		require.NoError(t, json.Unmarshal(transferred.Data, &transfer))
+		require.Equal(t, sender, transfer.FromUser)
+		require.Equal(t, recipient, transfer.ToUser)
+		require.Equal(t, int64(10), transfer.Amount)
+		require.Greater(t, transferred.ID, registered.ID)
+
+		res5 := tcl.CreateOrder(t, CreateOrderParams{
+			Auth:      senderToken,
+			MerchItem: "pen",
+			ForUser:   &recipient,
+		})
+		require.Equal(t, 201, res5.StatusCode())
+
+		purchased := waitBusEvent(t, "MerchPurchased", map[string]any{"id": float64(res5.JSON201.Id)})
+		var order Order
+		require.NoError(t, json.Unmarshal(purchased.Data, &order))
+		require.Equal(t, recipient, order.User)
+		require.Equal(t, sender, order.Payer)
+		require.Nil(t, order.PickupCode)
+
+		// a bundle publishes a purchase per unit
+		res6 := tcl.CreateBundle(t, CreateBundleParams{
+			Auth: adminToken,
+			Bundle: Bundle{
+				Slug:     "e2e-events-pack",
+				Price:    60,
+				StartsAt: time.Now().Add(-time.Minute),
+				EndsAt:   time.Now().Add(time.Hour),
+				Items: []BundleItem{
+					{Item: "book", Quantity: 1},
+					{Item: "cup", Quantity: 1},
+				},
+			},
+		})
+		require.Equal(t, 200, res6.StatusCode())
+
+		res7 := tcl.BuyBundle(t, BuyBundleParams{
+			Auth:   senderToken,
+			Bundle: "e2e-events-pack",
+		})
+		require.Equal(t, 200, res7.StatusCode())
+
+		for _, item := range []string{"book", "cup"} {
+			purchased := waitBusEvent(t, "MerchPurchased", map[string]any{"user": sender, "item": item})
+			require.NoError(t, json.Unmarshal(purchased.Data, &order))
+			require.Equal(t, sender, order.Payer)
+		}
+
+		// events are published in the outbox order, a second registration would be published before the order
+		var registrations int
+		for _, event := range bus.Events() {
+			var data struct {
+				Name string `json:"name"`
+			}
+			if event.Type == "UserRegistered" && json.Unmarshal(event.Data, &data) == nil && data.Name == sender {
+				registrations++
+			}
+		}
+		require.Equal(t, 1, registrations)
+	})
+
+	t.Run("docs", func(t *testing.T) {
+		t.Parallel()
+
+		res, body := tcl.Get(t, "/api/openapi.json")
+		require.Equal(t, 200, res.StatusCode)
+		require.Equal(t, "application/json", res.Header.Get("Content-Type"))
+
+		var spec struct {
+			Servers []struct {
+				Url string `json:"url"`
+			} `json:"servers"`
+			Paths map[string]any `json:"paths"`
+		}
+		require.NoError(t, json.Unmarshal(body, &spec))
+		// without PUBLIC_URL "try it out" goes to the listen address
+		require.Len(t, spec.Servers, 1)
+		require.True(t, strings.HasPrefix(spec.Servers[0].Url, "http://"), spec.Servers[0].Url)
+		if serverConfig.Type == embed {
+			// the embed server is configured with port 0 to listen on a random one
+			require.Equal(t, "http://localhost:0", spec.Servers[0].Url)
+		}
+		require.Contains(t, spec.Paths, "/api/sendCoin")
+
+		res, body = tcl.Get(t, "/api/openapi.yaml")
+		require.Equal(t, 200, res.StatusCode)
+		require.Equal(t, "application/yaml", res.Header.Get("Content-Type"))
+		require.Contains(t, string(body), "/api/sendCoin:")
+
+		// swagger ui assets are served from the binary, not from cdn
+		res, body = tcl.Get(t, "/api/docs")
+		require.Equal(t, 200, res.StatusCode)
+		require.Contains(t, string(body), "swagger-ui-bundle.js")
+
+		res, body = tcl.Get(t, "/api/docs/swagger-initializer.js")
+		require.Equal(t, 200, res.StatusCode)
+		require.Contains(t, string(body), "/api/openapi.json")
+
+		res, _ = tcl.Get(t, "/api/docs/swagger-ui-bundle.js")
+		require.Equal(t, 200, res.StatusCode)
+	})
+
+	t.Run("unknown admin route", func(t *testing.T) {
+		t.Parallel()
+
+		userName := NewEmail(t)
+		res := tcl.Auth(t, AuthParams{
+			Username: userName,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res.StatusCode())
+
+		// routes missing in the spec are not public, admin ones included
+		res2, body := tcl.Get(t, "/api/admin/unknown")
+		require.Equal(t, 401, res2.StatusCode)
+		var errRes ErrorResponse
+		require.NoError(t, json.Unmarshal(body, &errRes))
+		require.Equal(t, ErrorCodeUNAUTHORIZED, errRes.Code)
+
+		res3, body := tcl.GetWithAuth(t, *res.JSON200.Token, "/api/admin/unknown")
+		require.Equal(t, 403, res3.StatusCode)
+		require.NoError(t, json.Unmarshal(body, &errRes))
+		require.Equal(t, ErrorCodeFORBIDDEN, errRes.Code)
+
+		res4, _ := tcl.GetWithAuth(t, adminToken, "/api/admin/unknown")
+		require.Equal(t, 404, res4.StatusCode)
+	})
+
+	t.Run("catalog", func(t *testing.T) {
+		t.Parallel()
+
+		category := "clothes"
+		sort := MerchSortMinusPrice
+		limit, offset := int32(2), int32(2)
+		langRu := "ru-RU,ru;q=0.9"
+		search := "%"
+		minPrice, maxPrice := int64(100), int64(50)
+
+		res := tcl.ListMerch(t, GetApiMerchParams{
+			Category: &category,
+			Sort:     &sort,
+			Limit:    &limit,
+		})
+		require.Equal(t, 200, res.StatusCode())
+		assert.Equal(t, "en", res.HTTPResponse.Header.Get("Content-Language"))
+		assert.Equal(t, int64(4), res.JSON200.Total)
+		require.Len(t, res.JSON200.Items, 2)
+		assert.Equal(t, "pink-hoody", res.JSON200.Items[0].Slug)
+		assert.Equal(t, "Pink hoody", res.JSON200.Items[0].Name)
+		assert.Nil(t, res.JSON200.Items[0].ImageUrl, "no image is served for seeded items")
+		assert.Equal(t, "hoody", res.JSON200.Items[1].Slug)
+
+		res2 := tcl.ListMerch(t, GetApiMerchParams{
+			Category: &category,
+			Sort:     &sort,
+			Limit:    &limit,
+			Offset:   &offset,
+		}, WithAcceptLanguage(langRu))
+		require.Equal(t, 200, res2.StatusCode())
+		assert.Equal(t, "ru", res2.HTTPResponse.Header.Get("Content-Language"))
+		require.Len(t, res2.JSON200.Items, 2)
+		assert.Equal(t, "Футболка", res2.JSON200.Items[0].Name)
+		assert.Equal(t, "Носки", res2.JSON200.Items[1].Name)
+
+		// wildcards are matched literally
+		res3 := tcl.ListMerch(t, GetApiMerchParams{Q: &search})
+		require.Equal(t, 200, res3.StatusCode())
+		assert.Equal(t, int64(0), res3.JSON200.Total)
+
+		res4 := tcl.ListMerch(t, GetApiMerchParams{MinPrice: &minPrice, MaxPrice: &maxPrice})
+		require.Equal(t, 400, res4.StatusCode())
+
+		res5 := tcl.ListMerchCategories(t, WithAcceptLanguage(langRu))
+		require.Equal(t, 200, res5.StatusCode())
+		assert.Contains(t, *res5.JSON200, MerchCategory{Slug: "office", Name: "Для офиса"})
+	})
+
+	t.Run("wishlist", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := tdb.PgxPool.Exec(context.TODO(), `
+INSERT INTO merch_variants (merch_item, variant, attributes, price, stock)
+VALUES ('hoody', 'wishlist-e2e', '{"color": "silver"}', 120, 0);
+`)
+		require.NoError(t, err)
+
+		res := tcl.Auth(t, AuthParams{
+			Username: NewEmail(t),
+			Password: defPass,
+		})
+		require.Equal(t, 200, res.StatusCode())
+		userToken := *res.JSON200.Token
+
+		// leave 50 coins
+		res2 := tcl.SendCoins(t, SendCoinParams{
+			Auth:   userToken,
+			Amount: 950,
+			ToUser: adminUser,
+		})
+		require.Equal(t, 200, res2.StatusCode())
+
+		variant := "wishlist-e2e"
+		res3 := tcl.AddWishlistItem(t, WishlistItemParams{
+			Auth:      userToken,
+			MerchItem: "hoody",
+			Variant:   &variant,
+		})
+		require.Equal(t, 200, res3.StatusCode())
+		require.Len(t, res3.JSON200.Items, 1)
+		assert.EqualValues(t, 50, res3.JSON200.Coins)
+		assert.EqualValues(t, 120, res3.JSON200.Items[0].Price)
+		assert.EqualValues(t, 70, res3.JSON200.Items[0].MissingCoins)
+		assert.False(t, res3.JSON200.Items[0].InStock)
+
+		res4 := tcl.AddWishlistItem(t, WishlistItemParams{
+			Auth:      userToken,
+			MerchItem: "hoody",
+			Variant:   &variant,
+		})
+		require.Equal(t, 409, res4.StatusCode())
+
+		res5 := tcl.AddWishlistItem(t, WishlistItemParams{
+			Auth:      userToken,
+			MerchItem: "cup",
+		})
+		require.Equal(t, 200, res5.StatusCode())
+		require.Len(t, res5.JSON200.Items, 2)
+		assert.EqualValues(t, 0, res5.JSON200.Items[1].MissingCoins)
+
+		res6 := tcl.DeleteWishlistItem(t, WishlistItemParams{
+			Auth:      userToken,
+			MerchItem: "cup",
+		})
+		require.Equal(t, 200, res6.StatusCode())
+		require.Len(t, res6.JSON200.Items, 1)
+
+		res7 := tcl.DeleteWishlistItem(t, WishlistItemParams{
+			Auth:      userToken,
+			MerchItem: "cup",
+		})
+		require.Equal(t, 404, res7.StatusCode())
+
+		res8 := tcl.RestockMerchVariant(t, RestockParams{
+			Auth:      adminToken,
+			MerchItem: "hoody",
+			Variant:   variant,
+			Quantity:  2,
+		})
+		require.Equal(t, 200, res8.StatusCode())
+		assert.EqualValues(t, 2, res8.JSON200.Stock)
+		assert.EqualValues(t, 1, res8.JSON200.Notified)
+
+		// not sold out before, nobody is notified
+		res9 := tcl.RestockMerchVariant(t, RestockParams{
+			Auth:      adminToken,
+			MerchItem: "hoody",
+			Variant:   variant,
+			Quantity:  1,
+		})
+		require.Equal(t, 200, res9.StatusCode())
+		assert.EqualValues(t, 0, res9.JSON200.Notified)
+
+		// sale starts later to not change hoody price for other tests
+		item := "hoody"
+		percentOff := int32(10)
+		res10 := tcl.CreatePromotion(t, CreatePromotionParams{
+			Auth: adminToken,
+			Promotion: CreatePromotionRequest{
+				Item:       &item,
+				PercentOff: &percentOff,
+				StartsAt:   time.Now().Add(24 * time.Hour),
+				EndsAt:     time.Now().Add(48 * time.Hour),
+			},
+		})
+		require.Equal(t, 200, res10.StatusCode())
+
+		res11 := tcl.ListNotifications(t, ListNotificationsParams{Auth: userToken})
+		require.Equal(t, 200, res11.StatusCode())
+		require.Len(t, *res11.JSON200, 2)
+		assert.Equal(t, NotificationKindItemOnSale, (*res11.JSON200)[0].Kind)
+		assert.Equal(t, NotificationKindItemRestocked, (*res11.JSON200)[1].Kind)
+		assert.Equal(t, "hoody", *(*res11.JSON200)[1].Item)
+	})
+
+	t.Run("wishlist seeded variant", func(t *testing.T) {
+		t.Parallel()
+
+		// seeded variants have unlimited stock, restock limits it
+		variant := "l"
+		var tokens []string
+		for range 2 {
+			res := tcl.Auth(t, AuthParams{
+				Username: NewEmail(t),
+				Password: defPass,
+			})
+			require.Equal(t, 200, res.StatusCode())
+			tokens = append(tokens, *res.JSON200.Token)
+		}
+		waiterToken, buyerToken := tokens[0], tokens[1]
+
+		res := tcl.AddWishlistItem(t, WishlistItemParams{
+			Auth:      waiterToken,
+			MerchItem: "hoody",
+			Variant:   &variant,
+		})
+		require.Equal(t, 200, res.StatusCode())
+		assert.True(t, res.JSON200.Items[0].InStock)
+
+		// an unlimited variant was not sold out, nobody is notified
+		res2 := tcl.RestockMerchVariant(t, RestockParams{
+			Auth:      adminToken,
+			MerchItem: "hoody",
+			Variant:   variant,
+			Quantity:  1,
+		})
+		require.Equal(t, 200, res2.StatusCode())
+		assert.EqualValues(t, 1, res2.JSON200.Stock)
+		assert.EqualValues(t, 0, res2.JSON200.Notified)
+
+		res3 := tcl.BuyMerch(t, BuyMerchParams{
+			Auth:      buyerToken,
+			MerchItem: "hoody",
+			Variant:   &variant,
+		})
+		require.Equal(t, 200, res3.StatusCode())
+
+		res4 := tcl.BuyMerch(t, BuyMerchParams{
+			Auth:      buyerToken,
+			MerchItem: "hoody",
+			Variant:   &variant,
+		})
+		require.Equal(t, 400, res4.StatusCode())
+
+		res5 := tcl.RestockMerchVariant(t, RestockParams{
+			Auth:      adminToken,
+			MerchItem: "hoody",
+			Variant:   variant,
+			Quantity:  2,
+		})
+		require.Equal(t, 200, res5.StatusCode())
+		assert.EqualValues(t, 2, res5.JSON200.Stock)
+		assert.EqualValues(t, 1, res5.JSON200.Notified)
+
+		// the parallel wishlist test announces a hoody sale, so only restocks are counted
+		res6 := tcl.ListNotifications(t, ListNotificationsParams{Auth: waiterToken})
+		require.Equal(t, 200, res6.StatusCode())
+		var restocked []Notification
+		for _, notification := range *res6.JSON200 {
+			if notification.Kind == NotificationKindItemRestocked {
+				restocked = append(restocked, notification)
+			}
+		}
+		require.Len(t, restocked, 1)
+		assert.Equal(t, "hoody", *restocked[0].Item)
+
+		res7 := tcl.RestockMerchVariant(t, RestockParams{
+			Auth:      adminToken,
+			MerchItem: "hoody",
+			Variant:   "no-such-variant",
+			Quantity:  1,
+		})
+		require.Equal(t, 400, res7.StatusCode())
+		require.Equal(t, ErrorCodeUNKNOWNVARIANT, res7.JSON400.Code)
+	})
+
+	t.Run("notifications", func(t *testing.T) {
+		t.Parallel()
+
+		sender := NewEmail(t, "one")
+		recipient := NewEmail(t, "two")
+		res := tcl.Auth(t, AuthParams{
+			Username: sender,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res.StatusCode())
+		senderToken := *res.JSON200.Token
+
+		res2 := tcl.Auth(t, AuthParams{
+			Username: recipient,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res2.StatusCode())
+		recipientToken := *res2.JSON200.Token
+
+		for _, amount := range []int{10, 20, 30} {
+			res3 := tcl.SendCoins(t, SendCoinParams{
+				Auth:   senderToken,
+				Amount: amount,
+				ToUser: recipient,
+			})
+			require.Equal(t, 200, res3.StatusCode())
+		}
+
+		limit := int32(2)
+		res4 := tcl.ListNotifications(t, ListNotificationsParams{
+			Auth:  recipientToken,
+			Limit: &limit,
+		})
+		require.Equal(t, 200, res4.StatusCode())
+		require.Equal(t, "3", res4.HTTPResponse.Header.Get("X-Total-Count"))
+		require.Equal(t, "3", res4.HTTPResponse.Header.Get("X-Unread-Count"))
+		require.Len(t, *res4.JSON200, 2)
+		latest := (*res4.JSON200)[0]
+		assert.Equal(t, NotificationKindCoinsReceived, latest.Kind)
+		assert.Contains(t, latest.Message, sender)
+		assert.Contains(t, latest.Message, "30")
+		assert.Nil(t, latest.ReadAt)
+
+		offset := int32(2)
+		res5 := tcl.ListNotifications(t, ListNotificationsParams{
+			Auth:   recipientToken,
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		require.Equal(t, 200, res5.StatusCode())
+		require.Len(t, *res5.JSON200, 1)
+		assert.Contains(t, (*res5.JSON200)[0].Message, "10")
+
+		res6 := tcl.ReadNotification(t, NotificationParams{
+			Auth:           recipientToken,
+			NotificationId: latest.Id,
+		})
+		require.Equal(t, 200, res6.StatusCode())
+		require.NotNil(t, res6.JSON200.ReadAt)
+
+		// notifications of other users look missing
+		res7 := tcl.ReadNotification(t, NotificationParams{
+			Auth:           senderToken,
+			NotificationId: latest.Id,
+		})
+		require.Equal(t, 404, res7.StatusCode())
+		require.Equal(t, ErrorCodeNOTIFICATIONNOTFOUND, res7.JSON404.Code)
+
+		unread := true
+		res8 := tcl.ListNotifications(t, ListNotificationsParams{
+			Auth:   recipientToken,
+			Unread: &unread,
+		})
+		require.Equal(t, 200, res8.StatusCode())
+		require.Equal(t, "2", res8.HTTPResponse.Header.Get("X-Total-Count"))
+		require.Equal(t, "2", res8.HTTPResponse.Header.Get("X-Unread-Count"))
+		require.Len(t, *res8.JSON200, 2)
+
+		res9 := tcl.ReadAllNotifications(t, recipientToken)
+		require.Equal(t, 204, res9.StatusCode())
+
+		res10 := tcl.ListNotifications(t, ListNotificationsParams{Auth: recipientToken})
+		require.Equal(t, 200, res10.StatusCode())
+		require.Equal(t, "3", res10.HTTPResponse.Header.Get("X-Total-Count"))
+		require.Equal(t, "0", res10.HTTPResponse.Header.Get("X-Unread-Count"))
+
+		res11 := tcl.SetNotificationPreferences(t, SetNotificationPreferencesParams{
+			Auth:  recipientToken,
+			Muted: []NotificationKind{NotificationKindCoinsReceived},
+		})
+		require.Equal(t, 200, res11.StatusCode())
+		require.Equal(t, []NotificationKind{NotificationKindCoinsReceived}, res11.JSON200.Muted)
+
+		res12 := tcl.GetNotificationPreferences(t, recipientToken)
+		require.Equal(t, 200, res12.StatusCode())
+		require.Equal(t, []NotificationKind{NotificationKindCoinsReceived}, res12.JSON200.Muted)
+
+		res13 := tcl.SetNotificationPreferences(t, SetNotificationPreferencesParams{
+			Auth:  recipientToken,
+			Muted: []NotificationKind{"payment_requested"},
+		})
+		require.Equal(t, 400, res13.StatusCode())
+
+		// muted kinds are not created at all
+		res14 := tcl.SendCoins(t, SendCoinParams{
+			Auth:   senderToken,
+			Amount: 40,
+			ToUser: recipient,
+		})
+		require.Equal(t, 200, res14.StatusCode())
+
+		res15 := tcl.CreateOrder(t, CreateOrderParams{
+			Auth:      recipientToken,
+			MerchItem: "pen",
+		})
+		require.Equal(t, 201, res15.StatusCode())
+
+		res16 := tcl.MarkOrderReady(t, OrderParams{
+			Auth:    adminToken,
+			OrderId: res15.JSON201.Id,
+		})
+		require.Equal(t, 200, res16.StatusCode())
+
+		res17 := tcl.ListNotifications(t, ListNotificationsParams{Auth: recipientToken})
+		require.Equal(t, 200, res17.StatusCode())
+		require.Equal(t, "4", res17.HTTPResponse.Header.Get("X-Total-Count"))
+		require.Equal(t, "1", res17.HTTPResponse.Header.Get("X-Unread-Count"))
+		ready := (*res17.JSON200)[0]
+		assert.Equal(t, NotificationKindOrderReady, ready.Kind)
+		assert.Equal(t, "pen", *ready.Item)
+	})
+
+	t.Run("emails", func(t *testing.T) {
+		t.Parallel()
+
+		if mail.Url == "" {
+			t.Skip("emails of an external server can not be checked")
+		}
+
+		sender := NewEmail(t, "one")
+		recipient := NewEmail(t, "two")
+		res := tcl.Auth(t, AuthParams{
+			Username: sender,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res.StatusCode())
+		senderToken := *res.JSON200.Token
+
+		res2 := tcl.Auth(t, AuthParams{
+			Username: recipient,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res2.StatusCode())
+		recipientToken := *res2.JSON200.Token
+
+		res3 := tcl.GetNotificationPreferences(t, recipientToken)
+		require.Equal(t, 200, res3.StatusCode())
+		require.Equal(t, &EmailPreferences{Enabled: true, Language: EmailPreferencesLanguageEn}, res3.JSON200.Email)
+
+		res4 := tcl.SetNotificationPreferences(t, SetNotificationPreferencesParams{
+			Auth:  recipientToken,
+			Muted: []NotificationKind{},
+			Email: &EmailPreferences{Enabled: true, Language: EmailPreferencesLanguageRu},
+		})
+		require.Equal(t, 200, res4.StatusCode())
+		require.Equal(t, &EmailPreferences{Enabled: true, Language: EmailPreferencesLanguageRu}, res4.JSON200.Email)
+
+		res5 := tcl.SendCoins(t, SendCoinParams{
+			Auth:   senderToken,
+			Amount: 15,
+			ToUser: recipient,
+		})
+		require.Equal(t, 200, res5.StatusCode())
+
+		var emails []CaughtEmail
+		require.Eventually(t, func() bool {
+			var err error
+			emails, err = mail.Emails(recipient, "Вы получили")
+			return err == nil && len(emails) == 1
+		}, 5*time.Second, 50*time.Millisecond)
+		assert.Equal(t, smtpFrom, emails[0].From.Address)
+		assert.Equal(t, "Вы получили 15 монет", emails[0].Subject)
+		assert.Contains(t, emails[0].Text, sender+" отправил вам 15 монет")
+		assert.Contains(t, emails[0].HTML, "<b>15</b>")
+
+		// settings not sent are kept, opted out users still get notifications
+		res6 := tcl.SetNotificationPreferences(t, SetNotificationPreferencesParams{
+			Auth:  recipientToken,
+			Muted: []NotificationKind{},
+			Email: &EmailPreferences{Enabled: false, Language: EmailPreferencesLanguageRu},
+		})
+		require.Equal(t, 200, res6.StatusCode())
+
+		res7 := tcl.SetNotificationPreferences(t, SetNotificationPreferencesParams{
+			Auth:  recipientToken,
+			Muted: []NotificationKind{},
+		})
+		require.Equal(t, 200, res7.StatusCode())
+		require.Equal(t, &EmailPreferences{Enabled: false, Language: EmailPreferencesLanguageRu}, res7.JSON200.Email)
+
+		res8 := tcl.SendCoins(t, SendCoinParams{
+			Auth:   senderToken,
+			Amount: 5,
+			ToUser: recipient,
+		})
+		require.Equal(t, 200, res8.StatusCode())
+
+		res9 := tcl.ListNotifications(t, ListNotificationsParams{Auth: recipientToken})
+		require.Equal(t, 200, res9.StatusCode())
+		require.Equal(t, "2", res9.HTTPResponse.Header.Get("X-Total-Count"))
+
+		res10 := tcl.SetNotificationPreferences(t, SetNotificationPreferencesParams{
+			Auth:  recipientToken,
+			Muted: []NotificationKind{},
+			Email: &EmailPreferences{Enabled: true, Language: EmailPreferencesLanguageEn},
+		})
+		require.Equal(t, 200, res10.StatusCode())
+
+		res11 := tcl.CreateOrder(t, CreateOrderParams{
+			Auth:      recipientToken,
+			MerchItem: "pen",
+		})
+		require.Equal(t, 201, res11.StatusCode())
+
+		res12 := tcl.MarkOrderReady(t, OrderParams{
+			Auth:    adminToken,
+			OrderId: res11.JSON201.Id,
+		})
+		require.Equal(t, 200, res12.StatusCode())
+
+		require.Eventually(t, func() bool {
+			var err error
+			emails, err = mail.Emails(recipient, "Your order")
+			return err == nil && len(emails) == 1
+		}, 5*time.Second, 50*time.Millisecond)
+		assert.Equal(t, fmt.Sprintf("Your order #%d is ready", res11.JSON201.Id), emails[0].Subject)
+		assert.Contains(t, emails[0].Text, "(pen) is ready for pickup")
+
+		// the email of the opted out transfer is never queued
+		coinEmails, err := mail.Emails(recipient, "Вы получили")
+		require.NoError(t, err)
+		require.Len(t, coinEmails, 1)
+		assert.NotEqual(t, emails[0].MessageID, coinEmails[0].MessageID)
+
+		coinEmails, err = mail.Emails(sender, "")
+		require.NoError(t, err)
+		for _, email := range coinEmails {
+			assert.NotContains(t, email.Subject, "coins")
+		}
+	})
+
+	t.Run("account recovery", func(t *testing.T) {
+		t.Parallel()
+
+		if mail.Url == "" {
+			t.Skip("emails of an external server can not be checked")
+		}
+
+		// tokens are the only 64 hex digits in the emails
+		tokenRe := regexp.MustCompile(`[0-9a-f]{64}`)
+
+		userName := NewEmail(t)
+		res := tcl.Auth(t, AuthParams{
+			Username: userName,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res.StatusCode())
+		userToken := *res.JSON200.Token
+
+		var emails []CaughtEmail
+		require.Eventually(t, func() bool {
+			var err error
+			emails, err = mail.Emails(userName, "Confirm your email")
+			return err == nil && len(emails) == 1
+		}, 5*time.Second, 50*time.Millisecond)
+		verifyToken := tokenRe.FindString(emails[0].Text)
+		require.NotEmpty(t, verifyToken)
+		assert.Contains(t, emails[0].HTML, verifyToken)
+
+		res2 := tcl.VerifyEmail(t, strings.Repeat("0", 64))
+		require.Equal(t, 400, res2.StatusCode())
+		require.Equal(t, ErrorCodeINVALIDTOKEN, res2.JSON400.Code)
+
+		verified, err := tdb.Queries.IsEmailVerified(context.TODO(), userName)
+		require.NoError(t, err)
+		require.False(t, verified)
+
+		res3 := tcl.VerifyEmail(t, verifyToken)
+		require.Equal(t, 204, res3.StatusCode())
+
+		verified, err = tdb.Queries.IsEmailVerified(context.TODO(), userName)
+		require.NoError(t, err)
+		require.True(t, verified)
+
+		// tokens are single-use
+		res4 := tcl.VerifyEmail(t, verifyToken)
+		require.Equal(t, 400, res4.StatusCode())
+		require.Equal(t, ErrorCodeINVALIDTOKEN, res4.JSON400.Code)
+
+		res5 := tcl.SendVerifyEmail(t, userToken)
+		require.Equal(t, 204, res5.StatusCode())
+
+		// unknown users look the same
+		res6 := tcl.ForgotPassword(t, NewEmail(t, "unknown"))
+		require.Equal(t, 204, res6.StatusCode())
+
+		for range 3 {
+			res7 := tcl.ForgotPassword(t, userName)
+			require.Equal(t, 204, res7.StatusCode())
+		}
+		res8 := tcl.ForgotPassword(t, userName)
+		require.Equal(t, 429, res8.StatusCode())
+		require.Equal(t, ErrorCodeTOOMANYREQUESTS, res8.JSON429.Code)
+
+		require.Eventually(t, func() bool {
+			emails, err = mail.Emails(userName, "Reset your password")
+			return err == nil && len(emails) == 3
+		}, 5*time.Second, 50*time.Millisecond)
+		var resetTokens []string
+		for _, email := range emails {
+			resetTokens = append(resetTokens, tokenRe.FindString(email.Text))
+		}
+
+		// the verification token is not a reset one
+		res9 := tcl.ResetPassword(t, ResetPasswordParams{
+			Token:    verifyToken,
+			Password: "newpass123",
+		})
+		require.Equal(t, 400, res9.StatusCode())
+
+		res10 := tcl.ResetPassword(t, ResetPasswordParams{
+			Token:    resetTokens[1],
+			Password: "newpass123",
+		})
+		require.Equal(t, 204, res10.StatusCode())
 
-		t.Run("green", func(t *testing.T) {
-			t.Parallel()
+		// other reset tokens are revoked by the reset
+		res11 := tcl.ResetPassword(t, ResetPasswordParams{
+			Token:    resetTokens[0],
+			Password: "newpass456",
+		})
+		require.Equal(t, 400, res11.StatusCode())
+		require.Equal(t, ErrorCodeINVALIDTOKEN, res11.JSON400.Code)
 
-			t.Run("new user", func(t *testing.T) {
-				t.Parallel()
+		res12 := tcl.Auth(t, AuthParams{
+			Username: userName,
+			Password: defPass,
+		})
+		require.Equal(t, 401, res12.StatusCode())
 
-				res := tcl.Auth(t, AuthParams{
-					Username: NewEmail(t),
-					Password: defPass,
-				})
-				require.Equal(t, 200, res.StatusCode())
+		res13 := tcl.Auth(t, AuthParams{
+			Username: userName,
+			Password: "newpass123",
+		})
+		require.Equal(t, 200, res13.StatusCode())
+	})
 
-			})
+	t.Run("two factor", func(t *testing.T) {
+		t.Parallel()
 
-			t.Run("repeated login", func(t *testing.T) {
-				t.Parallel()
+		userName := NewEmail(t)
+		res := tcl.Auth(t, AuthParams{
+			Username: userName,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res.StatusCode())
+		userToken := *res.JSON200.Token
 
-				mail := NewEmail(t)
+		res2 := tcl.GetTwoFactor(t, userToken)
+		require.Equal(t, 200, res2.StatusCode())
+		assert.False(t, res2.JSON200.Enabled)
+		assert.False(t, res2.JSON200.Required)
 
-				res := tcl.Auth(t, AuthParams{
-					Username: mail,
-					Password: defPass,
-				})
-				require.Equal(t, 200, res.StatusCode())
+		res3 := tcl.ConfirmTwoFactor(t, TwoFactorCodeParams{
+			Auth: userToken,
+			Code: "123456",
+		})
+		require.Equal(t, 409, res3.StatusCode())
+		require.Equal(t, ErrorCodeTWOFACTORNOTENABLED, res3.JSON409.Code)
+
+		res4 := tcl.EnrollTwoFactor(t, userToken)
+		require.Equal(t, 200, res4.StatusCode())
+		assert.True(t, strings.HasPrefix(res4.JSON200.Uri, "otpauth://totp/merch-store:"))
+		assert.Contains(t, res4.JSON200.Uri, "secret="+res4.JSON200.Secret)
+		secret, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(res4.JSON200.Secret)
+		require.NoError(t, err)
+
+		// the enrollment is not confirmed yet, login asks no code
+		res5 := tcl.Auth(t, AuthParams{
+			Username: userName,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res5.StatusCode())
+		require.NotNil(t, res5.JSON200.Token)
+
+		// codes of steps far from now are not accepted
+		step := totp.Step(time.Now())
+		res6 := tcl.ConfirmTwoFactor(t, TwoFactorCodeParams{
+			Auth: userToken,
+			Code: totp.Code(secret, step-10),
+		})
+		require.Equal(t, 400, res6.StatusCode())
+		require.Equal(t, ErrorCodeINVALIDOTP, res6.JSON400.Code)
 
-				res2 := tcl.Auth(t, AuthParams{
-					Username: mail,
-					Password: defPass,
-				})
-				require.Equal(t, 200, res2.StatusCode())
+		res7 := tcl.ConfirmTwoFactor(t, TwoFactorCodeParams{
+			Auth: userToken,
+			Code: totp.Code(secret, step),
+		})
+		require.Equal(t, 200, res7.StatusCode())
+		recoveryCodes := res7.JSON200.RecoveryCodes
+		require.Len(t, recoveryCodes, 10)
 
-			})
+		res8 := tcl.EnrollTwoFactor(t, userToken)
+		require.Equal(t, 409, res8.StatusCode())
+		require.Equal(t, ErrorCodeTWOFACTORALREADYENABLED, res8.JSON409.Code)
+
+		res9 := tcl.Auth(t, AuthParams{
+			Username: userName,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res9.StatusCode())
+		require.Nil(t, res9.JSON200.Token)
+		require.NotNil(t, res9.JSON200.Challenge)
+
+		// the code used for the confirmation can not be used again
+		res10 := tcl.LoginOTP(t, LoginOTPParams{
+			Challenge: *res9.JSON200.Challenge,
+			Code:      totp.Code(secret, step),
 		})
+		require.Equal(t, 401, res10.StatusCode())
+		require.Equal(t, ErrorCodeINVALIDOTP, res10.JSON401.Code)
 
-		t.Run("wrong pass", func(t *testing.T) {
-			t.Parallel()
+		// a failed code uses the challenge up
+		res11 := tcl.LoginOTP(t, LoginOTPParams{
+			Challenge: *res9.JSON200.Challenge,
+			Code:      totp.Code(secret, step+1),
+		})
+		require.Equal(t, 401, res11.StatusCode())
+		require.Equal(t, ErrorCodeINVALIDTOKEN, res11.JSON401.Code)
 
-			mail := NewEmail(t)
+		res12 := tcl.Auth(t, AuthParams{
+			Username: userName,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res12.StatusCode())
+		res13 := tcl.LoginOTP(t, LoginOTPParams{
+			Challenge: *res12.JSON200.Challenge,
+			Code:      totp.Code(secret, step+1),
+		})
+		require.Equal(t, 200, res13.StatusCode())
 
-			res := tcl.Auth(t, AuthParams{
-				Username: mail,
+		res14 := tcl.Info(t, InfoParams{JwtToken: *res13.JSON200.Token})
+		require.Equal(t, 200, res14.StatusCode())
+
+		// recovery codes are single-use and ignore case
+		for i, want := range []int{200, 401} {
+			res15 := tcl.Auth(t, AuthParams{
+				Username: userName,
 				Password: defPass,
 			})
-			require.Equal(t, 200, res.StatusCode())
-
-			res2 := tcl.Auth(t, AuthParams{
-				Username: mail,
-				Password: "someWrongPassword",
+			require.Equal(t, 200, res15.StatusCode())
+			res16 := tcl.LoginOTP(t, LoginOTPParams{
+				Challenge: *res15.JSON200.Challenge,
+				Code:      strings.ToUpper(recoveryCodes[0]),
 			})
-			require.Equal(t, 401, res2.StatusCode())
+			require.Equal(t, want, res16.StatusCode(), "attempt %d", i)
+		}
+
+		res17 := tcl.GetTwoFactor(t, userToken)
+		require.Equal(t, 200, res17.StatusCode())
+		assert.True(t, res17.JSON200.Enabled)
+		assert.Equal(t, int64(9), res17.JSON200.RecoveryCodesLeft)
+
+		res18 := tcl.DisableTwoFactor(t, TwoFactorCodeParams{
+			Auth: userToken,
+			Code: recoveryCodes[0],
+		})
+		require.Equal(t, 400, res18.StatusCode())
+		require.Equal(t, ErrorCodeINVALIDOTP, res18.JSON400.Code)
 
+		res19 := tcl.DisableTwoFactor(t, TwoFactorCodeParams{
+			Auth: userToken,
+			Code: recoveryCodes[1],
 		})
+		require.Equal(t, 204, res19.StatusCode())
 
+		res20 := tcl.Auth(t, AuthParams{
+			Username: userName,
+			Password: defPass,
+		})
+		require.Equal(t, 200, res20.StatusCode())
+		require.NotNil(t, res20.JSON200.Token)
+
+		// admins can not turn it off
+		res21 := tcl.GetTwoFactor(t, adminToken)
+		require.Equal(t, 200, res21.StatusCode())
+		assert.True(t, res21.JSON200.Enabled)
+		assert.True(t, res21.JSON200.Required)
+
+		res22 := tcl.DisableTwoFactor(t, TwoFactorCodeParams{
+			Auth: adminToken,
+			Code: "123456",
+		})
+		require.Equal(t, 403, res22.StatusCode())
+		require.Equal(t, ErrorCodeTWOFACTORREQUIRED, res22.JSON403.Code)
 	})
 
-	t.Run("buy", func(t *testing.T) {
+	t.Run("auction", func(t *testing.T) {
 		t.Parallel()
 
-		t.Run("green", func(t *testing.T) {
-			t.Parallel()
-
+		var tokens []string
+		var names []string
+		for range 2 {
+			name := NewEmail(t, "bidder")
 			res := tcl.Auth(t, AuthParams{
+				Username: name,
 				Password: defPass,
-				Username: NewEmail(t),
 			})
 			require.Equal(t, 200, res.StatusCode())
+			tokens = append(tokens, *res.JSON200.Token)
+			names = append(names, name)
+		}
 
-			res2 := tcl.BuyMerch(t, BuyMerchParams{
-				Auth:      *res.JSON200.Token,
-				MerchItem: "t-shirt",
+		minStep := int64(10)
+		noExtension := int64(0)
+		res := tcl.CreateAuction(t, CreateAuctionParams{
+			Auth: adminToken,
+			Auction: CreateAuctionRequest{
+				Item:             "powerbank",
+				StartPrice:       100,
+				MinStep:          &minStep,
+				ExtensionSeconds: &noExtension,
+				StartsAt:         time.Now().Add(-time.Minute),
+				EndsAt:           time.Now().Add(3 * time.Second),
+			},
+		})
+		require.Equal(t, 200, res.StatusCode())
+		auctionID := res.JSON200.Id
+
+		res2 := tcl.PlaceBid(t, PlaceBidParams{Auth: tokens[0], Id: auctionID, Amount: 100})
+		require.Equal(t, 200, res2.StatusCode())
+
+		// less than leading bid + min step
+		res3 := tcl.PlaceBid(t, PlaceBidParams{Auth: tokens[1], Id: auctionID, Amount: 105})
+		require.Equal(t, 400, res3.StatusCode())
+
+		res4 := tcl.PlaceBid(t, PlaceBidParams{Auth: tokens[1], Id: auctionID, Amount: 150})
+		require.Equal(t, 200, res4.StatusCode())
+		assert.Equal(t, names[1], *res4.JSON200.Leader)
+
+		// outbid coins are released at once
+		u, err := tdb.Queries.GetUserByName(context.TODO(), names[0])
+		require.NoError(t, err)
+		require.EqualValues(t, 1000, u.Coins)
+
+		res5 := tcl.PlaceBid(t, PlaceBidParams{Auth: tokens[0], Id: auctionID, Amount: 2000})
+		require.Equal(t, 400, res5.StatusCode())
+
+		require.Eventually(t, func() bool {
+			res := tcl.GetAuction(t, AuctionParams{Auth: tokens[0], Id: auctionID})
+			return res.StatusCode() == 200 && res.JSON200.Status == AuctionStatusClosed
+		}, 15*time.Second, 250*time.Millisecond)
+
+		res6 := tcl.GetAuction(t, AuctionParams{Auth: tokens[0], Id: auctionID})
+		require.Equal(t, 200, res6.StatusCode())
+		assert.Equal(t, names[1], *res6.JSON200.Leader)
+		require.Len(t, *res6.JSON200.Bids, 2)
+		assert.Equal(t, AuctionBidStatusWon, (*res6.JSON200.Bids)[0].Status)
+		assert.Equal(t, AuctionBidStatusReleased, (*res6.JSON200.Bids)[1].Status)
+
+		u2, err := tdb.Queries.GetUserByName(context.TODO(), names[1])
+		require.NoError(t, err)
+		require.EqualValues(t, 850, u2.Coins)
+
+		res7 := tcl.ListMyOrders(t, ListMyOrdersParams{Auth: tokens[1]})
+		require.Equal(t, 200, res7.StatusCode())
+		require.Len(t, *res7.JSON200, 1)
+		assert.Equal(t, "powerbank", (*res7.JSON200)[0].Item)
+		assert.EqualValues(t, 150, (*res7.JSON200)[0].Price)
+
+		res8 := tcl.PlaceBid(t, PlaceBidParams{Auth: tokens[0], Id: auctionID, Amount: 200})
+		require.Equal(t, 409, res8.StatusCode())
+
+		t.Run("anti-sniping", func(t *testing.T) {
+			extension := int64(60)
+			res := tcl.CreateAuction(t, CreateAuctionParams{
+				Auth: adminToken,
+				Auction: CreateAuctionRequest{
+					Item:             "powerbank",
+					StartPrice:       10,
+					ExtensionSeconds: &extension,
+					StartsAt:         time.Now().Add(-time.Minute),
+					EndsAt:           time.Now().Add(10 * time.Second),
+				},
 			})
+			require.Equal(t, 200, res.StatusCode())
+
+			res2 := tcl.PlaceBid(t, PlaceBidParams{Auth: tokens[0], Id: res.JSON200.Id, Amount: 10})
 			require.Equal(t, 200, res2.StatusCode())
+			assert.True(t, res2.JSON200.EndsAt.After(time.Now().Add(50*time.Second)))
 		})
 
-		t.Run("empty jwt", func(t *testing.T) {
-			t.Parallel()
+		t.Run("stock", func(t *testing.T) {
+			_, err := tdb.PgxPool.Exec(context.TODO(), `
+INSERT INTO merch_variants (merch_item, variant, attributes, stock)
+VALUES ('powerbank', 'auction-e2e', '{"color": "gold"}', 1);
+`)
+			require.NoError(t, err)
 
-			res := tcl.Auth(t, AuthParams{
-				Password: defPass,
-				Username: NewEmail(t),
-			})
+			stock := func() int64 {
+				var stock int64
+				err := tdb.PgxPool.QueryRow(context.TODO(), `
+SELECT stock FROM merch_variants WHERE merch_item = 'powerbank' AND variant = 'auction-e2e'`).Scan(&stock)
+				require.NoError(t, err)
+				return stock
+			}
+			closed := func(auctionID int64) bool {
+				res := tcl.GetAuction(t, AuctionParams{Auth: tokens[0], Id: auctionID})
+				return res.StatusCode() == 200 && res.JSON200.Status == AuctionStatusClosed
+			}
+
+			variant := "auction-e2e"
+			newAuction := func(endsIn time.Duration) *PostApiAdminAuctionsResponse {
+				return tcl.CreateAuction(t, CreateAuctionParams{
+					Auth: adminToken,
+					Auction: CreateAuctionRequest{
+						Item:             "powerbank",
+						Variant:          &variant,
+						StartPrice:       10,
+						ExtensionSeconds: &noExtension,
+						StartsAt:         time.Now().Add(-time.Minute),
+						EndsAt:           time.Now().Add(endsIn),
+					},
+				})
+			}
+
+			// the lot is taken from stock when the auction is created
+			res := newAuction(2 * time.Second)
 			require.Equal(t, 200, res.StatusCode())
+			require.EqualValues(t, 0, stock())
 
-			res2 := tcl.BuyMerch(t, BuyMerchParams{
-				Auth:      "",
-				MerchItem: "t-shirt",
-			})
-			require.Equal(t, 401, res2.StatusCode())
-		})
+			res2 := newAuction(time.Hour)
+			require.Equal(t, 400, res2.StatusCode())
+			require.Equal(t, ErrorCodeOUTOFSTOCK, res2.JSON400.Code)
 
-		t.Run("not enough coins", func(t *testing.T) {
-			t.Parallel()
+			// nobody bids, the lot goes back
+			require.Eventually(t, func() bool { return closed(res.JSON200.Id) }, 15*time.Second, 250*time.Millisecond)
+			require.EqualValues(t, 1, stock())
 
-			userName := NewEmail(t)
-			var userToken string
+			res3 := newAuction(2 * time.Second)
+			require.Equal(t, 200, res3.StatusCode())
+			res4 := tcl.PlaceBid(t, PlaceBidParams{Auth: tokens[1], Id: res3.JSON200.Id, Amount: 10})
+			require.Equal(t, 200, res4.StatusCode())
+
+			require.Eventually(t, func() bool { return closed(res3.JSON200.Id) }, 15*time.Second, 250*time.Millisecond)
+			require.EqualValues(t, 0, stock())
+		})
+	})
+
+	t.Run("raffle", func(t *testing.T) {
+		t.Parallel()
 
+		var tokens []string
+		for range 3 {
 			res := tcl.Auth(t, AuthParams{
+				Username: NewEmail(t, "player"),
 				Password: defPass,
-				Username: userName,
 			})
 			require.Equal(t, 200, res.StatusCode())
-			userToken = *res.JSON200.Token
+			tokens = append(tokens, *res.JSON200.Token)
+		}
 
-			res2 := tcl.BuyMerch(t, BuyMerchParams{
-				Auth:      userToken,
-				MerchItem: "pink-hoody",
-			})
-			require.Equal(t, 200, res2.StatusCode())
+		winnersCount := int64(2)
+		maxTickets := int64(3)
+		salesEndAt := time.Now().Add(3 * time.Second)
+		res := tcl.CreateRaffle(t, CreateRaffleParams{
+			Auth: adminToken,
+			Raffle: CreateRaffleRequest{
+				Item:              "book",
+				TicketPrice:       10,
+				WinnersCount:      &winnersCount,
+				MaxTicketsPerUser: &maxTickets,
+				SalesEndAt:        salesEndAt,
+			},
+		})
+		require.Equal(t, 200, res.StatusCode())
+		raffleID := res.JSON200.Id
+		seedHash := res.JSON200.SeedHash
+		require.Nil(t, res.JSON200.Seed)
+
+		two := int64(2)
+		res2 := tcl.BuyRaffleTickets(t, BuyRaffleTicketsParams{Auth: tokens[0], Id: raffleID, Quantity: &two})
+		require.Equal(t, 200, res2.StatusCode())
+		require.Len(t, *res2.JSON200, 2)
+
+		// limit is 3 tickets per user
+		res3 := tcl.BuyRaffleTickets(t, BuyRaffleTicketsParams{Auth: tokens[0], Id: raffleID, Quantity: &two})
+		require.Equal(t, 400, res3.StatusCode())
+
+		for _, token := range tokens[1:] {
+			res := tcl.BuyRaffleTickets(t, BuyRaffleTicketsParams{Auth: token, Id: raffleID})
+			require.Equal(t, 200, res.StatusCode())
+		}
 
-			res3 := tcl.BuyMerch(t, BuyMerchParams{
-				Auth:      userToken,
-				MerchItem: "pink-hoody",
-			})
-			require.Equal(t, 200, res3.StatusCode())
+		res4 := tcl.DrawRaffle(t, RaffleParams{Auth: adminToken, Id: raffleID})
+		require.Equal(t, 409, res4.StatusCode())
+
+		time.Sleep(time.Until(salesEndAt))
+
+		res5 := tcl.BuyRaffleTickets(t, BuyRaffleTicketsParams{Auth: tokens[1], Id: raffleID})
+		require.Equal(t, 409, res5.StatusCode())
+
+		res6 := tcl.DrawRaffle(t, RaffleParams{Auth: adminToken, Id: raffleID})
+		require.Equal(t, 200, res6.StatusCode())
+		require.Len(t, *res6.JSON200.Winners, 2)
+		assert.NotEqual(t, (*res6.JSON200.Winners)[0].User, (*res6.JSON200.Winners)[1].User)
+
+		res7 := tcl.DrawRaffle(t, RaffleParams{Auth: adminToken, Id: raffleID})
+		require.Equal(t, 409, res7.StatusCode())
+
+		// anybody can check the draw with the revealed seed and the public tickets
+		res8 := tcl.GetRaffle(t, RaffleParams{Auth: tokens[2], Id: raffleID})
+		require.Equal(t, 200, res8.StatusCode())
+		require.NotNil(t, res8.JSON200.Seed)
+		seed := *res8.JSON200.Seed
+		hash := sha256.Sum256([]byte(seed))
+		require.Equal(t, seedHash, hex.EncodeToString(hash[:]))
+		assert.EqualValues(t, 4, *res8.JSON200.TicketsSold)
+
+		res9 := tcl.ListRaffleTickets(t, RaffleParams{Auth: tokens[2], Id: raffleID})
+		require.Equal(t, 200, res9.StatusCode())
+		remaining := *res9.JSON200
+		require.Len(t, remaining, 4)
+
+		var expected []int64
+		for k := 0; k < int(winnersCount) && len(remaining) > 0; k++ {
+			hash := sha256.Sum256([]byte(seed + ":" + strconv.Itoa(k)))
+			winner := remaining[binary.BigEndian.Uint64(hash[:8])%uint64(len(remaining))]
+			expected = append(expected, winner.Id)
+
+			var rest []RaffleTicket
+			for _, ticket := range remaining {
+				if ticket.User != winner.User {
+					rest = append(rest, ticket)
+				}
+			}
+			remaining = rest
+		}
+		var actual []int64
+		for _, ticket := range *res8.JSON200.Winners {
+			actual = append(actual, ticket.Id)
+		}
+		assert.ElementsMatch(t, expected, actual)
 
-			res4 := tcl.BuyMerch(t, BuyMerchParams{
-				Auth:      userToken,
-				MerchItem: "pink-hoody",
-			})
-			require.Equal(t, 400, res4.StatusCode())
+		t.Run("stock", func(t *testing.T) {
+			_, err := tdb.PgxPool.Exec(context.TODO(), `
+INSERT INTO merch_variants (merch_item, variant, attributes, stock)
+VALUES ('book', 'raffle-e2e', '{"cover": "hard"}', 3);
+`)
+			require.NoError(t, err)
 
-		})
+			stock := func() int64 {
+				var stock int64
+				err := tdb.PgxPool.QueryRow(context.TODO(), `
+SELECT stock FROM merch_variants WHERE merch_item = 'book' AND variant = 'raffle-e2e'`).Scan(&stock)
+				require.NoError(t, err)
+				return stock
+			}
 
-		t.Run("unavailable merch item", func(t *testing.T) {
-			t.Parallel()
+			variant := "raffle-e2e"
+			salesEndAt := time.Now().Add(2 * time.Second)
+			newRaffle := func() *PostApiAdminRafflesResponse {
+				return tcl.CreateRaffle(t, CreateRaffleParams{
+					Auth: adminToken,
+					Raffle: CreateRaffleRequest{
+						Item:         "book",
+						Variant:      &variant,
+						TicketPrice:  10,
+						WinnersCount: &winnersCount,
+						SalesEndAt:   salesEndAt,
+					},
+				})
+			}
 
-			res := tcl.Auth(t, AuthParams{
-				Password: defPass,
-				Username: NewEmail(t),
-			})
+			// the prizes are taken from stock when the raffle is created
+			res := newRaffle()
 			require.Equal(t, 200, res.StatusCode())
+			require.EqualValues(t, 1, stock())
 
-			res2 := tcl.BuyMerch(t, BuyMerchParams{
-				Auth:      *res.JSON200.Token,
-				MerchItem: "some-unavailable-merch-item",
-			})
+			res2 := newRaffle()
 			require.Equal(t, 400, res2.StatusCode())
+			require.Equal(t, ErrorCodeOUTOFSTOCK, res2.JSON400.Code)
+
+			res3 := tcl.BuyRaffleTickets(t, BuyRaffleTicketsParams{Auth: tokens[0], Id: res.JSON200.Id})
+			require.Equal(t, 200, res3.StatusCode())
+
+			time.Sleep(time.Until(salesEndAt))
+
+			// one ticket wins one prize, the other prize goes back
+			res4 := tcl.DrawRaffle(t, RaffleParams{Auth: adminToken, Id: res.JSON200.Id})
+			require.Equal(t, 200, res4.StatusCode())
+			require.Len(t, *res4.JSON200.Winners, 1)
+			require.EqualValues(t, 2, stock())
 		})
 	})
 
-	t.Run("send coins", func(t *testing.T) { // TODO try send to themself
+	t.Run("market", func(t *testing.T) {
 		t.Parallel()
 
 		t.Run("green", func(t *testing.T) {
 			t.Parallel()
 
-			user1 := NewEmail(t, "one")
-			user2 := NewEmail(t, "two")
-
+			sellerName := NewEmail(t, "seller")
 			res := tcl.Auth(t, AuthParams{
-				Username: user1,
+				Username: sellerName,
 				Password: defPass,
 			})
 			require.Equal(t, 200, res.StatusCode())
+			sellerToken := *res.JSON200.Token
 
+			buyerName := NewEmail(t, "buyer")
 			res2 := tcl.Auth(t, AuthParams{
-				Username: user2,
+				Username: buyerName,
 				Password: defPass,
 			})
 			require.Equal(t, 200, res2.StatusCode())
+			buyerToken := *res2.JSON200.Token
 
-			res3 := tcl.SendCoins(t, SendCoinParams{
-				Auth:   *res.JSON200.Token,
-				Amount: 10,
-				ToUser: user2,
+			for range 2 {
+				res3 := tcl.BuyMerch(t, BuyMerchParams{
+					Auth:      sellerToken,
+					MerchItem: "socks",
+				})
+				require.Equal(t, 200, res3.StatusCode())
+			}
+
+			// only 2 socks owned
+			res4 := tcl.CreateListing(t, CreateListingParams{
+				Auth: sellerToken,
+				Listing: CreateListingRequest{
+					Item:     "socks",
+					Quantity: 3,
+					Price:    10,
+				},
 			})
-			require.Equal(t, 200, res3.StatusCode())
+			require.Equal(t, 400, res4.StatusCode())
 
-			u1, err := tdb.Queries.GetUserByName(context.TODO(), user1)
-			require.NoError(t, err)
-			require.Equal(t, models.User{
-				Name:     user1,
-				Password: defPass,
-				Coins:    990,
-			}, u1)
+			res5 := tcl.CreateListing(t, CreateListingParams{
+				Auth: sellerToken,
+				Listing: CreateListingRequest{
+					Item:     "socks",
+					Quantity: 2,
+					Price:    10,
+				},
+			})
+			require.Equal(t, 200, res5.StatusCode())
+			listingId := res5.JSON200.Id
 
-			u2, err := tdb.Queries.GetUserByName(context.TODO(), user2)
-			require.NoError(t, err)
-			require.Equal(t, models.User{
-				Name:     user2,
-				Password: defPass,
-				Coins:    1010,
-			}, u2)
-		})
+			res6 := tcl.BuyListing(t, BuyListingParams{
+				Auth:      sellerToken,
+				ListingId: listingId,
+			})
+			require.Equal(t, 400, res6.StatusCode())
+
+			res7 := tcl.BuyListing(t, BuyListingParams{
+				Auth:      buyerToken,
+				ListingId: listingId,
+			})
+			require.Equal(t, 200, res7.StatusCode())
+
+			res8 := tcl.CancelListing(t, CancelListingParams{
+				Auth:      sellerToken,
+				ListingId: listingId,
+			})
+			require.Equal(t, 200, res8.StatusCode())
+			require.Equal(t, ListingStatusCancelled, res8.JSON200.Status)
 
+			res9 := tcl.BuyListing(t, BuyListingParams{
+				Auth:      buyerToken,
+				ListingId: listingId,
+			})
+			require.Equal(t, 409, res9.StatusCode())
+
+			// 1000 - 2 * 10 + 10 - 10% fee
+			res10 := tcl.Info(t, InfoParams{JwtToken: sellerToken})
+			require.Equal(t, 200, res10.StatusCode())
+			require.JSONEq(t, `{
+				"coins": 989,
+				"inventory": [{ "type": "socks", "quantity": 1 }],
+				"coinHistory": { "received": [], "sent": [] },
+				"giftHistory": { "received": [], "sent": [] }
+			}`, string(res10.Body))
+
+			res11 := tcl.Info(t, InfoParams{JwtToken: buyerToken})
+			require.Equal(t, 200, res11.StatusCode())
+			require.JSONEq(t, `{
+				"coins": 990,
+				"inventory": [{ "type": "socks", "quantity": 1 }],
+				"coinHistory": { "received": [], "sent": [] },
+				"giftHistory": { "received": [], "sent": [] }
+			}`, string(res11.Body))
+		})
 	})
 
 	t.Run("info", func(t *testing.T) {
@@ -411,7 +3433,8 @@ func TestE2E(t *testing.T) {
 						{ "toUser": "%[1]s",	"amount": 100 },
 						{ "toUser": "%[1]s",	"amount": 300 }
 					]
-				}
+				},
+				"giftHistory": { "received": [], "sent": [] }
 			}`, user2), string(res10.Body))
 		})
 
@@ -463,7 +3486,7 @@ func TestE2E(t *testing.T) {
 
 }
 
-func RunDockerHttpServ(t *testing.T, dbConnStr string) string {
+func RunDockerHttpServ(t *testing.T, dbConnStr string, smtpAddr string) (httpUrl string, grpcAddr string) {
 	httpSrvCtrName := fmt.Sprintf("merchStore_%s_HttpServ", NameTestInSnakeCase(t))
 
 	pgConfig, err := pgconn.ParseConfig(dbConnStr)
@@ -491,11 +3514,34 @@ func RunDockerHttpServ(t *testing.T, dbConnStr string) string {
 			"HTTP_SERVICE_HOST": "0.0.0.0",
 			"HTTP_SERVICE_PORT": "8080",
 
+			"GRPC_SERVICE_HOST": "0.0.0.0",
+			"GRPC_SERVICE_PORT": "9090",
+
 			"LOG_LEVEL": logLevel,
+
+			"ADMIN_USERS": adminUser,
+
+			"MARKET_FEE_PERCENT": marketFeePercent,
+			"HOUSE_ACCOUNT":      houseAccount,
+
+			"AUCTION_CLOSE_INTERVAL": auctionCloseInterval,
+
+			"WEBHOOK_DISPATCH_INTERVAL": webhookDispatchInterval,
+			"WEBHOOK_RETRY_DELAY":       webhookRetryDelay,
+
+			"EVENT_BUS":                  "memory",
+			"EVENT_BUS_PUBLISH_INTERVAL": eventBusPublishInterval,
+
+			"SMTP_ADDR":           smtpAddr,
+			"SMTP_FROM":           smtpFrom,
+			"EMAIL_SEND_INTERVAL": emailSendInterval,
+			"EMAIL_RETRY_DELAY":   emailRetryDelay,
+
+			"VALIDATE_RESPONSES": validateResponses,
 		},
 
 		WaitingFor:   wait.ForLog("started listen"),
-		ExposedPorts: []string{"8080/tcp"},
+		ExposedPorts: []string{"8080/tcp", "9090/tcp"},
 	}
 
 	if pgConfig.Host == "localhost" {
@@ -512,10 +3558,13 @@ func RunDockerHttpServ(t *testing.T, dbConnStr string) string {
 	p, err := container.MappedPort(context.TODO(), "8080/tcp")
 	require.NoError(t, err)
 
-	return fmt.Sprintf("http://localhost:%s", p.Port())
+	grpcPort, err := container.MappedPort(context.TODO(), "9090/tcp")
+	require.NoError(t, err)
+
+	return fmt.Sprintf("http://localhost:%s", p.Port()), net.JoinHostPort("localhost", grpcPort.Port())
 }
 
-func RunEmbedHttpServ(t *testing.T, connStr string) string {
+func RunEmbedHttpServ(t *testing.T, connStr string, smtpAddr string) (httpUrl string, grpcAddr string) {
 
 	logger := InitLogger(t)
 
@@ -531,6 +3580,12 @@ func RunEmbedHttpServ(t *testing.T, connStr string) string {
 		return nil
 	}
 
+	merchShopGrpcServiceOnListenFunc = func(addr net.Addr) {
+		grpcAddr = addr.String()
+	}
+
+	merchShopEventBus = eventbus.NewMemory()
+
 	cErr := make(chan error, 1)
 
 	go func() {
@@ -540,10 +3595,32 @@ func RunEmbedHttpServ(t *testing.T, connStr string) string {
 			HttpServiceHost: "localhost",
 			HttpServicePort: "0", // choose random free port
 
+			GrpcServiceHost: "localhost",
+			GrpcServicePort: "0",
+
 			JwtPrivateKey: jwtPrivateKey,
 			JwtPublicKey:  jwtPublicKey,
 
 			LogLevel: logLevel,
+
+			AdminUsers: adminUser,
+
+			MarketFeePercent: marketFeePercent,
+			HouseAccount:     houseAccount,
+
+			AuctionCloseInterval: auctionCloseInterval,
+
+			WebhookDispatchInterval: webhookDispatchInterval,
+			WebhookRetryDelay:       webhookRetryDelay,
+
+			EventBusPublishInterval: eventBusPublishInterval,
+
+			SmtpAddr:          smtpAddr,
+			SmtpFrom:          smtpFrom,
+			EmailSendInterval: emailSendInterval,
+			EmailRetryDelay:   emailRetryDelay,
+
+			ValidateResponses: validateResponses,
 		})
 	}()
 	select {
@@ -554,7 +3631,7 @@ func RunEmbedHttpServ(t *testing.T, connStr string) string {
 	case <-fiberStartedListen:
 		t.Log("fiber server started listen")
 	}
-	return fmt.Sprintf("http://localhost:%s", runningPort)
+	return fmt.Sprintf("http://localhost:%s", runningPort), grpcAddr
 }
 
 func handleFknPanicTestContainers(t *testing.T, some any) {
@@ -621,6 +3698,102 @@ func RunDockerPostgres(t *testing.T) (localhostConnStr, dockerBridgeConnStr stri
 	return localhostConnStr, dockerBridgeConnStr
 }
 
+// RunDockerMailpit starts a smtp server catching all emails, they are read by MailCatcher from apiUrl
+func RunDockerMailpit(t *testing.T) (localhostSmtpAddr, dockerBridgeSmtpAddr, apiUrl string) {
+	ctx := t.Context()
+
+	mailCtrName := fmt.Sprintf("merchStore_%s_Mailpit", NameTestInSnakeCase(t))
+
+	defer func() {
+		handleFknPanicTestContainers(t, recover())
+	}()
+	t.Logf("starting %s...", mailCtrName)
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Name:         mailCtrName,
+			Image:        "axllent/mailpit:v1.21",
+			ExposedPorts: []string{"1025/tcp", "8025/tcp"},
+			WaitingFor: wait.ForHTTP("/api/v1/info").
+				WithPort("8025/tcp").
+				WithStartupTimeout(10 * time.Second),
+		},
+		Started: true,
+	})
+	require.NoError(t, err)
+	t.Logf("started %s", mailCtrName)
+
+	smtpPort, err := container.MappedPort(ctx, "1025/tcp")
+	require.NoError(t, err)
+	apiPort, err := container.MappedPort(ctx, "8025/tcp")
+	require.NoError(t, err)
+
+	ctrBridgeIP, err := container.ContainerIP(ctx) // the same assumption as in RunDockerPostgres
+	require.NoError(t, err)
+
+	localhostSmtpAddr = net.JoinHostPort("localhost", smtpPort.Port())
+	dockerBridgeSmtpAddr = net.JoinHostPort(ctrBridgeIP, "1025")
+	apiUrl = fmt.Sprintf("http://localhost:%s", apiPort.Port())
+
+	t.Logf("mailpit smtp available on: \n - %s\n - %s (default docker bridge network)",
+		localhostSmtpAddr, dockerBridgeSmtpAddr)
+
+	return localhostSmtpAddr, dockerBridgeSmtpAddr, apiUrl
+}
+
+// MailCatcher reads emails caught by mailpit, Url is empty if there is no mailpit
+type MailCatcher struct {
+	Url string
+}
+
+type CaughtEmail struct {
+	ID        string
+	MessageID string
+	From      struct{ Address string }
+	Subject   string
+	Text      string
+	HTML      string
+}
+
+// Emails returns emails to the address with the subject prefix, the newest first.
+// It does not fail the test, so it may be polled
+func (m MailCatcher) Emails(to string, subjectPrefix string) ([]CaughtEmail, error) {
+	var found struct {
+		Messages []struct {
+			ID      string
+			Subject string
+		}
+	}
+	query := url.Values{"query": {fmt.Sprintf("to:%q", to)}}
+	if err := m.get("/api/v1/search?"+query.Encode(), &found); err != nil {
+		return nil, err
+	}
+
+	emails := make([]CaughtEmail, 0, len(found.Messages))
+	for _, message := range found.Messages {
+		if !strings.HasPrefix(message.Subject, subjectPrefix) {
+			continue
+		}
+		var email CaughtEmail
+		if err := m.get("/api/v1/message/"+message.ID, &email); err != nil {
+			return nil, err
+		}
+		emails = append(emails, email)
+	}
+	return emails, nil
+}
+
+func (m MailCatcher) get(path string, res any) error {
+	resp, err := http.Get(m.Url + path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("mailpit responded %d on %s", resp.StatusCode, path)
+	}
+	return json.NewDecoder(resp.Body).Decode(res)
+}
+
 func PostgresPrepare(t *testing.T, pgConnString string) {
 
 	mg, err := migrate.New(
@@ -685,6 +3858,35 @@ func NameTestInSnakeCase(t *testing.T) string {
 	return strings.ToLower(strings.ReplaceAll(t.Name(), "/", "_"))
 }
 
+// EnableTwoFactor enrolls the user of auth and returns a token issued after the second factor
+func EnableTwoFactor(t *testing.T, tcl HttpClient, auth string, p AuthParams) string {
+	t.Helper()
+
+	enrolled := tcl.EnrollTwoFactor(t, auth)
+	require.Equal(t, 200, enrolled.StatusCode())
+	secret, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(enrolled.JSON200.Secret)
+	require.NoError(t, err)
+
+	// every code is single-use, the login takes the code of the next step
+	step := totp.Step(time.Now())
+	confirmed := tcl.ConfirmTwoFactor(t, TwoFactorCodeParams{
+		Auth: auth,
+		Code: totp.Code(secret, step),
+	})
+	require.Equal(t, 200, confirmed.StatusCode())
+
+	res := tcl.Auth(t, p)
+	require.Equal(t, 200, res.StatusCode())
+	require.NotNil(t, res.JSON200.Challenge)
+
+	res2 := tcl.LoginOTP(t, LoginOTPParams{
+		Challenge: *res.JSON200.Challenge,
+		Code:      totp.Code(secret, step+1),
+	})
+	require.Equal(t, 200, res2.StatusCode())
+	return *res2.JSON200.Token
+}
+
 func NewEmail(t *testing.T, prefixes ...string) string {
 
 	userName := NameTestInSnakeCase(t)
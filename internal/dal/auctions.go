@@ -0,0 +1,187 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+const (
+	AuctionStatusOpen   = "open"
+	AuctionStatusClosed = "closed"
+)
+
+var ErrInvalidAuction = errors.New("invalid auction parameters")
+
+func (r Dal) CreateAuction(ctx context.Context, createArgs models.CreateAuctionParams) (*models.Auction, error) {
+	auction, err := r.Queries.CreateAuction(ctx, createArgs)
+	if err == nil {
+		return &auction, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "auctions_fk_merch_item":
+			return nil, ErrInvalidMerchItem
+		case "auctions_start_price_positive", "auctions_min_step_positive",
+			"auctions_extension_non_negative", "auctions_ends_after_start":
+			return nil, fmt.Errorf("%w: %s", ErrInvalidAuction, pgErr.ConstraintName)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+var ErrAuctionNotFound = errors.New("auction not found")
+
+func (r Dal) GetAuction(ctx context.Context, auctionID int64) (*models.Auction, error) {
+	auction, err := r.Queries.GetAuction(ctx, auctionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAuctionNotFound
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &auction, nil
+}
+
+// GetAuctionForUpdate locks the auction row until the end of the transaction,
+// bids and the closer are serialized by this lock
+func (r Dal) GetAuctionForUpdate(ctx context.Context, auctionID int64) (*models.Auction, error) {
+	auction, err := r.Queries.GetAuctionForUpdate(ctx, auctionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAuctionNotFound
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &auction, nil
+}
+
+func (r Dal) ListOpenAuctions(ctx context.Context) ([]models.Auction, error) {
+	auctions, err := r.Queries.ListOpenAuctions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(auctions), nil
+}
+
+// ListAuctionBids returns bids of the auction, newest first
+func (r Dal) ListAuctionBids(ctx context.Context, auctionID int64) ([]models.AuctionBid, error) {
+	bids, err := r.Queries.ListAuctionBids(ctx, auctionID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(bids), nil
+}
+
+func (r Dal) AddAuctionBid(ctx context.Context, createArgs models.CreateAuctionBidParams) (*models.AuctionBid, error) {
+	bid, err := r.Queries.CreateAuctionBid(ctx, createArgs)
+	if err == nil {
+		return &bid, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "auction_bids_fk_bidder":
+			return nil, ErrInvalidUser
+		case "auction_bids_fk_auction_id":
+			return nil, fmt.Errorf("%w: auction not found: this constraint must check upper layer", ErrInternal)
+		case "auction_bids_one_held":
+			return nil, fmt.Errorf("%w: previous bid is not released: this constraint must check upper layer", ErrInternal)
+		case "auction_bids_amount_positive":
+			return nil, fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+// ReleaseAuctionBids marks held bids of the auction released and returns them, coins must be returned by caller
+func (r Dal) ReleaseAuctionBids(ctx context.Context, auctionID int64) ([]models.AuctionBid, error) {
+	bids, err := r.Queries.ReleaseAuctionBids(ctx, auctionID)
+	if err == nil {
+		return NonNil(bids), nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "auction_bids_status_valid":
+			return nil, fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+func (r Dal) WinAuctionBid(ctx context.Context, auctionID int64) error {
+	err := r.Queries.WinAuctionBid(ctx, auctionID)
+	if err == nil {
+		return nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "auction_bids_status_valid":
+			return fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+		}
+	}
+	return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+var ErrAuctionClosed = errors.New("auction is closed")
+
+// UpdateAuctionLead moves the end of the auction by the extension after now() of the database,
+// the closer compares ends_at with it too. It fails with ErrAuctionClosed if the auction is over
+func (r Dal) UpdateAuctionLead(ctx context.Context, updateArgs models.UpdateAuctionLeadParams) (*models.Auction, error) {
+	auction, err := r.Queries.UpdateAuctionLead(ctx, updateArgs)
+	if err == nil {
+		return &auction, nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrAuctionClosed
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "auctions_fk_leader":
+			return nil, ErrInvalidUser
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+// ListDueAuctionsForUpdate locks open auctions which are over, auctions locked by
+// another transaction are skipped, so several closers do not wait for each other
+func (r Dal) ListDueAuctionsForUpdate(ctx context.Context, maxAuctions int32) ([]models.Auction, error) {
+	auctions, err := r.Queries.ListDueAuctionsForUpdate(ctx, maxAuctions)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(auctions), nil
+}
+
+func (r Dal) CloseAuction(ctx context.Context, auctionID int64) (*models.Auction, error) {
+	auction, err := r.Queries.CloseAuction(ctx, auctionID)
+	if err == nil {
+		return &auction, nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrAuctionClosed
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "auctions_status_valid":
+			return nil, fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
@@ -0,0 +1,118 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+const (
+	AuthTokenVerifyEmail   = "verify_email"
+	AuthTokenResetPassword = "reset_password"
+	// AuthTokenLoginChallenge is not sent by email, it links the password and the code steps of a login
+	AuthTokenLoginChallenge = "login_challenge"
+)
+
+// CountAuthTokensSince counts tokens of the purpose created for the user after since, used or not
+func (r Dal) CountAuthTokensSince(ctx context.Context, userName string, purpose string, since time.Time) (int64, error) {
+	count, err := r.Queries.CountAuthTokensSince(ctx, models.CountAuthTokensSinceParams{
+		UserName: userName,
+		Purpose:  purpose,
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return count, nil
+}
+
+func (r Dal) AddAuthToken(ctx context.Context, addArgs models.AddAuthTokenParams) error {
+	err := r.Queries.AddAuthToken(ctx, addArgs)
+	if err == nil {
+		return nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "auth_tokens_fk_user_name":
+			return ErrInvalidUser
+		case "auth_tokens_purpose_valid":
+			return fmt.Errorf("%w: invalid auth token purpose %q: this constraint must check upper layer", ErrInternal, addArgs.Purpose)
+		case "auth_tokens_token_hash_unique":
+			// tokens are 32 random bytes, a collision means the random source is broken
+			return fmt.Errorf("%w: %s: token is not unique", ErrInternal, pgErr.ConstraintName)
+		}
+	}
+	return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+var ErrInvalidAuthToken = errors.New("token is invalid, expired or already used")
+
+// UseAuthToken marks the token used and returns its user, a token can be used once
+func (r Dal) UseAuthToken(ctx context.Context, tokenHash []byte, purpose string) (string, error) {
+	userName, err := r.Queries.UseAuthToken(ctx, models.UseAuthTokenParams{
+		TokenHash: tokenHash,
+		Purpose:   purpose,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrInvalidAuthToken
+		}
+		return "", fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return userName, nil
+}
+
+// RevokeAuthTokens makes unused tokens of the purpose of the user invalid
+func (r Dal) RevokeAuthTokens(ctx context.Context, userName string, purpose string) error {
+	err := r.Queries.RevokeAuthTokens(ctx, models.RevokeAuthTokensParams{
+		UserName: userName,
+		Purpose:  purpose,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return nil
+}
+
+func (r Dal) MarkEmailVerified(ctx context.Context, userName string) error {
+	err := r.Queries.MarkEmailVerified(ctx, userName)
+	if err == nil {
+		return nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == "email_verifications_fk_user_name" {
+		return ErrInvalidUser
+	}
+	return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+func (r Dal) IsEmailVerified(ctx context.Context, userName string) (bool, error) {
+	verified, err := r.Queries.IsEmailVerified(ctx, userName)
+	if err != nil {
+		return false, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return verified, nil
+}
+
+func (r Dal) UpdateUserPassword(ctx context.Context, userName string, password string) error {
+	updated, err := r.Queries.UpdateUserPassword(ctx, models.UpdateUserPasswordParams{
+		Password: password,
+		Name:     userName,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	if updated == 0 {
+		return ErrInvalidUser
+	}
+	return nil
+}
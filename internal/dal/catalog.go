@@ -0,0 +1,41 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+func (r Dal) ListMerch(ctx context.Context, listArgs models.ListMerchParams) ([]models.Merch, error) {
+	merch, err := r.Queries.ListMerch(ctx, listArgs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(merch), nil
+}
+
+func (r Dal) CountMerch(ctx context.Context, countArgs models.CountMerchParams) (int64, error) {
+	count, err := r.Queries.CountMerch(ctx, countArgs)
+	if err != nil {
+		return 0, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return count, nil
+}
+
+func (r Dal) ListMerchCategories(ctx context.Context) ([]models.MerchCategory, error) {
+	categories, err := r.Queries.ListMerchCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(categories), nil
+}
+
+// ListMerchBySlugs returns merch items found by slugs, unknown slugs are skipped
+func (r Dal) ListMerchBySlugs(ctx context.Context, slugs []string) ([]models.Merch, error) {
+	merch, err := r.Queries.ListMerchBySlugs(ctx, slugs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(merch), nil
+}
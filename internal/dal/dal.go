@@ -59,6 +59,16 @@ func (r Dal) WithTx(tx pgx.Tx) *Dal {
 
 var ErrInternal = errors.New("dal internal error")
 
+// TransactionTime is now() of the database, it stays the same until the end of the transaction.
+// Deadlines checked by queries must be checked against it, not against the clock of the instance
+func (r Dal) TransactionTime(ctx context.Context) (time.Time, error) {
+	now, err := r.Queries.GetTransactionTime(ctx)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return now.Time, nil
+}
+
 func (r Dal) GetUserByName(ctx context.Context, name string) (*models.User, error) {
 	user, err := r.Queries.GetUserByName(ctx, name)
 	if err != nil {
@@ -129,9 +139,14 @@ var ErrInvalidMerchItem = errors.New("invalid merch item")
 var ErrUserMerchPairExist = errors.New("user - merch pair already exists")
 
 func (r Dal) AddMerchToUser(ctx context.Context, userName string, merchName string) (*models.MerchOwnership, error) {
+	return r.AddMerchVariantToUser(ctx, userName, merchName, "")
+}
+
+func (r Dal) AddMerchVariantToUser(ctx context.Context, userName string, merchName string, variant string) (*models.MerchOwnership, error) {
 	merchOwn, err := r.Queries.AddMerchItem(ctx, models.AddMerchItemParams{
 		UserName:  userName,
 		MerchItem: merchName,
+		Variant:   variant,
 	})
 	if err == nil {
 		return &merchOwn, nil
@@ -154,6 +169,15 @@ func (r Dal) AddMerchToUser(ctx context.Context, userName string, merchName stri
 var ErrNonPositiveAmount = errors.New("transfer amount must be positive number")
 var ErrInvalidSender = errors.New("invalid sender")
 var ErrInvalidRecipient = errors.New("invalid recipient")
+var ErrSelfTransfer = errors.New("coins can not be sent to yourself")
+
+func (r Dal) ListUserInventory(ctx context.Context, userName string) ([]models.MerchOwnership, error) {
+	inventory, err := r.Queries.ListUserInventory(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(inventory), nil
+}
 
 func (r Dal) CreateTransfer(ctx context.Context, sender, recipient string, amount int64) (*models.CoinTransfer, error) {
 	transfer, err := r.Queries.CreateTransfer(ctx, models.CreateTransferParams{
@@ -175,11 +199,43 @@ func (r Dal) CreateTransfer(ctx context.Context, sender, recipient string, amoun
 			return nil, ErrInvalidSender
 		case "coin_transfers_fk_recipient":
 			return nil, ErrInvalidRecipient
+		case "coin_transfers_sender_is_recipient":
+			return nil, ErrSelfTransfer
 		}
 	}
 	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
 }
 
+var ErrTransferNotFound = errors.New("transfer not found")
+
+func (r Dal) GetTransfer(ctx context.Context, transferID int64) (*models.CoinTransfer, error) {
+	transfer, err := r.Queries.GetTransfer(ctx, transferID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTransferNotFound
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &transfer, nil
+}
+
+// ListUserTransfers returns sent and received transfers of the user, newest first
+func (r Dal) ListUserTransfers(ctx context.Context, listArgs models.ListUserTransfersParams) ([]models.CoinTransfer, error) {
+	transfers, err := r.Queries.ListUserTransfers(ctx, listArgs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(transfers), nil
+}
+
+func (r Dal) CountUserTransfers(ctx context.Context, userName string) (int64, error) {
+	count, err := r.Queries.CountUserTransfers(ctx, userName)
+	if err != nil {
+		return 0, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return count, nil
+}
+
 func (r Dal) GetCompositeUserInfo(ctx context.Context, userName string) (*models.UserInfo, error) {
 	tmpInfo, err := r.Queries.GetCompositeUserIndo(ctx, userName)
 	if err != nil {
@@ -197,6 +253,10 @@ func (r Dal) GetCompositeUserInfo(ctx context.Context, userName string) (*models
 			Received: NonNil(tmpInfo.Recived),
 			Sent:     NonNil(tmpInfo.Sent),
 		},
+		GiftHistory: models.GiftHistory{
+			Received: NonNil(tmpInfo.GiftsReceived),
+			Sent:     NonNil(tmpInfo.GiftsSent),
+		},
 	}, nil
 }
 
@@ -144,6 +144,33 @@ func TestIntegration(t *testing.T) {
 
 	})
 
+	t.Run("minus merch never below zero", func(t *testing.T) {
+		t.Parallel()
+
+		userName := NewEmail(t)
+
+		user, err := repo.AddNewUser(ctx, models.CreateUserParams{
+			Name:     userName,
+			Password: defPass,
+			Coins:    defCoins,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, user)
+
+		_, err = repo.MinusMerchFromUser(ctx, userName, "pen")
+		require.ErrorIs(t, err, ErrNotEnoughMerch)
+
+		_, err = repo.AddMerchToUser(ctx, userName, "pen")
+		require.NoError(t, err)
+
+		userMerch, err := repo.MinusMerchFromUser(ctx, userName, "pen")
+		require.NoError(t, err)
+		require.EqualValues(t, 0, userMerch.Quantity)
+
+		_, err = repo.MinusMerchFromUser(ctx, userName, "pen")
+		require.ErrorIs(t, err, ErrNotEnoughMerch)
+	})
+
 	t.Run("get info", func(t *testing.T) {
 		userName := "user1_teste2e_info@ya.ru"
 
@@ -0,0 +1,99 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+const defaultEmailLanguage = "en"
+
+// GetEmailSettings returns the defaults for a user who never changed them
+func (r Dal) GetEmailSettings(ctx context.Context, userName string) (*models.EmailSetting, error) {
+	settings, err := r.Queries.GetEmailSettings(ctx, userName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &models.EmailSetting{
+				UserName: userName,
+				Enabled:  true,
+				Language: defaultEmailLanguage,
+			}, nil
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &settings, nil
+}
+
+func (r Dal) SetEmailSettings(ctx context.Context, setArgs models.UpsertEmailSettingsParams) (*models.EmailSetting, error) {
+	settings, err := r.Queries.UpsertEmailSettings(ctx, setArgs)
+	if err == nil {
+		return &settings, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "email_settings_language_valid", "email_settings_fk_user_name":
+			return nil, fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+// AddEmailMessage queues the rendered email in the transaction of r, it is sent only if the transaction commits
+func (r Dal) AddEmailMessage(ctx context.Context, addArgs models.AddEmailMessageParams) error {
+	if err := r.Queries.AddEmailMessage(ctx, addArgs); err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return nil
+}
+
+// ClaimDueEmailMessages leases due emails until leaseUntil, so other instances do not send them meanwhile
+func (r Dal) ClaimDueEmailMessages(ctx context.Context, leaseUntil time.Time, batchSize int32) ([]models.EmailMessage, error) {
+	messages, err := r.Queries.ClaimDueEmailMessages(ctx, models.ClaimDueEmailMessagesParams{
+		LeaseUntil: pgtype.Timestamptz{Time: leaseUntil, Valid: true},
+		BatchSize:  batchSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(messages), nil
+}
+
+func (r Dal) MarkEmailSent(ctx context.Context, messageID int64) error {
+	err := r.Queries.MarkEmailSent(ctx, messageID)
+	if err == nil {
+		return nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == "email_messages_status_valid" {
+		return fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+	}
+	return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+// MarkEmailFailed schedules the next attempt, the email becomes dead after maxAttempts
+func (r Dal) MarkEmailFailed(ctx context.Context, messageID int64, lastError string, nextAttemptAt time.Time, maxAttempts int32) error {
+	err := r.Queries.MarkEmailFailed(ctx, models.MarkEmailFailedParams{
+		MaxAttempts:   maxAttempts,
+		LastError:     pgtype.Text{String: lastError, Valid: true},
+		NextAttemptAt: pgtype.Timestamptz{Time: nextAttemptAt, Valid: true},
+		ID:            messageID,
+	})
+	if err == nil {
+		return nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == "email_messages_status_valid" {
+		return fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+	}
+	return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
@@ -0,0 +1,25 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+// ListUnpublishedOutboxEventsForUpdate locks a batch of events not yet published to the event bus, oldest first.
+// Events locked by other instances are skipped
+func (r Dal) ListUnpublishedOutboxEventsForUpdate(ctx context.Context, batchSize int32) ([]models.OutboxEvent, error) {
+	events, err := r.Queries.ListUnpublishedOutboxEventsForUpdate(ctx, batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(events), nil
+}
+
+func (r Dal) MarkOutboxEventsPublished(ctx context.Context, eventIDs []int64) error {
+	if err := r.Queries.MarkOutboxEventsPublished(ctx, eventIDs); err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return nil
+}
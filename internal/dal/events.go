@@ -0,0 +1,127 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+const (
+	// userEventsAddedChannel is notified on every insert, the payload is empty
+	userEventsAddedChannel = "user_events_added"
+	// userEventsChannel is notified when events get their seq, the payload is the user name
+	userEventsChannel = "user_events"
+)
+
+// user_events rows are inserted by triggers of the tables they describe, so user_events_fk_user_name and
+// user_events_kind_valid can not be violated by the dal. seq is taken from user_events_seq only,
+// so user_events_seq_unique can not be violated either
+
+// ListUserEventsAfter returns events of the user with seq greater than afterSeq, in the order they were sequenced.
+// Events that are not sequenced yet are not returned
+func (r Dal) ListUserEventsAfter(ctx context.Context, userName string, afterSeq int64, limit int32) ([]models.UserEvent, error) {
+	events, err := r.Queries.ListUserEventsAfter(ctx, models.ListUserEventsAfterParams{
+		UserName:  userName,
+		AfterSeq:  afterSeq,
+		PageLimit: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(events), nil
+}
+
+// LastUserEventSeq returns 0 if the user has no sequenced events
+func (r Dal) LastUserEventSeq(ctx context.Context, userName string) (int64, error) {
+	lastSeq, err := r.Queries.GetLastUserEventSeq(ctx, userName)
+	if err != nil {
+		return 0, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return lastSeq, nil
+}
+
+// LockUserEventsSequencer serializes SequenceUserEvents of all instances until the end of the transaction
+func (r Dal) LockUserEventsSequencer(ctx context.Context) error {
+	if err := r.Queries.LockUserEventsSequencer(ctx); err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return nil
+}
+
+// SequenceUserEvents gives committed events without seq the next values of user_events_seq.
+// Ids are taken in insert order but committed in any order, so readers resume by seq, never by id.
+// It must run under LockUserEventsSequencer, then a later seq is always committed after an earlier one
+func (r Dal) SequenceUserEvents(ctx context.Context) (int64, error) {
+	sequenced, err := r.Queries.SequenceUserEvents(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return sequenced, nil
+}
+
+func (r Dal) DeleteUserEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	deleted, err := r.Queries.DeleteUserEventsBefore(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return deleted, nil
+}
+
+var errListenOutsidePool = errors.New("listen needs a pool connection, not a transaction")
+
+// UserEventListener is called from the listening goroutine, the callbacks must not block
+type UserEventListener struct {
+	// Listening is called once LISTEN is set up. Notifications sent before it are lost,
+	// so everything that depends on them must be rechecked
+	Listening func()
+	// Added is called when events were inserted by any instance
+	Added func()
+	// Sequenced is called when events of the user got their seq and can be read
+	Sequenced func(userName string)
+}
+
+// ListenUserEvents calls the listener until ctx is done or the connection fails.
+// The connection is held out of the pool while listening
+func (r Dal) ListenUserEvents(ctx context.Context, listener UserEventListener) error {
+	pool, ok := r.PgxPool.(*pgxpool.Pool)
+	if !ok {
+		return errListenOutsidePool
+	}
+
+	pooled, err := pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	// the connection is in the listen state, it must not get back to the pool
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	for _, channel := range []string{userEventsAddedChannel, userEventsChannel} {
+		if _, err = conn.Exec(ctx, "LISTEN "+channel); err != nil {
+			return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+		}
+	}
+	listener.Listening()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+		}
+
+		switch notification.Channel {
+		case userEventsAddedChannel:
+			listener.Added()
+		case userEventsChannel:
+			listener.Sequenced(notification.Payload)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+var ErrGiftToSelf = errors.New("merch can not be gifted to yourself")
+
+func (r Dal) CreateGift(ctx context.Context, createArgs models.CreateGiftParams) (*models.MerchGift, error) {
+	gift, err := r.Queries.CreateGift(ctx, createArgs)
+	if err == nil {
+		return &gift, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "merch_gifts_fk_sender":
+			return nil, ErrInvalidSender
+		case "merch_gifts_fk_recipient":
+			return nil, ErrInvalidRecipient
+		case "merch_gifts_fk_merch_item":
+			return nil, ErrInvalidMerchItem
+		case "merch_gifts_sender_is_recipient":
+			return nil, ErrGiftToSelf
+		case "merch_gifts_price_non_negative", "merch_gifts_discount_non_negative":
+			return nil, ErrNegativePrice
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
@@ -0,0 +1,178 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+const (
+	ListingStatusActive    = "active"
+	ListingStatusSold      = "sold"
+	ListingStatusCancelled = "cancelled"
+)
+
+// TakeMerchFromUser removes quantity units of merch from the user inventory at once
+func (r Dal) TakeMerchFromUser(ctx context.Context, userName string, merchName string, variant string, quantity int64) (*models.MerchOwnership, error) {
+	merchOwn, err := r.Queries.TakeMerchItems(ctx, models.TakeMerchItemsParams{
+		Quantity:  quantity,
+		UserName:  userName,
+		MerchItem: merchName,
+		Variant:   variant,
+	})
+	if err == nil {
+		return &merchOwn, nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotEnoughMerch
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "merch_ownership_quantity_non_negative":
+			return nil, ErrNotEnoughMerch
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+// GiveMerchToUser adds quantity units of merch to the user inventory at once
+func (r Dal) GiveMerchToUser(ctx context.Context, userName string, merchName string, variant string, quantity int64) (*models.MerchOwnership, error) {
+	merchOwn, err := r.Queries.AddMerchItems(ctx, models.AddMerchItemsParams{
+		UserName:  userName,
+		MerchItem: merchName,
+		Variant:   variant,
+		Quantity:  quantity,
+	})
+	if err == nil {
+		return &merchOwn, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "merch_ownership_fk_user_name":
+			return nil, ErrInvalidUser
+		case "merch_ownership_fk_merch_item":
+			return nil, ErrInvalidMerchItem
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+var ErrInvalidListing = errors.New("invalid listing parameters")
+
+func (r Dal) CreateListing(ctx context.Context, createArgs models.CreateListingParams) (*models.MarketListing, error) {
+	listing, err := r.Queries.CreateListing(ctx, createArgs)
+	if err == nil {
+		return &listing, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "market_listings_fk_seller":
+			return nil, ErrInvalidUser
+		case "market_listings_fk_merch_item":
+			return nil, ErrInvalidMerchItem
+		case "market_listings_quantity_non_negative", "market_listings_price_positive":
+			return nil, fmt.Errorf("%w: %s", ErrInvalidListing, pgErr.ConstraintName)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+var ErrListingNotFound = errors.New("listing not found")
+
+// GetListingForUpdate locks the listing row until the end of the transaction
+func (r Dal) GetListingForUpdate(ctx context.Context, listingID int64) (*models.MarketListing, error) {
+	listing, err := r.Queries.GetListingForUpdate(ctx, listingID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrListingNotFound
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &listing, nil
+}
+
+// ListActiveListings returns active listings cheapest first, empty merchName means any merch item
+func (r Dal) ListActiveListings(ctx context.Context, merchName string) ([]models.MarketListing, error) {
+	listings, err := r.Queries.ListActiveListings(ctx, merchName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(listings), nil
+}
+
+var ErrListingClosed = errors.New("listing is already sold or cancelled")
+var ErrNotEnoughListed = errors.New("listing does not have enough units")
+
+// TakeListingUnits decrements listed units, the listing becomes sold when the last unit is taken
+func (r Dal) TakeListingUnits(ctx context.Context, listingID int64, quantity int64) (*models.MarketListing, error) {
+	listing, err := r.Queries.TakeListingUnits(ctx, models.TakeListingUnitsParams{
+		Quantity: quantity,
+		ID:       listingID,
+	})
+	if err == nil {
+		return &listing, nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrListingClosed
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "market_listings_quantity_non_negative":
+			return nil, ErrNotEnoughListed
+		case "market_listings_status_valid":
+			return nil, fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+func (r Dal) CancelListing(ctx context.Context, listingID int64) (*models.MarketListing, error) {
+	listing, err := r.Queries.CancelListing(ctx, listingID)
+	if err == nil {
+		return &listing, nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrListingClosed
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "market_listings_status_valid":
+			return nil, fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+func (r Dal) AddMarketSale(ctx context.Context, createArgs models.CreateMarketSaleParams) (*models.MarketSale, error) {
+	sale, err := r.Queries.CreateMarketSale(ctx, createArgs)
+	if err == nil {
+		return &sale, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "market_sales_fk_buyer":
+			return nil, ErrInvalidUser
+		case "market_sales_fk_listing_id":
+			return nil, fmt.Errorf("%w: listing not found: this constraint must check upper layer", ErrInternal)
+		case "market_sales_quantity_positive", "market_sales_fee_valid":
+			return nil, fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
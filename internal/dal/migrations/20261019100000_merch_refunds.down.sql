@@ -0,0 +1,5 @@
+ALTER TABLE merch_ownership
+    DROP CONSTRAINT IF EXISTS merch_ownership_quantity_non_negative;
+
+DROP TABLE IF EXISTS merch_refunds;
+DROP TABLE IF EXISTS merch_purchases;
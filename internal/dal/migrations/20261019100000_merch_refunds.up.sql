@@ -0,0 +1,46 @@
+CREATE TABLE merch_purchases
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_name varchar(255) NOT NULL,
+    merch_item varchar(255) NOT NULL,
+    price bigint NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT merch_purchases_fk_user_name
+        FOREIGN KEY (user_name)
+            REFERENCES users,
+
+    CONSTRAINT merch_purchases_fk_merch_item
+        FOREIGN KEY (merch_item)
+            REFERENCES merch,
+
+    CONSTRAINT merch_purchases_price_non_negative CHECK (price >= 0)
+);
+
+CREATE TABLE merch_refunds
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    purchase_id bigint NOT NULL,
+    status varchar(32) NOT NULL DEFAULT 'requested',
+    requested_at timestamptz NOT NULL DEFAULT now(),
+    resolved_at timestamptz,
+    resolved_by varchar(255),
+
+    CONSTRAINT merch_refunds_fk_purchase_id
+        FOREIGN KEY (purchase_id)
+            REFERENCES merch_purchases,
+
+    CONSTRAINT merch_refunds_fk_resolved_by
+        FOREIGN KEY (resolved_by)
+            REFERENCES users,
+
+    CONSTRAINT merch_refunds_status_valid CHECK (status IN ('requested', 'approved', 'rejected'))
+);
+
+-- only one not rejected refund per purchase
+CREATE UNIQUE INDEX merch_refunds_active_purchase_id
+    ON merch_refunds (purchase_id)
+    WHERE status != 'rejected';
+
+ALTER TABLE merch_ownership
+    ADD CONSTRAINT merch_ownership_quantity_non_negative CHECK (quantity >= 0);
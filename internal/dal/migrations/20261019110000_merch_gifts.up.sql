@@ -0,0 +1,25 @@
+CREATE TABLE merch_gifts
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    sender varchar(255) NOT NULL,
+    recipient varchar(255) NOT NULL,
+    merch_item varchar(255) NOT NULL,
+    price bigint NOT NULL,
+    message varchar(255) NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT merch_gifts_fk_sender
+        FOREIGN KEY (sender)
+            REFERENCES users,
+
+    CONSTRAINT merch_gifts_fk_recipient
+        FOREIGN KEY (recipient)
+            REFERENCES users,
+
+    CONSTRAINT merch_gifts_fk_merch_item
+        FOREIGN KEY (merch_item)
+            REFERENCES merch,
+
+    CONSTRAINT merch_gifts_price_non_negative CHECK (price >= 0),
+    CONSTRAINT merch_gifts_sender_is_recipient CHECK (sender != recipient)
+);
@@ -0,0 +1,23 @@
+ALTER TABLE merch_gifts
+    DROP COLUMN IF EXISTS variant;
+
+ALTER TABLE merch_purchases
+    DROP COLUMN IF EXISTS variant;
+
+-- fold variants back into plain merch items
+INSERT INTO merch_ownership (user_name, merch_item, variant, quantity)
+SELECT user_name, merch_item, '', sum(quantity)
+FROM merch_ownership
+WHERE variant != ''
+GROUP BY user_name, merch_item
+ON CONFLICT (user_name, merch_item, variant) DO UPDATE
+    SET quantity = merch_ownership.quantity + excluded.quantity;
+DELETE FROM merch_ownership WHERE variant != '';
+ALTER TABLE merch_ownership
+    DROP CONSTRAINT merch_ownership_pkey;
+ALTER TABLE merch_ownership
+    ADD CONSTRAINT merch_ownership_pkey PRIMARY KEY (user_name, merch_item);
+ALTER TABLE merch_ownership
+    DROP COLUMN IF EXISTS variant;
+
+DROP TABLE IF EXISTS merch_variants;
@@ -0,0 +1,44 @@
+CREATE TABLE merch_variants
+(
+    merch_item varchar(255) NOT NULL,
+    variant varchar(255) NOT NULL,
+    attributes jsonb NOT NULL DEFAULT '{}', -- e.g. {"size": "XL", "color": "black"}
+    price bigint, -- NULL means merch price
+    stock bigint, -- NULL means unlimited
+
+    CONSTRAINT merch_variants_fk_merch_item
+        FOREIGN KEY (merch_item)
+            REFERENCES merch,
+
+    CONSTRAINT merch_variants_variant_not_empty CHECK (variant != ''),
+    CONSTRAINT merch_variants_price_non_negative CHECK (price >= 0),
+    CONSTRAINT merch_variants_stock_non_negative CHECK (stock >= 0),
+
+    PRIMARY KEY (merch_item, variant)
+);
+
+-- empty variant means the plain merch item without variant
+ALTER TABLE merch_ownership
+    ADD COLUMN variant varchar(255) NOT NULL DEFAULT '';
+ALTER TABLE merch_ownership
+    DROP CONSTRAINT merch_ownership_pkey;
+ALTER TABLE merch_ownership
+    ADD CONSTRAINT merch_ownership_pkey PRIMARY KEY (user_name, merch_item, variant);
+
+ALTER TABLE merch_purchases
+    ADD COLUMN variant varchar(255) NOT NULL DEFAULT '';
+
+ALTER TABLE merch_gifts
+    ADD COLUMN variant varchar(255) NOT NULL DEFAULT '';
+
+-- todo remove to initialize container
+INSERT INTO merch_variants (merch_item, variant, attributes)
+VALUES ('t-shirt', 's', '{"size": "S"}'),
+       ('t-shirt', 'm', '{"size": "M"}'),
+       ('t-shirt', 'l', '{"size": "L"}'),
+       ('t-shirt', 'xl', '{"size": "XL"}'),
+       ('hoody', 's', '{"size": "S"}'),
+       ('hoody', 'm', '{"size": "M"}'),
+       ('hoody', 'l', '{"size": "L"}'),
+       ('hoody', 'xl', '{"size": "XL"}')
+;
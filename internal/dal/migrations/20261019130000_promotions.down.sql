@@ -0,0 +1,11 @@
+ALTER TABLE merch_gifts
+    DROP COLUMN IF EXISTS discount;
+
+ALTER TABLE merch_purchases
+    DROP COLUMN IF EXISTS bundle,
+    DROP COLUMN IF EXISTS discount;
+
+DROP TABLE IF EXISTS merch_bundle_items;
+DROP TABLE IF EXISTS merch_bundles;
+DROP TABLE IF EXISTS promotion_redemptions;
+DROP TABLE IF EXISTS promotions;
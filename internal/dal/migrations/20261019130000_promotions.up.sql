@@ -0,0 +1,91 @@
+CREATE TABLE promotions
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    code varchar(64), -- NULL means sale, applied automatically
+    merch_item varchar(255), -- NULL means any merch item
+    percent_off integer NOT NULL DEFAULT 0,
+    amount_off bigint NOT NULL DEFAULT 0,
+    stackable boolean NOT NULL DEFAULT false,
+    starts_at timestamptz NOT NULL,
+    ends_at timestamptz NOT NULL,
+    max_redemptions bigint, -- NULL means unlimited
+    max_redemptions_per_user bigint, -- NULL means unlimited
+    created_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT promotions_code_unique UNIQUE (code),
+
+    CONSTRAINT promotions_fk_merch_item
+        FOREIGN KEY (merch_item)
+            REFERENCES merch,
+
+    CONSTRAINT promotions_percent_off_range CHECK (percent_off BETWEEN 0 AND 100),
+    CONSTRAINT promotions_amount_off_non_negative CHECK (amount_off >= 0),
+    CONSTRAINT promotions_period_valid CHECK (starts_at < ends_at),
+    CONSTRAINT promotions_max_redemptions_positive CHECK (max_redemptions > 0),
+    CONSTRAINT promotions_max_redemptions_per_user_positive CHECK (max_redemptions_per_user > 0)
+);
+
+CREATE TABLE promotion_redemptions
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    promotion_id bigint NOT NULL,
+    user_name varchar(255) NOT NULL,
+    discount bigint NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT promotion_redemptions_fk_promotion_id
+        FOREIGN KEY (promotion_id)
+            REFERENCES promotions,
+
+    CONSTRAINT promotion_redemptions_fk_user_name
+        FOREIGN KEY (user_name)
+            REFERENCES users,
+
+    CONSTRAINT promotion_redemptions_discount_non_negative CHECK (discount >= 0)
+);
+
+CREATE INDEX promotion_redemptions_promotion_id_user_name
+    ON promotion_redemptions (promotion_id, user_name);
+
+CREATE TABLE merch_bundles
+(
+    slug varchar(255) PRIMARY KEY,
+    price bigint NOT NULL,
+    starts_at timestamptz NOT NULL,
+    ends_at timestamptz NOT NULL,
+
+    CONSTRAINT merch_bundles_price_non_negative CHECK (price >= 0),
+    CONSTRAINT merch_bundles_period_valid CHECK (starts_at < ends_at)
+);
+
+CREATE TABLE merch_bundle_items
+(
+    bundle varchar(255) NOT NULL,
+    merch_item varchar(255) NOT NULL,
+    quantity bigint NOT NULL,
+
+    CONSTRAINT merch_bundle_items_fk_bundle
+        FOREIGN KEY (bundle)
+            REFERENCES merch_bundles,
+
+    CONSTRAINT merch_bundle_items_fk_merch_item
+        FOREIGN KEY (merch_item)
+            REFERENCES merch,
+
+    CONSTRAINT merch_bundle_items_quantity_positive CHECK (quantity > 0),
+
+    PRIMARY KEY (bundle, merch_item)
+);
+
+-- price is what user actually paid, price + discount is the merch price at the moment of purchase
+ALTER TABLE merch_purchases
+    ADD COLUMN discount bigint NOT NULL DEFAULT 0,
+    ADD COLUMN bundle varchar(255),
+    ADD CONSTRAINT merch_purchases_discount_non_negative CHECK (discount >= 0),
+    ADD CONSTRAINT merch_purchases_fk_bundle
+        FOREIGN KEY (bundle)
+            REFERENCES merch_bundles;
+
+ALTER TABLE merch_gifts
+    ADD COLUMN discount bigint NOT NULL DEFAULT 0,
+    ADD CONSTRAINT merch_gifts_discount_non_negative CHECK (discount >= 0);
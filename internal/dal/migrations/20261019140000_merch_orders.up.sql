@@ -0,0 +1,52 @@
+CREATE TABLE merch_orders
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_name varchar(255) NOT NULL, -- who picks the item up
+    payer varchar(255) NOT NULL, -- differs from user_name for gifts
+    merch_item varchar(255) NOT NULL,
+    variant varchar(255) NOT NULL DEFAULT '',
+    price bigint NOT NULL, -- coins returned to payer on cancellation
+    purchase_id bigint,
+    gift_id bigint,
+    status varchar(32) NOT NULL DEFAULT 'placed',
+    pickup_code varchar(16) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    updated_by varchar(255),
+
+    CONSTRAINT merch_orders_fk_user_name
+        FOREIGN KEY (user_name)
+            REFERENCES users,
+
+    CONSTRAINT merch_orders_fk_payer
+        FOREIGN KEY (payer)
+            REFERENCES users,
+
+    CONSTRAINT merch_orders_fk_merch_item
+        FOREIGN KEY (merch_item)
+            REFERENCES merch,
+
+    CONSTRAINT merch_orders_fk_purchase_id
+        FOREIGN KEY (purchase_id)
+            REFERENCES merch_purchases,
+
+    CONSTRAINT merch_orders_fk_gift_id
+        FOREIGN KEY (gift_id)
+            REFERENCES merch_gifts,
+
+    CONSTRAINT merch_orders_fk_updated_by
+        FOREIGN KEY (updated_by)
+            REFERENCES users,
+
+    CONSTRAINT merch_orders_price_non_negative CHECK (price >= 0),
+    CONSTRAINT merch_orders_status_valid CHECK (status IN ('placed', 'ready', 'handed_over', 'cancelled'))
+);
+
+CREATE INDEX merch_orders_status
+    ON merch_orders (status);
+
+CREATE INDEX merch_orders_user_name
+    ON merch_orders (user_name);
+
+CREATE INDEX merch_orders_purchase_id
+    ON merch_orders (purchase_id);
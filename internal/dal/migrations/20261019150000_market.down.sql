@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS market_sales;
+DROP TABLE IF EXISTS market_listings;
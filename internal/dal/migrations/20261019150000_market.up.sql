@@ -0,0 +1,50 @@
+CREATE TABLE market_listings
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    seller varchar(255) NOT NULL,
+    merch_item varchar(255) NOT NULL,
+    variant varchar(255) NOT NULL DEFAULT '',
+    quantity bigint NOT NULL, -- units still reserved for sale
+    price bigint NOT NULL, -- per unit
+    status varchar(32) NOT NULL DEFAULT 'active',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    closed_at timestamptz,
+
+    CONSTRAINT market_listings_fk_seller
+        FOREIGN KEY (seller)
+            REFERENCES users,
+
+    CONSTRAINT market_listings_fk_merch_item
+        FOREIGN KEY (merch_item)
+            REFERENCES merch,
+
+    CONSTRAINT market_listings_quantity_non_negative CHECK (quantity >= 0),
+    CONSTRAINT market_listings_price_positive CHECK (price > 0),
+    CONSTRAINT market_listings_status_valid CHECK (status IN ('active', 'sold', 'cancelled'))
+);
+
+CREATE INDEX market_listings_active_merch_item
+    ON market_listings (merch_item)
+    WHERE status = 'active';
+
+CREATE TABLE market_sales
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    listing_id bigint NOT NULL,
+    buyer varchar(255) NOT NULL,
+    quantity bigint NOT NULL,
+    amount bigint NOT NULL, -- paid by buyer, seller gets amount - fee
+    fee bigint NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT market_sales_fk_listing_id
+        FOREIGN KEY (listing_id)
+            REFERENCES market_listings,
+
+    CONSTRAINT market_sales_fk_buyer
+        FOREIGN KEY (buyer)
+            REFERENCES users,
+
+    CONSTRAINT market_sales_quantity_positive CHECK (quantity > 0),
+    CONSTRAINT market_sales_fee_valid CHECK (fee >= 0 AND fee <= amount)
+);
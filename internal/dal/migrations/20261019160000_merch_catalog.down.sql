@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS merch_category;
+
+ALTER TABLE merch
+    DROP CONSTRAINT IF EXISTS merch_fk_category,
+    DROP COLUMN IF EXISTS image_url,
+    DROP COLUMN IF EXISTS description_en,
+    DROP COLUMN IF EXISTS description_ru,
+    DROP COLUMN IF EXISTS name_en,
+    DROP COLUMN IF EXISTS name_ru,
+    DROP COLUMN IF EXISTS category;
+
+DROP TABLE IF EXISTS merch_categories;
@@ -0,0 +1,49 @@
+CREATE TABLE merch_categories
+(
+    slug varchar(255) PRIMARY KEY,
+    name_ru varchar(255) NOT NULL,
+    name_en varchar(255) NOT NULL
+);
+
+ALTER TABLE merch
+    ADD COLUMN category varchar(255),
+    ADD COLUMN name_ru varchar(255) NOT NULL DEFAULT '',
+    ADD COLUMN name_en varchar(255) NOT NULL DEFAULT '',
+    ADD COLUMN description_ru text NOT NULL DEFAULT '',
+    ADD COLUMN description_en text NOT NULL DEFAULT '',
+    ADD COLUMN image_url varchar(1024) NOT NULL DEFAULT '',
+    ADD CONSTRAINT merch_fk_category
+        FOREIGN KEY (category)
+            REFERENCES merch_categories;
+
+CREATE INDEX merch_category
+    ON merch (category);
+
+-- todo remove to initialize container
+INSERT INTO merch_categories (slug, name_ru, name_en)
+VALUES ('clothes', 'Одежда', 'Clothes'),
+       ('accessories', 'Аксессуары', 'Accessories'),
+       ('office', 'Для офиса', 'Office'),
+       ('gadgets', 'Гаджеты', 'Gadgets')
+;
+
+UPDATE merch
+SET category = v.category,
+    name_ru = v.name_ru,
+    name_en = v.name_en,
+    description_ru = v.description_ru,
+    description_en = v.description_en,
+    image_url = '/static/merch/' || merch.slug || '.png'
+FROM (VALUES ('t-shirt', 'clothes', 'Футболка', 'T-shirt', 'Хлопковая футболка с логотипом.', 'Cotton t-shirt with the logo.'),
+             ('cup', 'office', 'Кружка', 'Cup', 'Керамическая кружка для чая и кофе.', 'Ceramic cup for tea and coffee.'),
+             ('book', 'office', 'Книга', 'Book', 'Книга о культуре компании.', 'A book about the company culture.'),
+             ('pen', 'office', 'Ручка', 'Pen', 'Шариковая ручка с логотипом.', 'Ballpoint pen with the logo.'),
+             ('powerbank', 'gadgets', 'Пауэрбанк', 'Power bank', 'Внешний аккумулятор на 10000 мАч.', '10000 mAh external battery.'),
+             ('hoody', 'clothes', 'Худи', 'Hoody', 'Теплое худи с капюшоном.', 'Warm hooded sweatshirt.'),
+             ('umbrella', 'accessories', 'Зонт', 'Umbrella', 'Складной зонт от дождя.', 'Folding rain umbrella.'),
+             ('socks', 'clothes', 'Носки', 'Socks', 'Яркие носки с принтом.', 'Bright printed socks.'),
+             ('wallet', 'accessories', 'Кошелек', 'Wallet', 'Кожаный кошелек.', 'Leather wallet.'),
+             ('pink-hoody', 'clothes', 'Розовое худи', 'Pink hoody', 'Лимитированное розовое худи.', 'Limited edition pink hoody.')
+     ) AS v (slug, category, name_ru, name_en, description_ru, description_en)
+WHERE merch.slug = v.slug
+;
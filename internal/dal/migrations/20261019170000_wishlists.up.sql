@@ -0,0 +1,43 @@
+CREATE TABLE wishlists
+(
+    user_name varchar(255) NOT NULL,
+    merch_item varchar(255) NOT NULL,
+    variant varchar(255) NOT NULL DEFAULT '', -- empty variant means any variant
+    created_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT wishlists_fk_user_name
+        FOREIGN KEY (user_name)
+            REFERENCES users,
+
+    CONSTRAINT wishlists_fk_merch_item
+        FOREIGN KEY (merch_item)
+            REFERENCES merch,
+
+    PRIMARY KEY (user_name, merch_item, variant)
+);
+
+CREATE INDEX wishlists_merch_item
+    ON wishlists (merch_item, variant);
+
+CREATE TABLE notifications
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_name varchar(255) NOT NULL,
+    kind varchar(32) NOT NULL,
+    merch_item varchar(255),
+    message text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT notifications_fk_user_name
+        FOREIGN KEY (user_name)
+            REFERENCES users,
+
+    CONSTRAINT notifications_fk_merch_item
+        FOREIGN KEY (merch_item)
+            REFERENCES merch,
+
+    CONSTRAINT notifications_kind_valid CHECK (kind IN ('item_on_sale', 'item_restocked'))
+);
+
+CREATE INDEX notifications_user_name
+    ON notifications (user_name, id);
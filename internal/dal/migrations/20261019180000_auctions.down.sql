@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS auction_bids;
+DROP TABLE IF EXISTS auctions;
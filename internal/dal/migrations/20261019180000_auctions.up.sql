@@ -0,0 +1,64 @@
+CREATE TABLE auctions
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    merch_item varchar(255) NOT NULL,
+    variant varchar(255) NOT NULL DEFAULT '',
+    start_price bigint NOT NULL,
+    min_step bigint NOT NULL,
+    extension_seconds bigint NOT NULL, -- a bid closer than this to the end moves the end, so nobody can snipe
+    starts_at timestamptz NOT NULL,
+    ends_at timestamptz NOT NULL,
+    status varchar(32) NOT NULL DEFAULT 'open',
+    leader varchar(255),
+    leading_bid bigint,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    closed_at timestamptz,
+
+    CONSTRAINT auctions_fk_merch_item
+        FOREIGN KEY (merch_item)
+            REFERENCES merch,
+
+    CONSTRAINT auctions_fk_leader
+        FOREIGN KEY (leader)
+            REFERENCES users,
+
+    CONSTRAINT auctions_start_price_positive CHECK (start_price > 0),
+    CONSTRAINT auctions_min_step_positive CHECK (min_step > 0),
+    CONSTRAINT auctions_extension_non_negative CHECK (extension_seconds >= 0),
+    CONSTRAINT auctions_ends_after_start CHECK (ends_at > starts_at),
+    CONSTRAINT auctions_status_valid CHECK (status IN ('open', 'closed'))
+);
+
+CREATE INDEX auctions_open_ends_at
+    ON auctions (ends_at)
+    WHERE status = 'open';
+
+CREATE TABLE auction_bids
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    auction_id bigint NOT NULL,
+    bidder varchar(255) NOT NULL,
+    amount bigint NOT NULL, -- held from the bidder balance while the bid leads
+    status varchar(32) NOT NULL DEFAULT 'held',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT auction_bids_fk_auction_id
+        FOREIGN KEY (auction_id)
+            REFERENCES auctions,
+
+    CONSTRAINT auction_bids_fk_bidder
+        FOREIGN KEY (bidder)
+            REFERENCES users,
+
+    CONSTRAINT auction_bids_amount_positive CHECK (amount > 0),
+    CONSTRAINT auction_bids_status_valid CHECK (status IN ('held', 'released', 'won'))
+);
+
+CREATE INDEX auction_bids_auction_id
+    ON auction_bids (auction_id, id);
+
+-- only the leading bid holds coins
+CREATE UNIQUE INDEX auction_bids_one_held
+    ON auction_bids (auction_id)
+    WHERE status = 'held';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS raffle_tickets;
+DROP TABLE IF EXISTS raffles;
@@ -0,0 +1,57 @@
+CREATE TABLE raffles
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    merch_item varchar(255) NOT NULL,
+    variant varchar(255) NOT NULL DEFAULT '',
+    ticket_price bigint NOT NULL,
+    winners bigint NOT NULL,
+    max_tickets_per_user bigint, -- NULL means unlimited
+    sales_end_at timestamptz NOT NULL,
+    seed_hash varchar(64) NOT NULL, -- published sha256 of the seed, so the seed can not be changed after tickets are sold
+    seed varchar(64) NOT NULL, -- kept secret until the draw
+    status varchar(32) NOT NULL DEFAULT 'open',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    created_by varchar(255) NOT NULL,
+    drawn_at timestamptz,
+    drawn_by varchar(255),
+
+    CONSTRAINT raffles_fk_merch_item
+        FOREIGN KEY (merch_item)
+            REFERENCES merch,
+
+    CONSTRAINT raffles_fk_created_by
+        FOREIGN KEY (created_by)
+            REFERENCES users,
+
+    CONSTRAINT raffles_fk_drawn_by
+        FOREIGN KEY (drawn_by)
+            REFERENCES users,
+
+    CONSTRAINT raffles_ticket_price_positive CHECK (ticket_price > 0),
+    CONSTRAINT raffles_winners_positive CHECK (winners > 0),
+    CONSTRAINT raffles_max_tickets_per_user_positive CHECK (max_tickets_per_user > 0),
+    CONSTRAINT raffles_status_valid CHECK (status IN ('open', 'drawn'))
+);
+
+CREATE TABLE raffle_tickets
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    raffle_id bigint NOT NULL,
+    user_name varchar(255) NOT NULL,
+    price bigint NOT NULL,
+    won boolean NOT NULL DEFAULT false,
+    created_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT raffle_tickets_fk_raffle_id
+        FOREIGN KEY (raffle_id)
+            REFERENCES raffles,
+
+    CONSTRAINT raffle_tickets_fk_user_name
+        FOREIGN KEY (user_name)
+            REFERENCES users,
+
+    CONSTRAINT raffle_tickets_price_non_negative CHECK (price >= 0)
+);
+
+CREATE INDEX raffle_tickets_raffle_id
+    ON raffle_tickets (raffle_id, user_name);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS coin_transfers_recipient_idx;
+DROP INDEX IF EXISTS coin_transfers_sender_idx;
+
+ALTER TABLE coin_transfers
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS id;
@@ -0,0 +1,7 @@
+-- transfers become addressable resources of /api/v2, existing rows are numbered in insert order
+ALTER TABLE coin_transfers
+    ADD COLUMN id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    ADD COLUMN created_at timestamptz NOT NULL DEFAULT now();
+
+CREATE INDEX coin_transfers_sender_idx ON coin_transfers (sender, id);
+CREATE INDEX coin_transfers_recipient_idx ON coin_transfers (recipient, id);
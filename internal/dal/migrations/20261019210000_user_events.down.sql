@@ -0,0 +1,11 @@
+DROP TRIGGER IF EXISTS merch_orders_status_changed ON merch_orders;
+DROP FUNCTION IF EXISTS merch_orders_status_changed;
+
+DROP TRIGGER IF EXISTS coin_transfers_received ON coin_transfers;
+DROP FUNCTION IF EXISTS coin_transfers_received;
+
+DROP TRIGGER IF EXISTS users_balance_changed ON users;
+DROP FUNCTION IF EXISTS users_balance_changed;
+
+DROP TABLE IF EXISTS user_events;
+DROP FUNCTION IF EXISTS user_events_notify;
@@ -0,0 +1,90 @@
+-- user_events feed GET /api/events. Triggers write them, so every path changing coins or orders is covered,
+-- and notify listeners of all instances after commit
+CREATE TABLE user_events
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_name varchar(255) NOT NULL,
+    kind varchar(32) NOT NULL,
+    payload jsonb NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT user_events_fk_user_name
+        FOREIGN KEY (user_name)
+            REFERENCES users,
+
+    CONSTRAINT user_events_kind_valid CHECK (kind IN ('balance_changed', 'transfer_received', 'order_status_changed'))
+);
+
+CREATE INDEX user_events_user_name
+    ON user_events (user_name, id);
+
+CREATE INDEX user_events_created_at
+    ON user_events (created_at);
+
+CREATE FUNCTION user_events_notify() RETURNS trigger AS
+$$
+BEGIN
+    PERFORM pg_notify('user_events', row_to_json(NEW)::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER user_events_notify
+    AFTER INSERT ON user_events
+    FOR EACH ROW
+EXECUTE FUNCTION user_events_notify();
+
+CREATE FUNCTION users_balance_changed() RETURNS trigger AS
+$$
+BEGIN
+    INSERT INTO user_events (user_name, kind, payload)
+    VALUES (NEW.name, 'balance_changed', json_build_object(
+            'coins', NEW.coins,
+            'delta', NEW.coins - OLD.coins));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER users_balance_changed
+    AFTER UPDATE OF coins ON users
+    FOR EACH ROW
+    WHEN (OLD.coins IS DISTINCT FROM NEW.coins)
+EXECUTE FUNCTION users_balance_changed();
+
+CREATE FUNCTION coin_transfers_received() RETURNS trigger AS
+$$
+BEGIN
+    INSERT INTO user_events (user_name, kind, payload)
+    VALUES (NEW.recipient, 'transfer_received', json_build_object(
+            'id', NEW.id,
+            'fromUser', NEW.sender,
+            'amount', NEW.amount,
+            'createdAt', NEW.created_at));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER coin_transfers_received
+    AFTER INSERT ON coin_transfers
+    FOR EACH ROW
+EXECUTE FUNCTION coin_transfers_received();
+
+-- both the user and the payer of a gift follow the order
+CREATE FUNCTION merch_orders_status_changed() RETURNS trigger AS
+$$
+BEGIN
+    INSERT INTO user_events (user_name, kind, payload)
+    SELECT follower, 'order_status_changed', json_build_object(
+            'id', NEW.id,
+            'item', NEW.merch_item,
+            'status', NEW.status)
+    FROM (SELECT DISTINCT unnest(ARRAY [NEW.user_name, NEW.payer]) AS follower) AS followers;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER merch_orders_status_changed
+    AFTER UPDATE OF status ON merch_orders
+    FOR EACH ROW
+    WHEN (OLD.status IS DISTINCT FROM NEW.status)
+EXECUTE FUNCTION merch_orders_status_changed();
@@ -0,0 +1,56 @@
+-- outbox_events are written in the transaction of the business operation, so an event exists if and only if
+-- the operation is committed. The dispatcher fans them out to webhook_deliveries of matching subscriptions
+CREATE TABLE outbox_events
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    event_type varchar(64) NOT NULL,
+    payload jsonb NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    webhooks_dispatched_at timestamptz
+);
+
+CREATE INDEX outbox_events_webhooks_undispatched
+    ON outbox_events (id) WHERE webhooks_dispatched_at IS NULL;
+
+CREATE TABLE webhook_subscriptions
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    url varchar(2048) NOT NULL,
+    secret varchar(255) NOT NULL,
+    event_types varchar(64)[] NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    created_by varchar(255) NOT NULL,
+
+    CONSTRAINT webhook_subscriptions_fk_created_by
+        FOREIGN KEY (created_by)
+            REFERENCES users
+);
+
+CREATE TABLE webhook_deliveries
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    subscription_id bigint NOT NULL,
+    event_id bigint NOT NULL,
+    status varchar(16) NOT NULL DEFAULT 'pending',
+    attempts int NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    last_status_code int,
+    last_error text,
+    delivered_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT webhook_deliveries_fk_subscription_id
+        FOREIGN KEY (subscription_id)
+            REFERENCES webhook_subscriptions
+            ON DELETE CASCADE,
+
+    CONSTRAINT webhook_deliveries_fk_event_id
+        FOREIGN KEY (event_id)
+            REFERENCES outbox_events,
+
+    CONSTRAINT webhook_deliveries_subscription_event_unique UNIQUE (subscription_id, event_id),
+    CONSTRAINT webhook_deliveries_status_valid CHECK (status IN ('pending', 'delivered', 'dead'))
+);
+
+CREATE INDEX webhook_deliveries_due
+    ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS outbox_events_bus_unpublished;
+
+ALTER TABLE outbox_events
+    DROP COLUMN IF EXISTS bus_published_at;
@@ -0,0 +1,7 @@
+-- outbox_events are published to the event bus as well, independently of webhooks.
+-- Events of the time the bus was disabled are published when it is enabled
+ALTER TABLE outbox_events
+    ADD COLUMN bus_published_at timestamptz;
+
+CREATE INDEX outbox_events_bus_unpublished
+    ON outbox_events (id) WHERE bus_published_at IS NULL;
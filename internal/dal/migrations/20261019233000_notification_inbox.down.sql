@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS notification_mutes;
+
+DROP INDEX IF EXISTS notifications_user_name_unread;
+
+DELETE FROM notifications
+WHERE kind NOT IN ('item_on_sale', 'item_restocked');
+
+ALTER TABLE notifications
+    DROP CONSTRAINT IF EXISTS notifications_kind_valid;
+
+ALTER TABLE notifications
+    ADD CONSTRAINT notifications_kind_valid CHECK (kind IN ('item_on_sale', 'item_restocked'));
+
+ALTER TABLE notifications
+    DROP COLUMN IF EXISTS read_at;
@@ -0,0 +1,28 @@
+ALTER TABLE notifications
+    ADD COLUMN read_at timestamptz;
+
+ALTER TABLE notifications
+    DROP CONSTRAINT notifications_kind_valid;
+
+ALTER TABLE notifications
+    ADD CONSTRAINT notifications_kind_valid
+        CHECK (kind IN ('item_on_sale', 'item_restocked', 'coins_received', 'order_ready'));
+
+CREATE INDEX notifications_user_name_unread
+    ON notifications (user_name) WHERE read_at IS NULL;
+
+-- notifications of a muted kind are not created for the user
+CREATE TABLE notification_mutes
+(
+    user_name varchar(255) NOT NULL,
+    kind varchar(32) NOT NULL,
+
+    PRIMARY KEY (user_name, kind),
+
+    CONSTRAINT notification_mutes_fk_user_name
+        FOREIGN KEY (user_name)
+            REFERENCES users,
+
+    CONSTRAINT notification_mutes_kind_valid
+        CHECK (kind IN ('item_on_sale', 'item_restocked', 'coins_received', 'order_ready'))
+);
@@ -0,0 +1,35 @@
+-- email_settings are created on the first change, a user without them gets english emails
+CREATE TABLE email_settings
+(
+    user_name varchar(255) PRIMARY KEY,
+    enabled boolean NOT NULL DEFAULT true,
+    language varchar(2) NOT NULL DEFAULT 'en',
+
+    CONSTRAINT email_settings_fk_user_name
+        FOREIGN KEY (user_name)
+            REFERENCES users,
+
+    CONSTRAINT email_settings_language_valid CHECK (language IN ('en', 'ru'))
+);
+
+-- email_messages are rendered and queued in the transaction of the notification, the sender retries failed ones
+CREATE TABLE email_messages
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    recipient varchar(255) NOT NULL,
+    kind varchar(32) NOT NULL,
+    subject text NOT NULL,
+    text_body text NOT NULL,
+    html_body text NOT NULL,
+    status varchar(16) NOT NULL DEFAULT 'pending',
+    attempts int NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    last_error text,
+    sent_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT email_messages_status_valid CHECK (status IN ('pending', 'sent', 'dead'))
+);
+
+CREATE INDEX email_messages_due
+    ON email_messages (next_attempt_at) WHERE status = 'pending';
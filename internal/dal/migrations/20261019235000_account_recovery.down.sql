@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS auth_tokens;
+
+DROP TABLE IF EXISTS email_verifications;
@@ -0,0 +1,32 @@
+-- a user is verified once a token sent to the email of the user name was used
+CREATE TABLE email_verifications
+(
+    user_name varchar(255) PRIMARY KEY,
+    verified_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT email_verifications_fk_user_name
+        FOREIGN KEY (user_name)
+            REFERENCES users
+);
+
+-- auth_tokens are single-use tokens sent by email, only their hmac is stored
+CREATE TABLE auth_tokens
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_name varchar(255) NOT NULL,
+    purpose varchar(16) NOT NULL,
+    token_hash bytea NOT NULL,
+    expires_at timestamptz NOT NULL,
+    used_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT auth_tokens_fk_user_name
+        FOREIGN KEY (user_name)
+            REFERENCES users,
+
+    CONSTRAINT auth_tokens_token_hash_unique UNIQUE (token_hash),
+    CONSTRAINT auth_tokens_purpose_valid CHECK (purpose IN ('verify_email', 'reset_password'))
+);
+
+CREATE INDEX auth_tokens_user_name_purpose
+    ON auth_tokens (user_name, purpose, created_at);
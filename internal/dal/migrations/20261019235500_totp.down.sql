@@ -0,0 +1,9 @@
+DELETE FROM auth_tokens WHERE purpose = 'login_challenge';
+
+ALTER TABLE auth_tokens
+    DROP CONSTRAINT IF EXISTS auth_tokens_purpose_valid,
+    ADD CONSTRAINT auth_tokens_purpose_valid CHECK (purpose IN ('verify_email', 'reset_password'));
+
+DROP TABLE IF EXISTS totp_recovery_codes;
+
+DROP TABLE IF EXISTS user_totp;
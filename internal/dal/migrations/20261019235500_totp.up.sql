@@ -0,0 +1,35 @@
+-- user_totp is confirmed once the user entered the first code, until then login does not ask for it
+CREATE TABLE user_totp
+(
+    user_name varchar(255) PRIMARY KEY,
+    secret bytea NOT NULL,
+    confirmed_at timestamptz,
+    -- last_used_step makes every code single-use
+    last_used_step bigint NOT NULL DEFAULT 0,
+    created_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT user_totp_fk_user_name
+        FOREIGN KEY (user_name)
+            REFERENCES users
+);
+
+-- totp_recovery_codes replace a code once each, only their hmac is stored
+CREATE TABLE totp_recovery_codes
+(
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_name varchar(255) NOT NULL,
+    code_hash bytea NOT NULL,
+    used_at timestamptz,
+
+    CONSTRAINT totp_recovery_codes_fk_user_name
+        FOREIGN KEY (user_name)
+            REFERENCES users
+);
+
+CREATE INDEX totp_recovery_codes_user_name
+    ON totp_recovery_codes (user_name);
+
+-- login challenges are auth tokens between the password and the code steps of the login
+ALTER TABLE auth_tokens
+    DROP CONSTRAINT auth_tokens_purpose_valid,
+    ADD CONSTRAINT auth_tokens_purpose_valid CHECK (purpose IN ('verify_email', 'reset_password', 'login_challenge'));
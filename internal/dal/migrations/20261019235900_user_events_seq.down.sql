@@ -0,0 +1,23 @@
+DROP TRIGGER IF EXISTS user_events_sequenced_notify ON user_events;
+
+DROP FUNCTION IF EXISTS user_events_sequenced_notify();
+
+CREATE OR REPLACE FUNCTION user_events_notify() RETURNS trigger AS
+$$
+BEGIN
+    PERFORM pg_notify('user_events', row_to_json(NEW)::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP INDEX IF EXISTS user_events_not_sequenced;
+
+DROP INDEX IF EXISTS user_events_user_name_seq;
+
+CREATE INDEX IF NOT EXISTS user_events_user_name
+    ON user_events (user_name, id);
+
+ALTER TABLE user_events
+    DROP COLUMN IF EXISTS seq;
+
+DROP SEQUENCE IF EXISTS user_events_seq;
@@ -0,0 +1,48 @@
+-- ids of user_events follow the start of inserts, not commits, so a client resuming after an id could miss
+-- an event committed later with a lower id. seq is given to committed events by one sequencer at a time,
+-- so it grows in the order events become readable
+CREATE SEQUENCE user_events_seq;
+
+ALTER TABLE user_events
+    ADD COLUMN seq bigint;
+
+UPDATE user_events
+SET seq = ordered.seq
+FROM (SELECT id, nextval('user_events_seq') AS seq
+      FROM (SELECT id FROM user_events ORDER BY id) AS ids) AS ordered
+WHERE user_events.id = ordered.id;
+
+ALTER TABLE user_events
+    ADD CONSTRAINT user_events_seq_unique UNIQUE (seq);
+
+DROP INDEX user_events_user_name;
+
+CREATE INDEX user_events_user_name_seq
+    ON user_events (user_name, seq);
+
+CREATE INDEX user_events_not_sequenced
+    ON user_events (id) WHERE seq IS NULL;
+
+-- new events wake the sequencer
+CREATE OR REPLACE FUNCTION user_events_notify() RETURNS trigger AS
+$$
+BEGIN
+    PERFORM pg_notify('user_events_added', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- sequenced events wake streams of the user, they read the events themselves
+CREATE FUNCTION user_events_sequenced_notify() RETURNS trigger AS
+$$
+BEGIN
+    PERFORM pg_notify('user_events', NEW.user_name);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER user_events_sequenced_notify
+    AFTER UPDATE OF seq ON user_events
+    FOR EACH ROW
+    WHEN (OLD.seq IS NULL AND NEW.seq IS NOT NULL)
+EXECUTE FUNCTION user_events_sequenced_notify();
@@ -0,0 +1,7 @@
+UPDATE merch
+SET image_url = ''
+WHERE image_url IS NULL;
+
+ALTER TABLE merch
+    ALTER COLUMN image_url SET DEFAULT '',
+    ALTER COLUMN image_url SET NOT NULL;
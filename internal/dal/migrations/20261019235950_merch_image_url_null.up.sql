@@ -0,0 +1,8 @@
+-- nothing serves /static/merch, so items have no image until a real url is set
+ALTER TABLE merch
+    ALTER COLUMN image_url DROP DEFAULT,
+    ALTER COLUMN image_url DROP NOT NULL;
+
+UPDATE merch
+SET image_url = NULL
+WHERE image_url = '' OR image_url LIKE '/static/merch/%';
@@ -4,21 +4,272 @@
 
 package modelsgen
 
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Auction struct {
+	ID               int64
+	MerchItem        string
+	Variant          string
+	StartPrice       int64
+	MinStep          int64
+	ExtensionSeconds int64
+	StartsAt         pgtype.Timestamptz
+	EndsAt           pgtype.Timestamptz
+	Status           string
+	Leader           pgtype.Text
+	LeadingBid       pgtype.Int8
+	CreatedAt        pgtype.Timestamptz
+	ClosedAt         pgtype.Timestamptz
+}
+
+type AuctionBid struct {
+	ID        int64
+	AuctionID int64
+	Bidder    string
+	Amount    int64
+	Status    string
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type AuthToken struct {
+	ID        int64
+	UserName  string
+	Purpose   string
+	TokenHash []byte
+	ExpiresAt pgtype.Timestamptz
+	UsedAt    pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
 type CoinTransfer struct {
 	Sender    string
 	Recipient string
 	Amount    int64
+	ID        int64
+	CreatedAt pgtype.Timestamptz
+}
+
+type EmailMessage struct {
+	ID            int64
+	Recipient     string
+	Kind          string
+	Subject       string
+	TextBody      string
+	HtmlBody      string
+	Status        string
+	Attempts      int32
+	NextAttemptAt pgtype.Timestamptz
+	LastError     pgtype.Text
+	SentAt        pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+}
+
+type EmailSetting struct {
+	UserName string
+	Enabled  bool
+	Language string
+}
+
+type EmailVerification struct {
+	UserName   string
+	VerifiedAt pgtype.Timestamptz
+}
+
+type MarketListing struct {
+	ID        int64
+	Seller    string
+	MerchItem string
+	Variant   string
+	Quantity  int64
+	Price     int64
+	Status    string
+	CreatedAt pgtype.Timestamptz
+	ClosedAt  pgtype.Timestamptz
+}
+
+type MarketSale struct {
+	ID        int64
+	ListingID int64
+	Buyer     string
+	Quantity  int64
+	Amount    int64
+	Fee       int64
+	CreatedAt pgtype.Timestamptz
 }
 
 type Merch struct {
-	Slug  string
-	Price int64
+	Slug          string
+	Price         int64
+	Category      pgtype.Text
+	NameRu        string
+	NameEn        string
+	DescriptionRu string
+	DescriptionEn string
+	ImageUrl      pgtype.Text
+}
+
+type MerchCategory struct {
+	Slug   string
+	NameRu string
+	NameEn string
+}
+
+type MerchBundle struct {
+	Slug     string
+	Price    int64
+	StartsAt pgtype.Timestamptz
+	EndsAt   pgtype.Timestamptz
+}
+
+type MerchBundleItem struct {
+	Bundle    string
+	MerchItem string
+	Quantity  int64
+}
+
+type MerchGift struct {
+	ID        int64
+	Sender    string
+	Recipient string
+	MerchItem string
+	Price     int64
+	Message   string
+	CreatedAt pgtype.Timestamptz
+	Variant   string
+	Discount  int64
+}
+
+type MerchOrder struct {
+	ID         int64
+	UserName   string
+	Payer      string
+	MerchItem  string
+	Variant    string
+	Price      int64
+	PurchaseID pgtype.Int8
+	GiftID     pgtype.Int8
+	Status     string
+	PickupCode string
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+	UpdatedBy  pgtype.Text
 }
 
 type MerchOwnership struct {
 	UserName  string
 	MerchItem string
 	Quantity  int64
+	Variant   string
+}
+
+type MerchPurchase struct {
+	ID        int64
+	UserName  string
+	MerchItem string
+	Price     int64
+	CreatedAt pgtype.Timestamptz
+	Variant   string
+	Discount  int64
+	Bundle    pgtype.Text
+}
+
+type MerchRefund struct {
+	ID          int64
+	PurchaseID  int64
+	Status      string
+	RequestedAt pgtype.Timestamptz
+	ResolvedAt  pgtype.Timestamptz
+	ResolvedBy  pgtype.Text
+}
+
+type MerchVariant struct {
+	MerchItem  string
+	Variant    string
+	Attributes VariantAttributes
+	Price      pgtype.Int8
+	Stock      pgtype.Int8
+}
+
+type Notification struct {
+	ID        int64
+	UserName  string
+	Kind      string
+	MerchItem pgtype.Text
+	Message   string
+	CreatedAt pgtype.Timestamptz
+	ReadAt    pgtype.Timestamptz
+}
+
+type NotificationMute struct {
+	UserName string
+	Kind     string
+}
+
+type OutboxEvent struct {
+	ID                   int64
+	EventType            string
+	Payload              []byte
+	CreatedAt            pgtype.Timestamptz
+	WebhooksDispatchedAt pgtype.Timestamptz
+	BusPublishedAt       pgtype.Timestamptz
+}
+
+type Promotion struct {
+	ID                    int64
+	Code                  pgtype.Text
+	MerchItem             pgtype.Text
+	PercentOff            int32
+	AmountOff             int64
+	Stackable             bool
+	StartsAt              pgtype.Timestamptz
+	EndsAt                pgtype.Timestamptz
+	MaxRedemptions        pgtype.Int8
+	MaxRedemptionsPerUser pgtype.Int8
+	CreatedAt             pgtype.Timestamptz
+}
+
+type PromotionRedemption struct {
+	ID          int64
+	PromotionID int64
+	UserName    string
+	Discount    int64
+	CreatedAt   pgtype.Timestamptz
+}
+
+type Raffle struct {
+	ID                int64
+	MerchItem         string
+	Variant           string
+	TicketPrice       int64
+	Winners           int64
+	MaxTicketsPerUser pgtype.Int8
+	SalesEndAt        pgtype.Timestamptz
+	SeedHash          string
+	Seed              string
+	Status            string
+	CreatedAt         pgtype.Timestamptz
+	CreatedBy         string
+	DrawnAt           pgtype.Timestamptz
+	DrawnBy           pgtype.Text
+}
+
+type RaffleTicket struct {
+	ID        int64
+	RaffleID  int64
+	UserName  string
+	Price     int64
+	Won       bool
+	CreatedAt pgtype.Timestamptz
+}
+
+type TotpRecoveryCode struct {
+	ID       int64
+	UserName string
+	CodeHash []byte
+	UsedAt   pgtype.Timestamptz
 }
 
 type User struct {
@@ -26,3 +277,49 @@ type User struct {
 	Password string
 	Coins    int64
 }
+
+type UserEvent struct {
+	ID        int64
+	UserName  string
+	Kind      string
+	Payload   []byte
+	CreatedAt pgtype.Timestamptz
+	Seq       pgtype.Int8
+}
+
+type UserTotp struct {
+	UserName     string
+	Secret       []byte
+	ConfirmedAt  pgtype.Timestamptz
+	LastUsedStep int64
+	CreatedAt    pgtype.Timestamptz
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventID        int64
+	Status         string
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	LastStatusCode pgtype.Int4
+	LastError      pgtype.Text
+	DeliveredAt    pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+}
+
+type WebhookSubscription struct {
+	ID         int64
+	Url        string
+	Secret     string
+	EventTypes []string
+	CreatedAt  pgtype.Timestamptz
+	CreatedBy  string
+}
+
+type Wishlist struct {
+	UserName  string
+	MerchItem string
+	Variant   string
+	CreatedAt pgtype.Timestamptz
+}
@@ -5,8 +5,12 @@ import (
 	"encoding/json"
 )
 
+// VariantAttributes describes merch variant, e.g. {"size": "XL", "color": "black"}
+type VariantAttributes map[string]string
+
 type UserInventoryItem struct {
 	Type     string `json:"type"`
+	Variant  string `json:"variant,omitempty"`
 	Quantity int64  `json:"quantity"`
 }
 
@@ -18,7 +22,7 @@ func (u *UserInventoryItem) UnmarshalJSON(b []byte) error {
 		return err
 	}
 
-	var merchType string
+	var merchType, merchVariant string
 	var merchQuantity int64
 	var err error
 
@@ -28,11 +32,15 @@ func (u *UserInventoryItem) UnmarshalJSON(b []byte) error {
 	if merchQuantity, err = GetInt64Token(dec); err != nil {
 		return err
 	}
+	if merchVariant, err = GetStringToken(dec); err != nil {
+		return err
+	}
 	if err = CheckLastArrayToken(dec); err != nil {
 		return err
 	}
 
 	u.Type = merchType
+	u.Variant = merchVariant
 	u.Quantity = merchQuantity
 	return nil
 }
@@ -99,14 +107,90 @@ func (s *Sent) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+type ReceivedGift struct {
+	FromUser string `json:"fromUser"`
+	Item     string `json:"item"`
+	Message  string `json:"message,omitempty"`
+}
+
+func (r *ReceivedGift) UnmarshalJSON(b []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+
+	if err := SkipFirstArrayToken(dec); err != nil {
+		return err
+	}
+
+	var fromUser, item, message string
+	var err error
+
+	if fromUser, err = GetStringToken(dec); err != nil {
+		return err
+	}
+	if item, err = GetStringToken(dec); err != nil {
+		return err
+	}
+	if message, err = GetStringToken(dec); err != nil {
+		return err
+	}
+	if err = CheckLastArrayToken(dec); err != nil {
+		return err
+	}
+
+	r.FromUser = fromUser
+	r.Item = item
+	r.Message = message
+	return nil
+}
+
+type SentGift struct {
+	ToUser  string `json:"toUser"`
+	Item    string `json:"item"`
+	Message string `json:"message,omitempty"`
+}
+
+func (s *SentGift) UnmarshalJSON(b []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+
+	if err := SkipFirstArrayToken(dec); err != nil {
+		return err
+	}
+
+	var toUser, item, message string
+	var err error
+
+	if toUser, err = GetStringToken(dec); err != nil {
+		return err
+	}
+	if item, err = GetStringToken(dec); err != nil {
+		return err
+	}
+	if message, err = GetStringToken(dec); err != nil {
+		return err
+	}
+	if err = CheckLastArrayToken(dec); err != nil {
+		return err
+	}
+
+	s.ToUser = toUser
+	s.Item = item
+	s.Message = message
+	return nil
+}
+
 type CoinHistory struct {
 	Received []Receive `json:"received"`
 	Sent     []Sent    `json:"sent"`
 }
 
+type GiftHistory struct {
+	Received []ReceivedGift `json:"received"`
+	Sent     []SentGift     `json:"sent"`
+}
+
 type UserInfo struct {
 	UserName          string              `json:"-"`
 	Coins             int64               `json:"coins"`
 	FullUserInventory []UserInventoryItem `json:"inventory"`
 	CoinHistory       CoinHistory         `json:"coinHistory"`
+	GiftHistory       GiftHistory         `json:"giftHistory"`
 }
@@ -7,187 +7,3748 @@ package modelsgen
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addAuthToken = `-- name: AddAuthToken :exec
+INSERT INTO auth_tokens (user_name, purpose, token_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+`
+
+type AddAuthTokenParams struct {
+	UserName  string
+	Purpose   string
+	TokenHash []byte
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) AddAuthToken(ctx context.Context, arg AddAuthTokenParams) error {
+	_, err := q.db.Exec(ctx, addAuthToken,
+		arg.UserName,
+		arg.Purpose,
+		arg.TokenHash,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const addEmailMessage = `-- name: AddEmailMessage :exec
+INSERT INTO email_messages (recipient, kind, subject, text_body, html_body)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type AddEmailMessageParams struct {
+	Recipient string
+	Kind      string
+	Subject   string
+	TextBody  string
+	HtmlBody  string
+}
+
+func (q *Queries) AddEmailMessage(ctx context.Context, arg AddEmailMessageParams) error {
+	_, err := q.db.Exec(ctx, addEmailMessage,
+		arg.Recipient,
+		arg.Kind,
+		arg.Subject,
+		arg.TextBody,
+		arg.HtmlBody,
+	)
+	return err
+}
+
 const addMerchItem = `-- name: AddMerchItem :one
-INSERT INTO merch_ownership (user_name, merch_item, quantity)
-VALUES ($1, $2, 1)
-ON CONFLICT (user_name, merch_item) DO UPDATE
+INSERT INTO merch_ownership (user_name, merch_item, variant, quantity)
+VALUES ($1, $2, $3, 1)
+ON CONFLICT (user_name, merch_item, variant) DO UPDATE
     SET quantity = merch_ownership.quantity + 1
-RETURNING user_name, merch_item, quantity
+RETURNING user_name, merch_item, quantity, variant
 `
 
 type AddMerchItemParams struct {
 	UserName  string
 	MerchItem string
+	Variant   string
 }
 
 func (q *Queries) AddMerchItem(ctx context.Context, arg AddMerchItemParams) (MerchOwnership, error) {
-	row := q.db.QueryRow(ctx, addMerchItem, arg.UserName, arg.MerchItem)
+	row := q.db.QueryRow(ctx, addMerchItem, arg.UserName, arg.MerchItem, arg.Variant)
 	var i MerchOwnership
-	err := row.Scan(&i.UserName, &i.MerchItem, &i.Quantity)
+	err := row.Scan(
+		&i.UserName,
+		&i.MerchItem,
+		&i.Quantity,
+		&i.Variant,
+	)
 	return i, err
 }
 
-const createTransfer = `-- name: CreateTransfer :one
-INSERT INTO coin_transfers (sender, recipient, amount)
-VALUES ($1, $2, $3)
-RETURNING sender, recipient, amount
+const addMerchItems = `-- name: AddMerchItems :one
+INSERT INTO merch_ownership (user_name, merch_item, variant, quantity)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_name, merch_item, variant) DO UPDATE
+    SET quantity = merch_ownership.quantity + EXCLUDED.quantity
+RETURNING user_name, merch_item, quantity, variant
 `
 
-type CreateTransferParams struct {
-	Sender    string
-	Recipient string
-	Amount    int64
+type AddMerchItemsParams struct {
+	UserName  string
+	MerchItem string
+	Variant   string
+	Quantity  int64
 }
 
-func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (CoinTransfer, error) {
-	row := q.db.QueryRow(ctx, createTransfer, arg.Sender, arg.Recipient, arg.Amount)
-	var i CoinTransfer
-	err := row.Scan(&i.Sender, &i.Recipient, &i.Amount)
+func (q *Queries) AddMerchItems(ctx context.Context, arg AddMerchItemsParams) (MerchOwnership, error) {
+	row := q.db.QueryRow(ctx, addMerchItems,
+		arg.UserName,
+		arg.MerchItem,
+		arg.Variant,
+		arg.Quantity,
+	)
+	var i MerchOwnership
+	err := row.Scan(
+		&i.UserName,
+		&i.MerchItem,
+		&i.Quantity,
+		&i.Variant,
+	)
 	return i, err
 }
 
-const createUser = `-- name: CreateUser :one
-INSERT INTO users (name, password, coins)
-VALUES ($1, $2, $3)
-RETURNING name, password, coins
+const addNotification = `-- name: AddNotification :execrows
+INSERT INTO notifications (user_name, kind, merch_item, message)
+SELECT $1::varchar, $2::varchar, $3::varchar, $4::text
+WHERE NOT EXISTS (
+    SELECT 1 FROM notification_mutes m
+    WHERE m.user_name = $1::varchar AND m.kind = $2::varchar
+)
 `
 
-type CreateUserParams struct {
-	Name     string
-	Password string
-	Coins    int64
+type AddNotificationParams struct {
+	UserName  string
+	Kind      string
+	MerchItem pgtype.Text
+	Message   string
 }
 
-func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
-	row := q.db.QueryRow(ctx, createUser, arg.Name, arg.Password, arg.Coins)
-	var i User
-	err := row.Scan(&i.Name, &i.Password, &i.Coins)
+func (q *Queries) AddNotification(ctx context.Context, arg AddNotificationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addNotification,
+		arg.UserName,
+		arg.Kind,
+		arg.MerchItem,
+		arg.Message,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const addNotificationMutes = `-- name: AddNotificationMutes :exec
+INSERT INTO notification_mutes (user_name, kind)
+SELECT $1, unnest($2::varchar[])
+ON CONFLICT DO NOTHING
+`
+
+type AddNotificationMutesParams struct {
+	UserName string
+	Kinds    []string
+}
+
+func (q *Queries) AddNotificationMutes(ctx context.Context, arg AddNotificationMutesParams) error {
+	_, err := q.db.Exec(ctx, addNotificationMutes, arg.UserName, arg.Kinds)
+	return err
+}
+
+const addOutboxEvent = `-- name: AddOutboxEvent :one
+INSERT INTO outbox_events (event_type, payload)
+VALUES ($1, $2)
+RETURNING id, event_type, payload, created_at, webhooks_dispatched_at, bus_published_at
+`
+
+type AddOutboxEventParams struct {
+	EventType string
+	Payload   []byte
+}
+
+func (q *Queries) AddOutboxEvent(ctx context.Context, arg AddOutboxEventParams) (OutboxEvent, error) {
+	row := q.db.QueryRow(ctx, addOutboxEvent, arg.EventType, arg.Payload)
+	var i OutboxEvent
+	err := row.Scan(
+		&i.ID,
+		&i.EventType,
+		&i.Payload,
+		&i.CreatedAt,
+		&i.WebhooksDispatchedAt,
+		&i.BusPublishedAt,
+	)
 	return i, err
 }
 
-const getCompositeUserIndo = `-- name: GetCompositeUserIndo :one
-WITH
-    user_name_inventory AS (
-        SELECT
-            user_name,
-            json_agg(
-                    json_build_array(merch_item, quantity)
-                ) as merch_item_quantity -- key_value json format
-        FROM merch_ownership
-        WHERE user_name = $1
-        GROUP BY user_name
-    ),
+const addTotpRecoveryCodes = `-- name: AddTotpRecoveryCodes :exec
+INSERT INTO totp_recovery_codes (user_name, code_hash)
+SELECT $1::varchar, unnest($2::bytea[])
+`
 
-    -- received
-    user_name_recipient AS (
-        SELECT
-            recipient as user_name,
-            json_agg(
-                    json_build_array(sender, amount)
-                ) as sender_amount -- key_value json format
-        FROM coin_transfers
-        WHERE recipient = $1
-        GROUP BY recipient
-    ),
-    -- sent
-    user_name_sender AS (
-        SELECT
-            sender as user_name,
-            json_agg(
-                    json_build_array(recipient, amount)
-                ) as recipient_amount -- key_value json format
-        FROM coin_transfers
-        WHERE sender = $1
-        GROUP BY sender
-    )
+type AddTotpRecoveryCodesParams struct {
+	UserName   string
+	CodeHashes [][]byte
+}
 
-SELECT
-    name,
-    coins,
-    merch_item_quantity as inventory,
-    recipient_amount as sent,
-    sender_amount as recived
-FROM users
-         LEFT JOIN user_name_inventory
-                   ON users.name = user_name_inventory.user_name
-         LEFT JOIN user_name_sender
-                   ON users.name = user_name_sender.user_name
-         LEFT JOIN user_name_recipient
-                   ON users.name = user_name_recipient.user_name
-WHERE name = $1
+func (q *Queries) AddTotpRecoveryCodes(ctx context.Context, arg AddTotpRecoveryCodesParams) error {
+	_, err := q.db.Exec(ctx, addTotpRecoveryCodes, arg.UserName, arg.CodeHashes)
+	return err
+}
+
+const addWishlistItem = `-- name: AddWishlistItem :one
+INSERT INTO wishlists (user_name, merch_item, variant)
+VALUES ($1, $2, $3)
+RETURNING *
 `
 
-type GetCompositeUserIndoRow struct {
-	Name      string
-	Coins     int64
-	Inventory []UserInventoryItem
-	Sent      []Sent
-	Recived   []Receive
+type AddWishlistItemParams struct {
+	UserName  string
+	MerchItem string
+	Variant   string
 }
 
-func (q *Queries) GetCompositeUserIndo(ctx context.Context, ftUserName string) (GetCompositeUserIndoRow, error) {
-	row := q.db.QueryRow(ctx, getCompositeUserIndo, ftUserName)
-	var i GetCompositeUserIndoRow
+func (q *Queries) AddWishlistItem(ctx context.Context, arg AddWishlistItemParams) (Wishlist, error) {
+	row := q.db.QueryRow(ctx, addWishlistItem, arg.UserName, arg.MerchItem, arg.Variant)
+	var i Wishlist
 	err := row.Scan(
-		&i.Name,
-		&i.Coins,
-		&i.Inventory,
-		&i.Sent,
-		&i.Recived,
+		&i.UserName,
+		&i.MerchItem,
+		&i.Variant,
+		&i.CreatedAt,
 	)
 	return i, err
 }
 
-const getUserByName = `-- name: GetUserByName :one
-SELECT name, password, coins FROM users
-WHERE name = $1
+const cancelListing = `-- name: CancelListing :one
+UPDATE market_listings
+SET
+    quantity = 0,
+    status = 'cancelled',
+    closed_at = now()
+WHERE id = $1 AND status = 'active'
+RETURNING id, seller, merch_item, variant, quantity, price, status, created_at, closed_at
 `
 
-func (q *Queries) GetUserByName(ctx context.Context, name string) (User, error) {
-	row := q.db.QueryRow(ctx, getUserByName, name)
-	var i User
-	err := row.Scan(&i.Name, &i.Password, &i.Coins)
+func (q *Queries) CancelListing(ctx context.Context, id int64) (MarketListing, error) {
+	row := q.db.QueryRow(ctx, cancelListing, id)
+	var i MarketListing
+	err := row.Scan(
+		&i.ID,
+		&i.Seller,
+		&i.MerchItem,
+		&i.Variant,
+		&i.Quantity,
+		&i.Price,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
 	return i, err
 }
 
-const minusUserCoins = `-- name: MinusUserCoins :one
-UPDATE users
+const cancelPurchaseOrder = `-- name: CancelPurchaseOrder :exec
+UPDATE merch_orders
 SET
-    coins = coins - $1
-WHERE name = $2
-RETURNING name, password, coins
+    status = 'cancelled',
+    updated_at = now(),
+    updated_by = $1
+WHERE purchase_id = $2::bigint AND status IN ('placed', 'ready')
 `
 
-type MinusUserCoinsParams struct {
-	Amount int64
-	Name   string
+type CancelPurchaseOrderParams struct {
+	UpdatedBy  pgtype.Text
+	PurchaseID int64
 }
 
-func (q *Queries) MinusUserCoins(ctx context.Context, arg MinusUserCoinsParams) (User, error) {
-	row := q.db.QueryRow(ctx, minusUserCoins, arg.Amount, arg.Name)
-	var i User
-	err := row.Scan(&i.Name, &i.Password, &i.Coins)
+func (q *Queries) CancelPurchaseOrder(ctx context.Context, arg CancelPurchaseOrderParams) error {
+	_, err := q.db.Exec(ctx, cancelPurchaseOrder, arg.UpdatedBy, arg.PurchaseID)
+	return err
+}
+
+const claimDueEmailMessages = `-- name: ClaimDueEmailMessages :many
+UPDATE email_messages
+SET
+    next_attempt_at = $1
+WHERE email_messages.id IN (
+    SELECT id FROM email_messages
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, recipient, kind, subject, text_body, html_body, status, attempts, next_attempt_at, last_error, sent_at, created_at
+`
+
+type ClaimDueEmailMessagesParams struct {
+	LeaseUntil pgtype.Timestamptz
+	BatchSize  int32
+}
+
+func (q *Queries) ClaimDueEmailMessages(ctx context.Context, arg ClaimDueEmailMessagesParams) ([]EmailMessage, error) {
+	rows, err := q.db.Query(ctx, claimDueEmailMessages, arg.LeaseUntil, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EmailMessage
+	for rows.Next() {
+		var i EmailMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.Recipient,
+			&i.Kind,
+			&i.Subject,
+			&i.TextBody,
+			&i.HtmlBody,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.SentAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimDueWebhookDeliveries = `-- name: ClaimDueWebhookDeliveries :many
+WITH claimed AS (
+    UPDATE webhook_deliveries
+    SET
+        next_attempt_at = $1
+    WHERE webhook_deliveries.id IN (
+        SELECT id FROM webhook_deliveries
+        WHERE status = 'pending' AND next_attempt_at <= now()
+        ORDER BY next_attempt_at
+        LIMIT $2
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING webhook_deliveries.id, webhook_deliveries.subscription_id, webhook_deliveries.event_id, webhook_deliveries.status, webhook_deliveries.attempts, webhook_deliveries.next_attempt_at, webhook_deliveries.last_status_code, webhook_deliveries.last_error, webhook_deliveries.delivered_at, webhook_deliveries.created_at
+)
+SELECT claimed.id, claimed.attempts, s.url, s.secret, e.id AS event_id, e.event_type, e.payload, e.created_at AS event_created_at
+FROM claimed
+JOIN webhook_subscriptions s ON s.id = claimed.subscription_id
+JOIN outbox_events e ON e.id = claimed.event_id
+ORDER BY claimed.id
+`
+
+type ClaimDueWebhookDeliveriesRow struct {
+	ID             int64
+	Attempts       int32
+	Url            string
+	Secret         string
+	EventID        int64
+	EventType      string
+	Payload        []byte
+	EventCreatedAt pgtype.Timestamptz
+}
+
+type ClaimDueWebhookDeliveriesParams struct {
+	LeaseUntil pgtype.Timestamptz
+	BatchSize  int32
+}
+
+func (q *Queries) ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, claimDueWebhookDeliveries, arg.LeaseUntil, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimDueWebhookDeliveriesRow
+	for rows.Next() {
+		var i ClaimDueWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.EventCreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const closeAuction = `-- name: CloseAuction :one
+UPDATE auctions
+SET
+    status = 'closed',
+    closed_at = now()
+WHERE id = $1 AND status = 'open'
+RETURNING *
+`
+
+func (q *Queries) CloseAuction(ctx context.Context, id int64) (Auction, error) {
+	row := q.db.QueryRow(ctx, closeAuction, id)
+	var i Auction
+	err := row.Scan(
+		&i.ID,
+		&i.MerchItem,
+		&i.Variant,
+		&i.StartPrice,
+		&i.MinStep,
+		&i.ExtensionSeconds,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Status,
+		&i.Leader,
+		&i.LeadingBid,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
 	return i, err
 }
 
-const plusUserCoins = `-- name: PlusUserCoins :one
-UPDATE users
+const confirmUserTotp = `-- name: ConfirmUserTotp :execrows
+UPDATE user_totp
 SET
-    coins = coins + $1
-WHERE name = $2
-RETURNING name, password, coins
+    confirmed_at = now(),
+    last_used_step = $1
+WHERE user_name = $2 AND confirmed_at IS NULL AND last_used_step < $1
 `
 
-type PlusUserCoinsParams struct {
-	Amount int64
-	Name   string
+type ConfirmUserTotpParams struct {
+	Step     int64
+	UserName string
 }
 
-func (q *Queries) PlusUserCoins(ctx context.Context, arg PlusUserCoinsParams) (User, error) {
-	row := q.db.QueryRow(ctx, plusUserCoins, arg.Amount, arg.Name)
-	var i User
-	err := row.Scan(&i.Name, &i.Password, &i.Coins)
+func (q *Queries) ConfirmUserTotp(ctx context.Context, arg ConfirmUserTotpParams) (int64, error) {
+	result, err := q.db.Exec(ctx, confirmUserTotp, arg.Step, arg.UserName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countAuthTokensSince = `-- name: CountAuthTokensSince :one
+SELECT count(*) FROM auth_tokens
+WHERE user_name = $1 AND purpose = $2 AND created_at > $3
+`
+
+type CountAuthTokensSinceParams struct {
+	UserName string
+	Purpose  string
+	Since    pgtype.Timestamptz
+}
+
+func (q *Queries) CountAuthTokensSince(ctx context.Context, arg CountAuthTokensSinceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countAuthTokensSince, arg.UserName, arg.Purpose, arg.Since)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countMerch = `-- name: CountMerch :one
+SELECT count(*) FROM merch
+WHERE ($1::varchar IS NULL OR category = $1::varchar)
+  AND ($2::bigint IS NULL OR price >= $2::bigint)
+  AND ($3::bigint IS NULL OR price <= $3::bigint)
+  AND ($4::varchar = ''
+    OR slug ILIKE '%' || $4::varchar || '%'
+    OR name_ru ILIKE '%' || $4::varchar || '%'
+    OR name_en ILIKE '%' || $4::varchar || '%'
+    OR description_ru ILIKE '%' || $4::varchar || '%'
+    OR description_en ILIKE '%' || $4::varchar || '%')
+`
+
+type CountMerchParams struct {
+	Category pgtype.Text
+	MinPrice pgtype.Int8
+	MaxPrice pgtype.Int8
+	Search   string
+}
+
+func (q *Queries) CountMerch(ctx context.Context, arg CountMerchParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countMerch,
+		arg.Category,
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.Search,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPromotionRedemptions = `-- name: CountPromotionRedemptions :one
+SELECT
+    count(*) as total,
+    count(*) FILTER (WHERE user_name = $1) as by_user
+FROM promotion_redemptions
+WHERE promotion_id = $2
+`
+
+type CountPromotionRedemptionsRow struct {
+	Total  int64
+	ByUser int64
+}
+
+type CountPromotionRedemptionsParams struct {
+	UserName    string
+	PromotionID int64
+}
+
+func (q *Queries) CountPromotionRedemptions(ctx context.Context, arg CountPromotionRedemptionsParams) (CountPromotionRedemptionsRow, error) {
+	row := q.db.QueryRow(ctx, countPromotionRedemptions, arg.UserName, arg.PromotionID)
+	var i CountPromotionRedemptionsRow
+	err := row.Scan(&i.Total, &i.ByUser)
+	return i, err
+}
+
+const countTotpRecoveryCodes = `-- name: CountTotpRecoveryCodes :one
+SELECT count(*) FROM totp_recovery_codes
+WHERE user_name = $1 AND used_at IS NULL
+`
+
+func (q *Queries) CountTotpRecoveryCodes(ctx context.Context, userName string) (int64, error) {
+	row := q.db.QueryRow(ctx, countTotpRecoveryCodes, userName)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUserNotifications = `-- name: CountUserNotifications :one
+SELECT
+    count(*) FILTER (WHERE NOT $1::boolean OR read_at IS NULL) AS total,
+    count(*) FILTER (WHERE read_at IS NULL) AS unread
+FROM notifications
+WHERE user_name = $2
+`
+
+type CountUserNotificationsRow struct {
+	Total  int64
+	Unread int64
+}
+
+type CountUserNotificationsParams struct {
+	UnreadOnly bool
+	UserName   string
+}
+
+func (q *Queries) CountUserNotifications(ctx context.Context, arg CountUserNotificationsParams) (CountUserNotificationsRow, error) {
+	row := q.db.QueryRow(ctx, countUserNotifications, arg.UnreadOnly, arg.UserName)
+	var i CountUserNotificationsRow
+	err := row.Scan(&i.Total, &i.Unread)
+	return i, err
+}
+
+const countUserRaffleTickets = `-- name: CountUserRaffleTickets :one
+SELECT count(*) FROM raffle_tickets
+WHERE raffle_id = $1 AND user_name = $2
+`
+
+type CountUserRaffleTicketsParams struct {
+	RaffleID int64
+	UserName string
+}
+
+func (q *Queries) CountUserRaffleTickets(ctx context.Context, arg CountUserRaffleTicketsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserRaffleTickets, arg.RaffleID, arg.UserName)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUserTransfers = `-- name: CountUserTransfers :one
+SELECT count(*) FROM coin_transfers
+WHERE sender = $1 OR recipient = $1
+`
+
+func (q *Queries) CountUserTransfers(ctx context.Context, userName string) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserTransfers, userName)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAuction = `-- name: CreateAuction :one
+INSERT INTO auctions (merch_item, variant, start_price, min_step, extension_seconds, starts_at, ends_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *
+`
+
+type CreateAuctionParams struct {
+	MerchItem        string
+	Variant          string
+	StartPrice       int64
+	MinStep          int64
+	ExtensionSeconds int64
+	StartsAt         pgtype.Timestamptz
+	EndsAt           pgtype.Timestamptz
+}
+
+func (q *Queries) CreateAuction(ctx context.Context, arg CreateAuctionParams) (Auction, error) {
+	row := q.db.QueryRow(ctx, createAuction,
+		arg.MerchItem,
+		arg.Variant,
+		arg.StartPrice,
+		arg.MinStep,
+		arg.ExtensionSeconds,
+		arg.StartsAt,
+		arg.EndsAt,
+	)
+	var i Auction
+	err := row.Scan(
+		&i.ID,
+		&i.MerchItem,
+		&i.Variant,
+		&i.StartPrice,
+		&i.MinStep,
+		&i.ExtensionSeconds,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Status,
+		&i.Leader,
+		&i.LeadingBid,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const createAuctionBid = `-- name: CreateAuctionBid :one
+INSERT INTO auction_bids (auction_id, bidder, amount)
+VALUES ($1, $2, $3)
+RETURNING *
+`
+
+type CreateAuctionBidParams struct {
+	AuctionID int64
+	Bidder    string
+	Amount    int64
+}
+
+func (q *Queries) CreateAuctionBid(ctx context.Context, arg CreateAuctionBidParams) (AuctionBid, error) {
+	row := q.db.QueryRow(ctx, createAuctionBid, arg.AuctionID, arg.Bidder, arg.Amount)
+	var i AuctionBid
+	err := row.Scan(
+		&i.ID,
+		&i.AuctionID,
+		&i.Bidder,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
 	return i, err
 }
+
+const createBundle = `-- name: CreateBundle :one
+INSERT INTO merch_bundles (slug, price, starts_at, ends_at)
+VALUES ($1, $2, $3, $4)
+RETURNING slug, price, starts_at, ends_at
+`
+
+type CreateBundleParams struct {
+	Slug     string
+	Price    int64
+	StartsAt pgtype.Timestamptz
+	EndsAt   pgtype.Timestamptz
+}
+
+func (q *Queries) CreateBundle(ctx context.Context, arg CreateBundleParams) (MerchBundle, error) {
+	row := q.db.QueryRow(ctx, createBundle,
+		arg.Slug,
+		arg.Price,
+		arg.StartsAt,
+		arg.EndsAt,
+	)
+	var i MerchBundle
+	err := row.Scan(
+		&i.Slug,
+		&i.Price,
+		&i.StartsAt,
+		&i.EndsAt,
+	)
+	return i, err
+}
+
+const createBundleItem = `-- name: CreateBundleItem :one
+INSERT INTO merch_bundle_items (bundle, merch_item, quantity)
+VALUES ($1, $2, $3)
+RETURNING bundle, merch_item, quantity
+`
+
+type CreateBundleItemParams struct {
+	Bundle    string
+	MerchItem string
+	Quantity  int64
+}
+
+func (q *Queries) CreateBundleItem(ctx context.Context, arg CreateBundleItemParams) (MerchBundleItem, error) {
+	row := q.db.QueryRow(ctx, createBundleItem, arg.Bundle, arg.MerchItem, arg.Quantity)
+	var i MerchBundleItem
+	err := row.Scan(&i.Bundle, &i.MerchItem, &i.Quantity)
+	return i, err
+}
+
+const createGift = `-- name: CreateGift :one
+INSERT INTO merch_gifts (sender, recipient, merch_item, variant, price, discount, message)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, sender, recipient, merch_item, price, message, created_at, variant, discount
+`
+
+type CreateGiftParams struct {
+	Sender    string
+	Recipient string
+	MerchItem string
+	Variant   string
+	Price     int64
+	Discount  int64
+	Message   string
+}
+
+func (q *Queries) CreateGift(ctx context.Context, arg CreateGiftParams) (MerchGift, error) {
+	row := q.db.QueryRow(ctx, createGift,
+		arg.Sender,
+		arg.Recipient,
+		arg.MerchItem,
+		arg.Variant,
+		arg.Price,
+		arg.Discount,
+		arg.Message,
+	)
+	var i MerchGift
+	err := row.Scan(
+		&i.ID,
+		&i.Sender,
+		&i.Recipient,
+		&i.MerchItem,
+		&i.Price,
+		&i.Message,
+		&i.CreatedAt,
+		&i.Variant,
+		&i.Discount,
+	)
+	return i, err
+}
+
+const createListing = `-- name: CreateListing :one
+INSERT INTO market_listings (seller, merch_item, variant, quantity, price)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, seller, merch_item, variant, quantity, price, status, created_at, closed_at
+`
+
+type CreateListingParams struct {
+	Seller    string
+	MerchItem string
+	Variant   string
+	Quantity  int64
+	Price     int64
+}
+
+func (q *Queries) CreateListing(ctx context.Context, arg CreateListingParams) (MarketListing, error) {
+	row := q.db.QueryRow(ctx, createListing,
+		arg.Seller,
+		arg.MerchItem,
+		arg.Variant,
+		arg.Quantity,
+		arg.Price,
+	)
+	var i MarketListing
+	err := row.Scan(
+		&i.ID,
+		&i.Seller,
+		&i.MerchItem,
+		&i.Variant,
+		&i.Quantity,
+		&i.Price,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const createMarketSale = `-- name: CreateMarketSale :one
+INSERT INTO market_sales (listing_id, buyer, quantity, amount, fee)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, listing_id, buyer, quantity, amount, fee, created_at
+`
+
+type CreateMarketSaleParams struct {
+	ListingID int64
+	Buyer     string
+	Quantity  int64
+	Amount    int64
+	Fee       int64
+}
+
+func (q *Queries) CreateMarketSale(ctx context.Context, arg CreateMarketSaleParams) (MarketSale, error) {
+	row := q.db.QueryRow(ctx, createMarketSale,
+		arg.ListingID,
+		arg.Buyer,
+		arg.Quantity,
+		arg.Amount,
+		arg.Fee,
+	)
+	var i MarketSale
+	err := row.Scan(
+		&i.ID,
+		&i.ListingID,
+		&i.Buyer,
+		&i.Quantity,
+		&i.Amount,
+		&i.Fee,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createOrder = `-- name: CreateOrder :one
+INSERT INTO merch_orders (user_name, payer, merch_item, variant, price, purchase_id, gift_id, pickup_code)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, user_name, payer, merch_item, variant, price, purchase_id, gift_id, status, pickup_code, created_at, updated_at, updated_by
+`
+
+type CreateOrderParams struct {
+	UserName   string
+	Payer      string
+	MerchItem  string
+	Variant    string
+	Price      int64
+	PurchaseID pgtype.Int8
+	GiftID     pgtype.Int8
+	PickupCode string
+}
+
+func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (MerchOrder, error) {
+	row := q.db.QueryRow(ctx, createOrder,
+		arg.UserName,
+		arg.Payer,
+		arg.MerchItem,
+		arg.Variant,
+		arg.Price,
+		arg.PurchaseID,
+		arg.GiftID,
+		arg.PickupCode,
+	)
+	var i MerchOrder
+	err := row.Scan(
+		&i.ID,
+		&i.UserName,
+		&i.Payer,
+		&i.MerchItem,
+		&i.Variant,
+		&i.Price,
+		&i.PurchaseID,
+		&i.GiftID,
+		&i.Status,
+		&i.PickupCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+	)
+	return i, err
+}
+
+const createPromotion = `-- name: CreatePromotion :one
+INSERT INTO promotions (
+    code, merch_item, percent_off, amount_off, stackable,
+    starts_at, ends_at, max_redemptions, max_redemptions_per_user
+)
+VALUES (
+    $1, $2, $3, $4, $5,
+    $6, $7, $8, $9
+)
+RETURNING id, code, merch_item, percent_off, amount_off, stackable, starts_at, ends_at, max_redemptions, max_redemptions_per_user, created_at
+`
+
+type CreatePromotionParams struct {
+	Code                  pgtype.Text
+	MerchItem             pgtype.Text
+	PercentOff            int32
+	AmountOff             int64
+	Stackable             bool
+	StartsAt              pgtype.Timestamptz
+	EndsAt                pgtype.Timestamptz
+	MaxRedemptions        pgtype.Int8
+	MaxRedemptionsPerUser pgtype.Int8
+}
+
+func (q *Queries) CreatePromotion(ctx context.Context, arg CreatePromotionParams) (Promotion, error) {
+	row := q.db.QueryRow(ctx, createPromotion,
+		arg.Code,
+		arg.MerchItem,
+		arg.PercentOff,
+		arg.AmountOff,
+		arg.Stackable,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.MaxRedemptions,
+		arg.MaxRedemptionsPerUser,
+	)
+	var i Promotion
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.MerchItem,
+		&i.PercentOff,
+		&i.AmountOff,
+		&i.Stackable,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.MaxRedemptions,
+		&i.MaxRedemptionsPerUser,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createPromotionRedemption = `-- name: CreatePromotionRedemption :one
+INSERT INTO promotion_redemptions (promotion_id, user_name, discount)
+VALUES ($1, $2, $3)
+RETURNING id, promotion_id, user_name, discount, created_at
+`
+
+type CreatePromotionRedemptionParams struct {
+	PromotionID int64
+	UserName    string
+	Discount    int64
+}
+
+func (q *Queries) CreatePromotionRedemption(ctx context.Context, arg CreatePromotionRedemptionParams) (PromotionRedemption, error) {
+	row := q.db.QueryRow(ctx, createPromotionRedemption, arg.PromotionID, arg.UserName, arg.Discount)
+	var i PromotionRedemption
+	err := row.Scan(
+		&i.ID,
+		&i.PromotionID,
+		&i.UserName,
+		&i.Discount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createPurchase = `-- name: CreatePurchase :one
+INSERT INTO merch_purchases (user_name, merch_item, variant, price, discount, bundle)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, user_name, merch_item, price, created_at, variant, discount, bundle
+`
+
+type CreatePurchaseParams struct {
+	UserName  string
+	MerchItem string
+	Variant   string
+	Price     int64
+	Discount  int64
+	Bundle    pgtype.Text
+}
+
+func (q *Queries) CreatePurchase(ctx context.Context, arg CreatePurchaseParams) (MerchPurchase, error) {
+	row := q.db.QueryRow(ctx, createPurchase,
+		arg.UserName,
+		arg.MerchItem,
+		arg.Variant,
+		arg.Price,
+		arg.Discount,
+		arg.Bundle,
+	)
+	var i MerchPurchase
+	err := row.Scan(
+		&i.ID,
+		&i.UserName,
+		&i.MerchItem,
+		&i.Price,
+		&i.CreatedAt,
+		&i.Variant,
+		&i.Discount,
+		&i.Bundle,
+	)
+	return i, err
+}
+
+const createRaffle = `-- name: CreateRaffle :one
+INSERT INTO raffles (merch_item, variant, ticket_price, winners, max_tickets_per_user, sales_end_at, seed_hash, seed, created_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *
+`
+
+type CreateRaffleParams struct {
+	MerchItem         string
+	Variant           string
+	TicketPrice       int64
+	Winners           int64
+	MaxTicketsPerUser pgtype.Int8
+	SalesEndAt        pgtype.Timestamptz
+	SeedHash          string
+	Seed              string
+	CreatedBy         string
+}
+
+func (q *Queries) CreateRaffle(ctx context.Context, arg CreateRaffleParams) (Raffle, error) {
+	row := q.db.QueryRow(ctx, createRaffle,
+		arg.MerchItem,
+		arg.Variant,
+		arg.TicketPrice,
+		arg.Winners,
+		arg.MaxTicketsPerUser,
+		arg.SalesEndAt,
+		arg.SeedHash,
+		arg.Seed,
+		arg.CreatedBy,
+	)
+	var i Raffle
+	err := row.Scan(
+		&i.ID,
+		&i.MerchItem,
+		&i.Variant,
+		&i.TicketPrice,
+		&i.Winners,
+		&i.MaxTicketsPerUser,
+		&i.SalesEndAt,
+		&i.SeedHash,
+		&i.Seed,
+		&i.Status,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.DrawnAt,
+		&i.DrawnBy,
+	)
+	return i, err
+}
+
+const createRaffleTicket = `-- name: CreateRaffleTicket :one
+INSERT INTO raffle_tickets (raffle_id, user_name, price)
+VALUES ($1, $2, $3)
+RETURNING *
+`
+
+type CreateRaffleTicketParams struct {
+	RaffleID int64
+	UserName string
+	Price    int64
+}
+
+func (q *Queries) CreateRaffleTicket(ctx context.Context, arg CreateRaffleTicketParams) (RaffleTicket, error) {
+	row := q.db.QueryRow(ctx, createRaffleTicket, arg.RaffleID, arg.UserName, arg.Price)
+	var i RaffleTicket
+	err := row.Scan(
+		&i.ID,
+		&i.RaffleID,
+		&i.UserName,
+		&i.Price,
+		&i.Won,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createRefund = `-- name: CreateRefund :one
+INSERT INTO merch_refunds (purchase_id)
+VALUES ($1)
+RETURNING id, purchase_id, status, requested_at, resolved_at, resolved_by
+`
+
+func (q *Queries) CreateRefund(ctx context.Context, purchaseID int64) (MerchRefund, error) {
+	row := q.db.QueryRow(ctx, createRefund, purchaseID)
+	var i MerchRefund
+	err := row.Scan(
+		&i.ID,
+		&i.PurchaseID,
+		&i.Status,
+		&i.RequestedAt,
+		&i.ResolvedAt,
+		&i.ResolvedBy,
+	)
+	return i, err
+}
+
+const createTransfer = `-- name: CreateTransfer :one
+INSERT INTO coin_transfers (sender, recipient, amount)
+VALUES ($1, $2, $3)
+RETURNING sender, recipient, amount, id, created_at
+`
+
+type CreateTransferParams struct {
+	Sender    string
+	Recipient string
+	Amount    int64
+}
+
+func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (CoinTransfer, error) {
+	row := q.db.QueryRow(ctx, createTransfer, arg.Sender, arg.Recipient, arg.Amount)
+	var i CoinTransfer
+	err := row.Scan(
+		&i.Sender,
+		&i.Recipient,
+		&i.Amount,
+		&i.ID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createUser = `-- name: CreateUser :one
+INSERT INTO users (name, password, coins)
+VALUES ($1, $2, $3)
+RETURNING name, password, coins
+`
+
+type CreateUserParams struct {
+	Name     string
+	Password string
+	Coins    int64
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, createUser, arg.Name, arg.Password, arg.Coins)
+	var i User
+	err := row.Scan(&i.Name, &i.Password, &i.Coins)
+	return i, err
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (url, secret, event_types, created_by)
+VALUES ($1, $2, $3, $4)
+RETURNING id, url, secret, event_types, created_at, created_by
+`
+
+type CreateWebhookSubscriptionParams struct {
+	Url        string
+	Secret     string
+	EventTypes []string
+	CreatedBy  string
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, createWebhookSubscription,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+		arg.CreatedBy,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.CreatedAt,
+		&i.CreatedBy,
+	)
+	return i, err
+}
+
+const deleteNotificationMutes = `-- name: DeleteNotificationMutes :exec
+DELETE FROM notification_mutes
+WHERE user_name = $1
+`
+
+func (q *Queries) DeleteNotificationMutes(ctx context.Context, userName string) error {
+	_, err := q.db.Exec(ctx, deleteNotificationMutes, userName)
+	return err
+}
+
+const deleteTotpRecoveryCodes = `-- name: DeleteTotpRecoveryCodes :exec
+DELETE FROM totp_recovery_codes
+WHERE user_name = $1
+`
+
+func (q *Queries) DeleteTotpRecoveryCodes(ctx context.Context, userName string) error {
+	_, err := q.db.Exec(ctx, deleteTotpRecoveryCodes, userName)
+	return err
+}
+
+const deleteUserEventsBefore = `-- name: DeleteUserEventsBefore :execrows
+DELETE FROM user_events
+WHERE created_at < $1
+`
+
+func (q *Queries) DeleteUserEventsBefore(ctx context.Context, before pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserEventsBefore, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUserTotp = `-- name: DeleteUserTotp :exec
+DELETE FROM user_totp
+WHERE user_name = $1
+`
+
+func (q *Queries) DeleteUserTotp(ctx context.Context, userName string) error {
+	_, err := q.db.Exec(ctx, deleteUserTotp, userName)
+	return err
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1
+`
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhookSubscription, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteWishlistItem = `-- name: DeleteWishlistItem :execrows
+DELETE FROM wishlists
+WHERE user_name = $1 AND merch_item = $2 AND variant = $3
+`
+
+type DeleteWishlistItemParams struct {
+	UserName  string
+	MerchItem string
+	Variant   string
+}
+
+func (q *Queries) DeleteWishlistItem(ctx context.Context, arg DeleteWishlistItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWishlistItem, arg.UserName, arg.MerchItem, arg.Variant)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const dispatchOutboxEventsToWebhooks = `-- name: DispatchOutboxEventsToWebhooks :execrows
+WITH due AS (
+    SELECT id, event_type FROM outbox_events
+    WHERE webhooks_dispatched_at IS NULL
+    ORDER BY id
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+), deliveries AS (
+    INSERT INTO webhook_deliveries (subscription_id, event_id)
+    SELECT s.id, due.id
+    FROM due
+    JOIN webhook_subscriptions s ON due.event_type = ANY (s.event_types)
+    ON CONFLICT DO NOTHING
+)
+UPDATE outbox_events
+SET
+    webhooks_dispatched_at = now()
+WHERE id IN (SELECT id FROM due)
+`
+
+func (q *Queries) DispatchOutboxEventsToWebhooks(ctx context.Context, batchSize int32) (int64, error) {
+	result, err := q.db.Exec(ctx, dispatchOutboxEventsToWebhooks, batchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const drawRaffle = `-- name: DrawRaffle :one
+UPDATE raffles
+SET
+    status = 'drawn',
+    drawn_at = now(),
+    drawn_by = $1
+WHERE id = $2 AND status = 'open'
+RETURNING *
+`
+
+type DrawRaffleParams struct {
+	DrawnBy pgtype.Text
+	ID      int64
+}
+
+func (q *Queries) DrawRaffle(ctx context.Context, arg DrawRaffleParams) (Raffle, error) {
+	row := q.db.QueryRow(ctx, drawRaffle, arg.DrawnBy, arg.ID)
+	var i Raffle
+	err := row.Scan(
+		&i.ID,
+		&i.MerchItem,
+		&i.Variant,
+		&i.TicketPrice,
+		&i.Winners,
+		&i.MaxTicketsPerUser,
+		&i.SalesEndAt,
+		&i.SeedHash,
+		&i.Seed,
+		&i.Status,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.DrawnAt,
+		&i.DrawnBy,
+	)
+	return i, err
+}
+
+const getActiveBundleItems = `-- name: GetActiveBundleItems :many
+SELECT
+    b.price,
+    i.merch_item,
+    i.quantity
+FROM merch_bundles b
+         JOIN merch_bundle_items i
+              ON i.bundle = b.slug
+WHERE b.slug = $1 AND b.starts_at <= now() AND b.ends_at > now()
+ORDER BY i.merch_item
+`
+
+type GetActiveBundleItemsRow struct {
+	Price     int64
+	MerchItem string
+	Quantity  int64
+}
+
+func (q *Queries) GetActiveBundleItems(ctx context.Context, slug string) ([]GetActiveBundleItemsRow, error) {
+	rows, err := q.db.Query(ctx, getActiveBundleItems, slug)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetActiveBundleItemsRow
+	for rows.Next() {
+		var i GetActiveBundleItemsRow
+		if err := rows.Scan(&i.Price, &i.MerchItem, &i.Quantity); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAuction = `-- name: GetAuction :one
+SELECT * FROM auctions
+WHERE id = $1
+`
+
+func (q *Queries) GetAuction(ctx context.Context, id int64) (Auction, error) {
+	row := q.db.QueryRow(ctx, getAuction, id)
+	var i Auction
+	err := row.Scan(
+		&i.ID,
+		&i.MerchItem,
+		&i.Variant,
+		&i.StartPrice,
+		&i.MinStep,
+		&i.ExtensionSeconds,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Status,
+		&i.Leader,
+		&i.LeadingBid,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const getAuctionForUpdate = `-- name: GetAuctionForUpdate :one
+SELECT * FROM auctions
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetAuctionForUpdate(ctx context.Context, id int64) (Auction, error) {
+	row := q.db.QueryRow(ctx, getAuctionForUpdate, id)
+	var i Auction
+	err := row.Scan(
+		&i.ID,
+		&i.MerchItem,
+		&i.Variant,
+		&i.StartPrice,
+		&i.MinStep,
+		&i.ExtensionSeconds,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Status,
+		&i.Leader,
+		&i.LeadingBid,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const getCompositeUserIndo = `-- name: GetCompositeUserIndo :one
+WITH
+    user_name_inventory AS (
+        SELECT
+            user_name,
+            json_agg(
+                    json_build_array(merch_item, quantity, variant)
+                    ORDER BY merch_item, variant
+                ) as merch_item_quantity -- tuple json format
+        FROM merch_ownership
+        WHERE user_name = $1 AND quantity > 0
+        GROUP BY user_name
+    ),
+
+    -- received
+    user_name_recipient AS (
+        SELECT
+            recipient as user_name,
+            json_agg(
+                    json_build_array(sender, amount)
+                ) as sender_amount -- key_value json format
+        FROM coin_transfers
+        WHERE recipient = $1
+        GROUP BY recipient
+    ),
+    -- sent
+    user_name_sender AS (
+        SELECT
+            sender as user_name,
+            json_agg(
+                    json_build_array(recipient, amount)
+                ) as recipient_amount -- key_value json format
+        FROM coin_transfers
+        WHERE sender = $1
+        GROUP BY sender
+    ),
+
+    -- gifts received
+    user_name_gift_recipient AS (
+        SELECT
+            recipient as user_name,
+            json_agg(
+                    json_build_array(sender, merch_item, message)
+                ) as sender_item_message -- tuple json format
+        FROM merch_gifts
+        WHERE recipient = $1
+        GROUP BY recipient
+    ),
+    -- gifts sent
+    user_name_gift_sender AS (
+        SELECT
+            sender as user_name,
+            json_agg(
+                    json_build_array(recipient, merch_item, message)
+                ) as recipient_item_message -- tuple json format
+        FROM merch_gifts
+        WHERE sender = $1
+        GROUP BY sender
+    )
+
+SELECT
+    name,
+    coins,
+    merch_item_quantity as inventory,
+    recipient_amount as sent,
+    sender_amount as recived,
+    recipient_item_message as gifts_sent,
+    sender_item_message as gifts_received
+FROM users
+         LEFT JOIN user_name_inventory
+                   ON users.name = user_name_inventory.user_name
+         LEFT JOIN user_name_sender
+                   ON users.name = user_name_sender.user_name
+         LEFT JOIN user_name_recipient
+                   ON users.name = user_name_recipient.user_name
+         LEFT JOIN user_name_gift_sender
+                   ON users.name = user_name_gift_sender.user_name
+         LEFT JOIN user_name_gift_recipient
+                   ON users.name = user_name_gift_recipient.user_name
+WHERE name = $1
+`
+
+type GetCompositeUserIndoRow struct {
+	Name          string
+	Coins         int64
+	Inventory     []UserInventoryItem
+	Sent          []Sent
+	Recived       []Receive
+	GiftsSent     []SentGift
+	GiftsReceived []ReceivedGift
+}
+
+func (q *Queries) GetCompositeUserIndo(ctx context.Context, ftUserName string) (GetCompositeUserIndoRow, error) {
+	row := q.db.QueryRow(ctx, getCompositeUserIndo, ftUserName)
+	var i GetCompositeUserIndoRow
+	err := row.Scan(
+		&i.Name,
+		&i.Coins,
+		&i.Inventory,
+		&i.Sent,
+		&i.Recived,
+		&i.GiftsSent,
+		&i.GiftsReceived,
+	)
+	return i, err
+}
+
+const getEmailSettings = `-- name: GetEmailSettings :one
+SELECT user_name, enabled, language FROM email_settings
+WHERE user_name = $1
+`
+
+func (q *Queries) GetEmailSettings(ctx context.Context, userName string) (EmailSetting, error) {
+	row := q.db.QueryRow(ctx, getEmailSettings, userName)
+	var i EmailSetting
+	err := row.Scan(&i.UserName, &i.Enabled, &i.Language)
+	return i, err
+}
+
+const getLastRefundablePurchase = `-- name: GetLastRefundablePurchase :one
+SELECT p.id, p.user_name, p.merch_item, p.price, p.created_at, p.variant, p.discount, p.bundle FROM merch_purchases p
+WHERE p.user_name = $1
+  AND p.merch_item = $2
+  AND p.variant = $3
+  AND p.created_at >= $4
+  AND NOT EXISTS (
+      SELECT 1 FROM merch_refunds r
+      WHERE r.purchase_id = p.id AND r.status != 'rejected'
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM merch_orders o
+      WHERE o.purchase_id = p.id AND o.status = 'cancelled'
+  )
+ORDER BY p.created_at DESC
+LIMIT 1
+`
+
+type GetLastRefundablePurchaseParams struct {
+	UserName  string
+	MerchItem string
+	Variant   string
+	Since     pgtype.Timestamptz
+}
+
+func (q *Queries) GetLastRefundablePurchase(ctx context.Context, arg GetLastRefundablePurchaseParams) (MerchPurchase, error) {
+	row := q.db.QueryRow(ctx, getLastRefundablePurchase,
+		arg.UserName,
+		arg.MerchItem,
+		arg.Variant,
+		arg.Since,
+	)
+	var i MerchPurchase
+	err := row.Scan(
+		&i.ID,
+		&i.UserName,
+		&i.MerchItem,
+		&i.Price,
+		&i.CreatedAt,
+		&i.Variant,
+		&i.Discount,
+		&i.Bundle,
+	)
+	return i, err
+}
+
+const getLastUserEventSeq = `-- name: GetLastUserEventSeq :one
+SELECT coalesce(max(seq), 0)::bigint AS last_seq FROM user_events
+WHERE user_name = $1
+`
+
+func (q *Queries) GetLastUserEventSeq(ctx context.Context, userName string) (int64, error) {
+	row := q.db.QueryRow(ctx, getLastUserEventSeq, userName)
+	var last_seq int64
+	err := row.Scan(&last_seq)
+	return last_seq, err
+}
+
+const getListingForUpdate = `-- name: GetListingForUpdate :one
+SELECT id, seller, merch_item, variant, quantity, price, status, created_at, closed_at FROM market_listings
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetListingForUpdate(ctx context.Context, id int64) (MarketListing, error) {
+	row := q.db.QueryRow(ctx, getListingForUpdate, id)
+	var i MarketListing
+	err := row.Scan(
+		&i.ID,
+		&i.Seller,
+		&i.MerchItem,
+		&i.Variant,
+		&i.Quantity,
+		&i.Price,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const getMerchVariant = `-- name: GetMerchVariant :one
+SELECT merch_item, variant, attributes, price, stock FROM merch_variants
+WHERE merch_item = $1 AND variant = $2
+`
+
+type GetMerchVariantParams struct {
+	MerchItem string
+	Variant   string
+}
+
+func (q *Queries) GetMerchVariant(ctx context.Context, arg GetMerchVariantParams) (MerchVariant, error) {
+	row := q.db.QueryRow(ctx, getMerchVariant, arg.MerchItem, arg.Variant)
+	var i MerchVariant
+	err := row.Scan(
+		&i.MerchItem,
+		&i.Variant,
+		&i.Attributes,
+		&i.Price,
+		&i.Stock,
+	)
+	return i, err
+}
+
+const getMerchVariantForUpdate = `-- name: GetMerchVariantForUpdate :one
+SELECT merch_item, variant, attributes, price, stock FROM merch_variants
+WHERE merch_item = $1 AND variant = $2
+FOR UPDATE
+`
+
+type GetMerchVariantForUpdateParams struct {
+	MerchItem string
+	Variant   string
+}
+
+func (q *Queries) GetMerchVariantForUpdate(ctx context.Context, arg GetMerchVariantForUpdateParams) (MerchVariant, error) {
+	row := q.db.QueryRow(ctx, getMerchVariantForUpdate, arg.MerchItem, arg.Variant)
+	var i MerchVariant
+	err := row.Scan(
+		&i.MerchItem,
+		&i.Variant,
+		&i.Attributes,
+		&i.Price,
+		&i.Stock,
+	)
+	return i, err
+}
+
+const getOrder = `-- name: GetOrder :one
+SELECT id, user_name, payer, merch_item, variant, price, purchase_id, gift_id, status, pickup_code, created_at, updated_at, updated_by FROM merch_orders
+WHERE id = $1
+`
+
+func (q *Queries) GetOrder(ctx context.Context, id int64) (MerchOrder, error) {
+	row := q.db.QueryRow(ctx, getOrder, id)
+	var i MerchOrder
+	err := row.Scan(
+		&i.ID,
+		&i.UserName,
+		&i.Payer,
+		&i.MerchItem,
+		&i.Variant,
+		&i.Price,
+		&i.PurchaseID,
+		&i.GiftID,
+		&i.Status,
+		&i.PickupCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+	)
+	return i, err
+}
+
+const getOrderForUpdate = `-- name: GetOrderForUpdate :one
+SELECT id, user_name, payer, merch_item, variant, price, purchase_id, gift_id, status, pickup_code, created_at, updated_at, updated_by FROM merch_orders
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetOrderForUpdate(ctx context.Context, id int64) (MerchOrder, error) {
+	row := q.db.QueryRow(ctx, getOrderForUpdate, id)
+	var i MerchOrder
+	err := row.Scan(
+		&i.ID,
+		&i.UserName,
+		&i.Payer,
+		&i.MerchItem,
+		&i.Variant,
+		&i.Price,
+		&i.PurchaseID,
+		&i.GiftID,
+		&i.Status,
+		&i.PickupCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+	)
+	return i, err
+}
+
+const getPromotionByCodeForUpdate = `-- name: GetPromotionByCodeForUpdate :one
+SELECT id, code, merch_item, percent_off, amount_off, stackable, starts_at, ends_at, max_redemptions, max_redemptions_per_user, created_at FROM promotions
+WHERE code = $1::varchar
+FOR UPDATE
+`
+
+func (q *Queries) GetPromotionByCodeForUpdate(ctx context.Context, code string) (Promotion, error) {
+	row := q.db.QueryRow(ctx, getPromotionByCodeForUpdate, code)
+	var i Promotion
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.MerchItem,
+		&i.PercentOff,
+		&i.AmountOff,
+		&i.Stackable,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.MaxRedemptions,
+		&i.MaxRedemptionsPerUser,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getRaffle = `-- name: GetRaffle :one
+SELECT * FROM raffles
+WHERE id = $1
+`
+
+func (q *Queries) GetRaffle(ctx context.Context, id int64) (Raffle, error) {
+	row := q.db.QueryRow(ctx, getRaffle, id)
+	var i Raffle
+	err := row.Scan(
+		&i.ID,
+		&i.MerchItem,
+		&i.Variant,
+		&i.TicketPrice,
+		&i.Winners,
+		&i.MaxTicketsPerUser,
+		&i.SalesEndAt,
+		&i.SeedHash,
+		&i.Seed,
+		&i.Status,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.DrawnAt,
+		&i.DrawnBy,
+	)
+	return i, err
+}
+
+const getRaffleForUpdate = `-- name: GetRaffleForUpdate :one
+SELECT * FROM raffles
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetRaffleForUpdate(ctx context.Context, id int64) (Raffle, error) {
+	row := q.db.QueryRow(ctx, getRaffleForUpdate, id)
+	var i Raffle
+	err := row.Scan(
+		&i.ID,
+		&i.MerchItem,
+		&i.Variant,
+		&i.TicketPrice,
+		&i.Winners,
+		&i.MaxTicketsPerUser,
+		&i.SalesEndAt,
+		&i.SeedHash,
+		&i.Seed,
+		&i.Status,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.DrawnAt,
+		&i.DrawnBy,
+	)
+	return i, err
+}
+
+const getRefundForUpdate = `-- name: GetRefundForUpdate :one
+SELECT
+    r.id, r.purchase_id, r.status, r.requested_at, r.resolved_at, r.resolved_by,
+    p.user_name,
+    p.merch_item,
+    p.variant,
+    p.price
+FROM merch_refunds r
+         JOIN merch_purchases p
+              ON p.id = r.purchase_id
+WHERE r.id = $1
+FOR UPDATE OF r
+`
+
+type GetRefundForUpdateRow struct {
+	ID          int64
+	PurchaseID  int64
+	Status      string
+	RequestedAt pgtype.Timestamptz
+	ResolvedAt  pgtype.Timestamptz
+	ResolvedBy  pgtype.Text
+	UserName    string
+	MerchItem   string
+	Variant     string
+	Price       int64
+}
+
+func (q *Queries) GetRefundForUpdate(ctx context.Context, id int64) (GetRefundForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getRefundForUpdate, id)
+	var i GetRefundForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.PurchaseID,
+		&i.Status,
+		&i.RequestedAt,
+		&i.ResolvedAt,
+		&i.ResolvedBy,
+		&i.UserName,
+		&i.MerchItem,
+		&i.Variant,
+		&i.Price,
+	)
+	return i, err
+}
+
+const getRefundOrderForUpdate = `-- name: GetRefundOrderForUpdate :one
+SELECT o.id, o.user_name, o.payer, o.merch_item, o.variant, o.price, o.purchase_id, o.gift_id, o.status, o.pickup_code, o.created_at, o.updated_at, o.updated_by FROM merch_orders o
+         JOIN merch_refunds r
+              ON r.purchase_id = o.purchase_id
+WHERE r.id = $1
+FOR UPDATE OF o
+`
+
+func (q *Queries) GetRefundOrderForUpdate(ctx context.Context, id int64) (MerchOrder, error) {
+	row := q.db.QueryRow(ctx, getRefundOrderForUpdate, id)
+	var i MerchOrder
+	err := row.Scan(
+		&i.ID,
+		&i.UserName,
+		&i.Payer,
+		&i.MerchItem,
+		&i.Variant,
+		&i.Price,
+		&i.PurchaseID,
+		&i.GiftID,
+		&i.Status,
+		&i.PickupCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+	)
+	return i, err
+}
+
+const getTransactionTime = `-- name: GetTransactionTime :one
+SELECT now()::timestamptz AS now
+`
+
+func (q *Queries) GetTransactionTime(ctx context.Context) (pgtype.Timestamptz, error) {
+	row := q.db.QueryRow(ctx, getTransactionTime)
+	var now pgtype.Timestamptz
+	err := row.Scan(&now)
+	return now, err
+}
+
+const getTransfer = `-- name: GetTransfer :one
+SELECT sender, recipient, amount, id, created_at FROM coin_transfers
+WHERE id = $1
+`
+
+func (q *Queries) GetTransfer(ctx context.Context, id int64) (CoinTransfer, error) {
+	row := q.db.QueryRow(ctx, getTransfer, id)
+	var i CoinTransfer
+	err := row.Scan(
+		&i.Sender,
+		&i.Recipient,
+		&i.Amount,
+		&i.ID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getUserByName = `-- name: GetUserByName :one
+SELECT name, password, coins FROM users
+WHERE name = $1
+`
+
+func (q *Queries) GetUserByName(ctx context.Context, name string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByName, name)
+	var i User
+	err := row.Scan(&i.Name, &i.Password, &i.Coins)
+	return i, err
+}
+
+const getUserTotp = `-- name: GetUserTotp :one
+SELECT * FROM user_totp
+WHERE user_name = $1
+`
+
+func (q *Queries) GetUserTotp(ctx context.Context, userName string) (UserTotp, error) {
+	row := q.db.QueryRow(ctx, getUserTotp, userName)
+	var i UserTotp
+	err := row.Scan(
+		&i.UserName,
+		&i.Secret,
+		&i.ConfirmedAt,
+		&i.LastUsedStep,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getWebhookSubscription = `-- name: GetWebhookSubscription :one
+SELECT id, url, secret, event_types, created_at, created_by FROM webhook_subscriptions
+WHERE id = $1
+`
+
+func (q *Queries) GetWebhookSubscription(ctx context.Context, id int64) (WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, getWebhookSubscription, id)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.CreatedAt,
+		&i.CreatedBy,
+	)
+	return i, err
+}
+
+const isEmailVerified = `-- name: IsEmailVerified :one
+SELECT EXISTS (
+    SELECT 1 FROM email_verifications
+    WHERE user_name = $1
+)
+`
+
+func (q *Queries) IsEmailVerified(ctx context.Context, userName string) (bool, error) {
+	row := q.db.QueryRow(ctx, isEmailVerified, userName)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listActiveBundles = `-- name: ListActiveBundles :many
+SELECT
+    b.slug,
+    b.price,
+    b.starts_at,
+    b.ends_at,
+    i.merch_item,
+    i.quantity
+FROM merch_bundles b
+         JOIN merch_bundle_items i
+              ON i.bundle = b.slug
+WHERE b.starts_at <= now() AND b.ends_at > now()
+ORDER BY b.slug, i.merch_item
+`
+
+type ListActiveBundlesRow struct {
+	Slug      string
+	Price     int64
+	StartsAt  pgtype.Timestamptz
+	EndsAt    pgtype.Timestamptz
+	MerchItem string
+	Quantity  int64
+}
+
+func (q *Queries) ListActiveBundles(ctx context.Context) ([]ListActiveBundlesRow, error) {
+	rows, err := q.db.Query(ctx, listActiveBundles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListActiveBundlesRow
+	for rows.Next() {
+		var i ListActiveBundlesRow
+		if err := rows.Scan(
+			&i.Slug,
+			&i.Price,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.MerchItem,
+			&i.Quantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveListings = `-- name: ListActiveListings :many
+SELECT id, seller, merch_item, variant, quantity, price, status, created_at, closed_at FROM market_listings
+WHERE status = 'active'
+  AND ($1::varchar = '' OR merch_item = $1::varchar)
+ORDER BY price, id
+`
+
+func (q *Queries) ListActiveListings(ctx context.Context, merchItem string) ([]MarketListing, error) {
+	rows, err := q.db.Query(ctx, listActiveListings, merchItem)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MarketListing
+	for rows.Next() {
+		var i MarketListing
+		if err := rows.Scan(
+			&i.ID,
+			&i.Seller,
+			&i.MerchItem,
+			&i.Variant,
+			&i.Quantity,
+			&i.Price,
+			&i.Status,
+			&i.CreatedAt,
+			&i.ClosedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveSales = `-- name: ListActiveSales :many
+SELECT id, code, merch_item, percent_off, amount_off, stackable, starts_at, ends_at, max_redemptions, max_redemptions_per_user, created_at FROM promotions
+WHERE code IS NULL
+  AND (merch_item IS NULL OR merch_item = $1::varchar)
+  AND starts_at <= now() AND ends_at > now()
+ORDER BY id
+FOR UPDATE
+`
+
+func (q *Queries) ListActiveSales(ctx context.Context, merchItem string) ([]Promotion, error) {
+	rows, err := q.db.Query(ctx, listActiveSales, merchItem)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Promotion
+	for rows.Next() {
+		var i Promotion
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.MerchItem,
+			&i.PercentOff,
+			&i.AmountOff,
+			&i.Stackable,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.MaxRedemptions,
+			&i.MaxRedemptionsPerUser,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuctionBids = `-- name: ListAuctionBids :many
+SELECT * FROM auction_bids
+WHERE auction_id = $1
+ORDER BY id DESC
+`
+
+func (q *Queries) ListAuctionBids(ctx context.Context, auctionID int64) ([]AuctionBid, error) {
+	rows, err := q.db.Query(ctx, listAuctionBids, auctionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuctionBid
+	for rows.Next() {
+		var i AuctionBid
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuctionID,
+			&i.Bidder,
+			&i.Amount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDueAuctionsForUpdate = `-- name: ListDueAuctionsForUpdate :many
+SELECT * FROM auctions
+WHERE status = 'open' AND ends_at <= now()
+ORDER BY ends_at, id
+LIMIT $1
+FOR UPDATE SKIP LOCKED
+`
+
+func (q *Queries) ListDueAuctionsForUpdate(ctx context.Context, maxAuctions int32) ([]Auction, error) {
+	rows, err := q.db.Query(ctx, listDueAuctionsForUpdate, maxAuctions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Auction
+	for rows.Next() {
+		var i Auction
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchItem,
+			&i.Variant,
+			&i.StartPrice,
+			&i.MinStep,
+			&i.ExtensionSeconds,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Status,
+			&i.Leader,
+			&i.LeadingBid,
+			&i.CreatedAt,
+			&i.ClosedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMerch = `-- name: ListMerch :many
+SELECT slug, price, category, name_ru, name_en, description_ru, description_en, image_url FROM merch
+WHERE ($1::varchar IS NULL OR category = $1::varchar)
+  AND ($2::bigint IS NULL OR price >= $2::bigint)
+  AND ($3::bigint IS NULL OR price <= $3::bigint)
+  AND ($4::varchar = ''
+    OR slug ILIKE '%' || $4::varchar || '%'
+    OR name_ru ILIKE '%' || $4::varchar || '%'
+    OR name_en ILIKE '%' || $4::varchar || '%'
+    OR description_ru ILIKE '%' || $4::varchar || '%'
+    OR description_en ILIKE '%' || $4::varchar || '%')
+ORDER BY
+    CASE WHEN $5::varchar = 'price' THEN price END,
+    CASE WHEN $5::varchar = '-price' THEN price END DESC,
+    CASE WHEN $5::varchar = 'name' AND $6::varchar = 'ru' THEN name_ru END,
+    CASE WHEN $5::varchar = 'name' AND $6::varchar != 'ru' THEN name_en END,
+    slug
+LIMIT $7 OFFSET $8
+`
+
+type ListMerchParams struct {
+	Category   pgtype.Text
+	MinPrice   pgtype.Int8
+	MaxPrice   pgtype.Int8
+	Search     string
+	Sort       string
+	Lang       string
+	PageLimit  int32
+	PageOffset int32
+}
+
+func (q *Queries) ListMerch(ctx context.Context, arg ListMerchParams) ([]Merch, error) {
+	rows, err := q.db.Query(ctx, listMerch,
+		arg.Category,
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.Search,
+		arg.Sort,
+		arg.Lang,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Merch
+	for rows.Next() {
+		var i Merch
+		if err := rows.Scan(
+			&i.Slug,
+			&i.Price,
+			&i.Category,
+			&i.NameRu,
+			&i.NameEn,
+			&i.DescriptionRu,
+			&i.DescriptionEn,
+			&i.ImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMerchBySlugs = `-- name: ListMerchBySlugs :many
+SELECT slug, price, category, name_ru, name_en, description_ru, description_en, image_url FROM merch
+WHERE slug = ANY($1::varchar[])
+ORDER BY slug
+`
+
+func (q *Queries) ListMerchBySlugs(ctx context.Context, slugs []string) ([]Merch, error) {
+	rows, err := q.db.Query(ctx, listMerchBySlugs, slugs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Merch
+	for rows.Next() {
+		var i Merch
+		if err := rows.Scan(
+			&i.Slug,
+			&i.Price,
+			&i.Category,
+			&i.NameRu,
+			&i.NameEn,
+			&i.DescriptionRu,
+			&i.DescriptionEn,
+			&i.ImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMerchCategories = `-- name: ListMerchCategories :many
+SELECT slug, name_ru, name_en FROM merch_categories
+ORDER BY slug
+`
+
+func (q *Queries) ListMerchCategories(ctx context.Context) ([]MerchCategory, error) {
+	rows, err := q.db.Query(ctx, listMerchCategories)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MerchCategory
+	for rows.Next() {
+		var i MerchCategory
+		if err := rows.Scan(&i.Slug, &i.NameRu, &i.NameEn); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotificationMutes = `-- name: ListNotificationMutes :many
+SELECT kind FROM notification_mutes
+WHERE user_name = $1
+ORDER BY kind
+`
+
+func (q *Queries) ListNotificationMutes(ctx context.Context, userName string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listNotificationMutes, userName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var kind string
+		if err := rows.Scan(&kind); err != nil {
+			return nil, err
+		}
+		items = append(items, kind)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOpenAuctions = `-- name: ListOpenAuctions :many
+SELECT * FROM auctions
+WHERE status = 'open'
+ORDER BY ends_at, id
+`
+
+func (q *Queries) ListOpenAuctions(ctx context.Context) ([]Auction, error) {
+	rows, err := q.db.Query(ctx, listOpenAuctions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Auction
+	for rows.Next() {
+		var i Auction
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchItem,
+			&i.Variant,
+			&i.StartPrice,
+			&i.MinStep,
+			&i.ExtensionSeconds,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Status,
+			&i.Leader,
+			&i.LeadingBid,
+			&i.CreatedAt,
+			&i.ClosedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrdersByStatus = `-- name: ListOrdersByStatus :many
+SELECT id, user_name, payer, merch_item, variant, price, purchase_id, gift_id, status, pickup_code, created_at, updated_at, updated_by FROM merch_orders
+WHERE status = $1
+ORDER BY id
+`
+
+func (q *Queries) ListOrdersByStatus(ctx context.Context, status string) ([]MerchOrder, error) {
+	rows, err := q.db.Query(ctx, listOrdersByStatus, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MerchOrder
+	for rows.Next() {
+		var i MerchOrder
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserName,
+			&i.Payer,
+			&i.MerchItem,
+			&i.Variant,
+			&i.Price,
+			&i.PurchaseID,
+			&i.GiftID,
+			&i.Status,
+			&i.PickupCode,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.UpdatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPromotions = `-- name: ListPromotions :many
+SELECT id, code, merch_item, percent_off, amount_off, stackable, starts_at, ends_at, max_redemptions, max_redemptions_per_user, created_at FROM promotions
+ORDER BY id
+`
+
+func (q *Queries) ListPromotions(ctx context.Context) ([]Promotion, error) {
+	rows, err := q.db.Query(ctx, listPromotions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Promotion
+	for rows.Next() {
+		var i Promotion
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.MerchItem,
+			&i.PercentOff,
+			&i.AmountOff,
+			&i.Stackable,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.MaxRedemptions,
+			&i.MaxRedemptionsPerUser,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRaffleTickets = `-- name: ListRaffleTickets :many
+SELECT * FROM raffle_tickets
+WHERE raffle_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListRaffleTickets(ctx context.Context, raffleID int64) ([]RaffleTicket, error) {
+	rows, err := q.db.Query(ctx, listRaffleTickets, raffleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RaffleTicket
+	for rows.Next() {
+		var i RaffleTicket
+		if err := rows.Scan(
+			&i.ID,
+			&i.RaffleID,
+			&i.UserName,
+			&i.Price,
+			&i.Won,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRaffles = `-- name: ListRaffles :many
+SELECT * FROM raffles
+ORDER BY id DESC
+`
+
+func (q *Queries) ListRaffles(ctx context.Context) ([]Raffle, error) {
+	rows, err := q.db.Query(ctx, listRaffles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Raffle
+	for rows.Next() {
+		var i Raffle
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchItem,
+			&i.Variant,
+			&i.TicketPrice,
+			&i.Winners,
+			&i.MaxTicketsPerUser,
+			&i.SalesEndAt,
+			&i.SeedHash,
+			&i.Seed,
+			&i.Status,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.DrawnAt,
+			&i.DrawnBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRefundsByStatus = `-- name: ListRefundsByStatus :many
+SELECT
+    r.id, r.purchase_id, r.status, r.requested_at, r.resolved_at, r.resolved_by,
+    p.user_name,
+    p.merch_item,
+    p.variant,
+    p.price
+FROM merch_refunds r
+         JOIN merch_purchases p
+              ON p.id = r.purchase_id
+WHERE r.status = $1
+ORDER BY r.requested_at
+`
+
+type ListRefundsByStatusRow struct {
+	ID          int64
+	PurchaseID  int64
+	Status      string
+	RequestedAt pgtype.Timestamptz
+	ResolvedAt  pgtype.Timestamptz
+	ResolvedBy  pgtype.Text
+	UserName    string
+	MerchItem   string
+	Variant     string
+	Price       int64
+}
+
+func (q *Queries) ListRefundsByStatus(ctx context.Context, status string) ([]ListRefundsByStatusRow, error) {
+	rows, err := q.db.Query(ctx, listRefundsByStatus, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRefundsByStatusRow
+	for rows.Next() {
+		var i ListRefundsByStatusRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PurchaseID,
+			&i.Status,
+			&i.RequestedAt,
+			&i.ResolvedAt,
+			&i.ResolvedBy,
+			&i.UserName,
+			&i.MerchItem,
+			&i.Variant,
+			&i.Price,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRunningSales = `-- name: ListRunningSales :many
+SELECT * FROM promotions
+WHERE code IS NULL
+  AND starts_at <= now() AND ends_at > now()
+ORDER BY id
+`
+
+func (q *Queries) ListRunningSales(ctx context.Context) ([]Promotion, error) {
+	rows, err := q.db.Query(ctx, listRunningSales)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Promotion
+	for rows.Next() {
+		var i Promotion
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.MerchItem,
+			&i.PercentOff,
+			&i.AmountOff,
+			&i.Stackable,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.MaxRedemptions,
+			&i.MaxRedemptionsPerUser,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnpublishedOutboxEventsForUpdate = `-- name: ListUnpublishedOutboxEventsForUpdate :many
+SELECT id, event_type, payload, created_at, webhooks_dispatched_at, bus_published_at FROM outbox_events
+WHERE bus_published_at IS NULL
+ORDER BY id
+LIMIT $1
+FOR UPDATE SKIP LOCKED
+`
+
+func (q *Queries) ListUnpublishedOutboxEventsForUpdate(ctx context.Context, batchSize int32) ([]OutboxEvent, error) {
+	rows, err := q.db.Query(ctx, listUnpublishedOutboxEventsForUpdate, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OutboxEvent
+	for rows.Next() {
+		var i OutboxEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.Payload,
+			&i.CreatedAt,
+			&i.WebhooksDispatchedAt,
+			&i.BusPublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserEventsAfter = `-- name: ListUserEventsAfter :many
+SELECT id, user_name, kind, payload, created_at, seq FROM user_events
+WHERE user_name = $1 AND seq > $2::bigint
+ORDER BY seq
+LIMIT $3
+`
+
+type ListUserEventsAfterParams struct {
+	UserName  string
+	AfterSeq  int64
+	PageLimit int32
+}
+
+func (q *Queries) ListUserEventsAfter(ctx context.Context, arg ListUserEventsAfterParams) ([]UserEvent, error) {
+	rows, err := q.db.Query(ctx, listUserEventsAfter, arg.UserName, arg.AfterSeq, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserEvent
+	for rows.Next() {
+		var i UserEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserName,
+			&i.Kind,
+			&i.Payload,
+			&i.CreatedAt,
+			&i.Seq,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserInventory = `-- name: ListUserInventory :many
+SELECT user_name, merch_item, quantity, variant FROM merch_ownership
+WHERE user_name = $1 AND quantity > 0
+ORDER BY merch_item, variant
+`
+
+func (q *Queries) ListUserInventory(ctx context.Context, userName string) ([]MerchOwnership, error) {
+	rows, err := q.db.Query(ctx, listUserInventory, userName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MerchOwnership
+	for rows.Next() {
+		var i MerchOwnership
+		if err := rows.Scan(
+			&i.UserName,
+			&i.MerchItem,
+			&i.Quantity,
+			&i.Variant,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserNotifications = `-- name: ListUserNotifications :many
+SELECT id, user_name, kind, merch_item, message, created_at, read_at FROM notifications
+WHERE user_name = $1
+  AND (NOT $2::boolean OR read_at IS NULL)
+ORDER BY id DESC
+LIMIT $3 OFFSET $4
+`
+
+type ListUserNotificationsParams struct {
+	UserName   string
+	UnreadOnly bool
+	PageLimit  int32
+	PageOffset int32
+}
+
+func (q *Queries) ListUserNotifications(ctx context.Context, arg ListUserNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, listUserNotifications,
+		arg.UserName,
+		arg.UnreadOnly,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserName,
+			&i.Kind,
+			&i.MerchItem,
+			&i.Message,
+			&i.CreatedAt,
+			&i.ReadAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserOrders = `-- name: ListUserOrders :many
+SELECT id, user_name, payer, merch_item, variant, price, purchase_id, gift_id, status, pickup_code, created_at, updated_at, updated_by FROM merch_orders
+WHERE user_name = $1 OR payer = $1
+ORDER BY id DESC
+`
+
+func (q *Queries) ListUserOrders(ctx context.Context, userName string) ([]MerchOrder, error) {
+	rows, err := q.db.Query(ctx, listUserOrders, userName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MerchOrder
+	for rows.Next() {
+		var i MerchOrder
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserName,
+			&i.Payer,
+			&i.MerchItem,
+			&i.Variant,
+			&i.Price,
+			&i.PurchaseID,
+			&i.GiftID,
+			&i.Status,
+			&i.PickupCode,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.UpdatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserTransfers = `-- name: ListUserTransfers :many
+SELECT sender, recipient, amount, id, created_at FROM coin_transfers
+WHERE sender = $1 OR recipient = $1
+ORDER BY id DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListUserTransfersParams struct {
+	UserName   string
+	PageLimit  int32
+	PageOffset int32
+}
+
+func (q *Queries) ListUserTransfers(ctx context.Context, arg ListUserTransfersParams) ([]CoinTransfer, error) {
+	rows, err := q.db.Query(ctx, listUserTransfers, arg.UserName, arg.PageLimit, arg.PageOffset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoinTransfer
+	for rows.Next() {
+		var i CoinTransfer
+		if err := rows.Scan(
+			&i.Sender,
+			&i.Recipient,
+			&i.Amount,
+			&i.ID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT d.id, d.subscription_id, d.event_id, d.status, d.attempts, d.next_attempt_at, d.last_status_code, d.last_error, d.delivered_at, d.created_at, e.event_type
+FROM webhook_deliveries d
+JOIN outbox_events e ON e.id = d.event_id
+WHERE d.subscription_id = $1 AND ($2::varchar = '' OR d.status = $2)
+ORDER BY d.id DESC
+LIMIT $3 OFFSET $4
+`
+
+type ListWebhookDeliveriesRow struct {
+	ID             int64
+	SubscriptionID int64
+	EventID        int64
+	Status         string
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	LastStatusCode pgtype.Int4
+	LastError      pgtype.Text
+	DeliveredAt    pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+	EventType      string
+}
+
+type ListWebhookDeliveriesParams struct {
+	SubscriptionID int64
+	Status         string
+	PageLimit      int32
+	PageOffset     int32
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]ListWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeliveries,
+		arg.SubscriptionID,
+		arg.Status,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhookDeliveriesRow
+	for rows.Next() {
+		var i ListWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.EventID,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+			&i.EventType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSubscriptions = `-- name: ListWebhookSubscriptions :many
+SELECT id, url, secret, event_types, created_at, created_by FROM webhook_subscriptions
+ORDER BY id
+`
+
+func (q *Queries) ListWebhookSubscriptions(ctx context.Context) ([]WebhookSubscription, error) {
+	rows, err := q.db.Query(ctx, listWebhookSubscriptions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookSubscription
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.CreatedAt,
+			&i.CreatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWishlist = `-- name: ListWishlist :many
+SELECT w.merch_item, w.variant, w.created_at,
+       COALESCE(v.price, m.price)::bigint AS price,
+       v.stock
+FROM wishlists w
+    JOIN merch m ON m.slug = w.merch_item
+    LEFT JOIN merch_variants v ON v.merch_item = w.merch_item AND v.variant = w.variant
+WHERE w.user_name = $1
+ORDER BY w.created_at, w.merch_item, w.variant
+`
+
+type ListWishlistRow struct {
+	MerchItem string
+	Variant   string
+	CreatedAt pgtype.Timestamptz
+	Price     int64
+	Stock     pgtype.Int8
+}
+
+func (q *Queries) ListWishlist(ctx context.Context, userName string) ([]ListWishlistRow, error) {
+	rows, err := q.db.Query(ctx, listWishlist, userName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWishlistRow
+	for rows.Next() {
+		var i ListWishlistRow
+		if err := rows.Scan(
+			&i.MerchItem,
+			&i.Variant,
+			&i.CreatedAt,
+			&i.Price,
+			&i.Stock,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockUserEventsSequencer = `-- name: LockUserEventsSequencer :exec
+SELECT pg_advisory_xact_lock(hashtext('user_events_seq'))
+`
+
+func (q *Queries) LockUserEventsSequencer(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockUserEventsSequencer)
+	return err
+}
+
+const markAllNotificationsRead = `-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications
+SET
+    read_at = now()
+WHERE user_name = $1 AND read_at IS NULL
+`
+
+func (q *Queries) MarkAllNotificationsRead(ctx context.Context, userName string) (int64, error) {
+	result, err := q.db.Exec(ctx, markAllNotificationsRead, userName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markEmailFailed = `-- name: MarkEmailFailed :exec
+UPDATE email_messages
+SET
+    status = CASE WHEN attempts + 1 >= $1::int THEN 'dead' ELSE 'pending' END,
+    attempts = attempts + 1,
+    last_error = $2,
+    next_attempt_at = $3
+WHERE id = $4
+`
+
+type MarkEmailFailedParams struct {
+	MaxAttempts   int32
+	LastError     pgtype.Text
+	NextAttemptAt pgtype.Timestamptz
+	ID            int64
+}
+
+func (q *Queries) MarkEmailFailed(ctx context.Context, arg MarkEmailFailedParams) error {
+	_, err := q.db.Exec(ctx, markEmailFailed,
+		arg.MaxAttempts,
+		arg.LastError,
+		arg.NextAttemptAt,
+		arg.ID,
+	)
+	return err
+}
+
+const markEmailSent = `-- name: MarkEmailSent :exec
+UPDATE email_messages
+SET
+    status = 'sent',
+    attempts = attempts + 1,
+    last_error = NULL,
+    sent_at = now()
+WHERE id = $1
+`
+
+func (q *Queries) MarkEmailSent(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, markEmailSent, id)
+	return err
+}
+
+const markEmailVerified = `-- name: MarkEmailVerified :exec
+INSERT INTO email_verifications (user_name)
+VALUES ($1)
+ON CONFLICT DO NOTHING
+`
+
+func (q *Queries) MarkEmailVerified(ctx context.Context, userName string) error {
+	_, err := q.db.Exec(ctx, markEmailVerified, userName)
+	return err
+}
+
+const markNotificationRead = `-- name: MarkNotificationRead :one
+UPDATE notifications
+SET
+    read_at = coalesce(read_at, now())
+WHERE id = $1 AND user_name = $2
+RETURNING id, user_name, kind, merch_item, message, created_at, read_at
+`
+
+type MarkNotificationReadParams struct {
+	ID       int64
+	UserName string
+}
+
+func (q *Queries) MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) (Notification, error) {
+	row := q.db.QueryRow(ctx, markNotificationRead, arg.ID, arg.UserName)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.UserName,
+		&i.Kind,
+		&i.MerchItem,
+		&i.Message,
+		&i.CreatedAt,
+		&i.ReadAt,
+	)
+	return i, err
+}
+
+const markOutboxEventsPublished = `-- name: MarkOutboxEventsPublished :exec
+UPDATE outbox_events
+SET
+    bus_published_at = now()
+WHERE id = ANY($1::bigint[])
+`
+
+func (q *Queries) MarkOutboxEventsPublished(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, markOutboxEventsPublished, ids)
+	return err
+}
+
+const markWebhookDelivered = `-- name: MarkWebhookDelivered :exec
+UPDATE webhook_deliveries
+SET
+    status = 'delivered',
+    attempts = attempts + 1,
+    last_status_code = $1,
+    last_error = NULL,
+    delivered_at = now()
+WHERE id = $2
+`
+
+type MarkWebhookDeliveredParams struct {
+	LastStatusCode pgtype.Int4
+	ID             int64
+}
+
+func (q *Queries) MarkWebhookDelivered(ctx context.Context, arg MarkWebhookDeliveredParams) error {
+	_, err := q.db.Exec(ctx, markWebhookDelivered, arg.LastStatusCode, arg.ID)
+	return err
+}
+
+const markWebhookFailed = `-- name: MarkWebhookFailed :exec
+UPDATE webhook_deliveries
+SET
+    status = CASE WHEN attempts + 1 >= $1::int THEN 'dead' ELSE 'pending' END,
+    attempts = attempts + 1,
+    last_status_code = $2,
+    last_error = $3,
+    next_attempt_at = $4
+WHERE id = $5
+`
+
+type MarkWebhookFailedParams struct {
+	MaxAttempts    int32
+	LastStatusCode pgtype.Int4
+	LastError      pgtype.Text
+	NextAttemptAt  pgtype.Timestamptz
+	ID             int64
+}
+
+func (q *Queries) MarkWebhookFailed(ctx context.Context, arg MarkWebhookFailedParams) error {
+	_, err := q.db.Exec(ctx, markWebhookFailed,
+		arg.MaxAttempts,
+		arg.LastStatusCode,
+		arg.LastError,
+		arg.NextAttemptAt,
+		arg.ID,
+	)
+	return err
+}
+
+const minusMerchItem = `-- name: MinusMerchItem :one
+UPDATE merch_ownership
+SET
+    quantity = quantity - 1
+WHERE user_name = $1 AND merch_item = $2 AND variant = $3
+RETURNING user_name, merch_item, quantity, variant
+`
+
+type MinusMerchItemParams struct {
+	UserName  string
+	MerchItem string
+	Variant   string
+}
+
+func (q *Queries) MinusMerchItem(ctx context.Context, arg MinusMerchItemParams) (MerchOwnership, error) {
+	row := q.db.QueryRow(ctx, minusMerchItem, arg.UserName, arg.MerchItem, arg.Variant)
+	var i MerchOwnership
+	err := row.Scan(
+		&i.UserName,
+		&i.MerchItem,
+		&i.Quantity,
+		&i.Variant,
+	)
+	return i, err
+}
+
+const minusUserCoins = `-- name: MinusUserCoins :one
+UPDATE users
+SET
+    coins = coins - $1
+WHERE name = $2
+RETURNING name, password, coins
+`
+
+type MinusUserCoinsParams struct {
+	Amount int64
+	Name   string
+}
+
+func (q *Queries) MinusUserCoins(ctx context.Context, arg MinusUserCoinsParams) (User, error) {
+	row := q.db.QueryRow(ctx, minusUserCoins, arg.Amount, arg.Name)
+	var i User
+	err := row.Scan(&i.Name, &i.Password, &i.Coins)
+	return i, err
+}
+
+const notifyWishlistedUsers = `-- name: NotifyWishlistedUsers :execrows
+INSERT INTO notifications (user_name, kind, merch_item, message)
+SELECT DISTINCT user_name, $1::varchar, merch_item, $2::text
+FROM wishlists
+WHERE ($3::varchar IS NULL OR merch_item = $3)
+  AND ($4::varchar IS NULL OR variant IN ('', $4))
+  AND NOT EXISTS (
+    SELECT 1 FROM notification_mutes m
+    WHERE m.user_name = wishlists.user_name AND m.kind = $1::varchar
+  )
+`
+
+type NotifyWishlistedUsersParams struct {
+	Kind      string
+	Message   string
+	MerchItem pgtype.Text
+	Variant   pgtype.Text
+}
+
+func (q *Queries) NotifyWishlistedUsers(ctx context.Context, arg NotifyWishlistedUsersParams) (int64, error) {
+	result, err := q.db.Exec(ctx, notifyWishlistedUsers,
+		arg.Kind,
+		arg.Message,
+		arg.MerchItem,
+		arg.Variant,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const plusUserCoins = `-- name: PlusUserCoins :one
+UPDATE users
+SET
+    coins = coins + $1
+WHERE name = $2
+RETURNING name, password, coins
+`
+
+type PlusUserCoinsParams struct {
+	Amount int64
+	Name   string
+}
+
+func (q *Queries) PlusUserCoins(ctx context.Context, arg PlusUserCoinsParams) (User, error) {
+	row := q.db.QueryRow(ctx, plusUserCoins, arg.Amount, arg.Name)
+	var i User
+	err := row.Scan(&i.Name, &i.Password, &i.Coins)
+	return i, err
+}
+
+const redeliverWebhookDelivery = `-- name: RedeliverWebhookDelivery :one
+WITH redelivered AS (
+    UPDATE webhook_deliveries
+    SET
+        status = 'pending',
+        attempts = 0,
+        next_attempt_at = now(),
+        delivered_at = NULL
+    WHERE webhook_deliveries.id = $1
+    RETURNING webhook_deliveries.id, webhook_deliveries.subscription_id, webhook_deliveries.event_id, webhook_deliveries.status, webhook_deliveries.attempts, webhook_deliveries.next_attempt_at, webhook_deliveries.last_status_code, webhook_deliveries.last_error, webhook_deliveries.delivered_at, webhook_deliveries.created_at
+)
+SELECT redelivered.id, redelivered.subscription_id, redelivered.event_id, redelivered.status, redelivered.attempts, redelivered.next_attempt_at, redelivered.last_status_code, redelivered.last_error, redelivered.delivered_at, redelivered.created_at, e.event_type
+FROM redelivered
+JOIN outbox_events e ON e.id = redelivered.event_id
+`
+
+type RedeliverWebhookDeliveryRow struct {
+	ID             int64
+	SubscriptionID int64
+	EventID        int64
+	Status         string
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	LastStatusCode pgtype.Int4
+	LastError      pgtype.Text
+	DeliveredAt    pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+	EventType      string
+}
+
+func (q *Queries) RedeliverWebhookDelivery(ctx context.Context, id int64) (RedeliverWebhookDeliveryRow, error) {
+	row := q.db.QueryRow(ctx, redeliverWebhookDelivery, id)
+	var i RedeliverWebhookDeliveryRow
+	err := row.Scan(
+		&i.ID,
+		&i.SubscriptionID,
+		&i.EventID,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+		&i.EventType,
+	)
+	return i, err
+}
+
+const rejectPurchaseRefunds = `-- name: RejectPurchaseRefunds :exec
+UPDATE merch_refunds
+SET
+    status = 'rejected',
+    resolved_at = now(),
+    resolved_by = $1
+WHERE purchase_id = $2 AND status = 'requested'
+`
+
+type RejectPurchaseRefundsParams struct {
+	ResolvedBy pgtype.Text
+	PurchaseID int64
+}
+
+func (q *Queries) RejectPurchaseRefunds(ctx context.Context, arg RejectPurchaseRefundsParams) error {
+	_, err := q.db.Exec(ctx, rejectPurchaseRefunds, arg.ResolvedBy, arg.PurchaseID)
+	return err
+}
+
+const releaseAuctionBids = `-- name: ReleaseAuctionBids :many
+UPDATE auction_bids
+SET
+    status = 'released',
+    updated_at = now()
+WHERE auction_id = $1 AND status = 'held'
+RETURNING *
+`
+
+func (q *Queries) ReleaseAuctionBids(ctx context.Context, auctionID int64) ([]AuctionBid, error) {
+	rows, err := q.db.Query(ctx, releaseAuctionBids, auctionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuctionBid
+	for rows.Next() {
+		var i AuctionBid
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuctionID,
+			&i.Bidder,
+			&i.Amount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolveRefund = `-- name: ResolveRefund :one
+UPDATE merch_refunds
+SET
+    status = $1,
+    resolved_at = now(),
+    resolved_by = $2
+WHERE id = $3 AND status = 'requested'
+RETURNING id, purchase_id, status, requested_at, resolved_at, resolved_by
+`
+
+type ResolveRefundParams struct {
+	Status     string
+	ResolvedBy pgtype.Text
+	ID         int64
+}
+
+func (q *Queries) ResolveRefund(ctx context.Context, arg ResolveRefundParams) (MerchRefund, error) {
+	row := q.db.QueryRow(ctx, resolveRefund, arg.Status, arg.ResolvedBy, arg.ID)
+	var i MerchRefund
+	err := row.Scan(
+		&i.ID,
+		&i.PurchaseID,
+		&i.Status,
+		&i.RequestedAt,
+		&i.ResolvedAt,
+		&i.ResolvedBy,
+	)
+	return i, err
+}
+
+const restockMerchVariant = `-- name: RestockMerchVariant :one
+UPDATE merch_variants
+SET
+    stock = coalesce(stock, 0) + $1::bigint
+WHERE merch_item = $2 AND variant = $3
+RETURNING *
+`
+
+type RestockMerchVariantParams struct {
+	Quantity  int64
+	MerchItem string
+	Variant   string
+}
+
+func (q *Queries) RestockMerchVariant(ctx context.Context, arg RestockMerchVariantParams) (MerchVariant, error) {
+	row := q.db.QueryRow(ctx, restockMerchVariant, arg.Quantity, arg.MerchItem, arg.Variant)
+	var i MerchVariant
+	err := row.Scan(
+		&i.MerchItem,
+		&i.Variant,
+		&i.Attributes,
+		&i.Price,
+		&i.Stock,
+	)
+	return i, err
+}
+
+const returnMerchVariantStock = `-- name: ReturnMerchVariantStock :exec
+UPDATE merch_variants
+SET
+    stock = stock + $1::bigint
+WHERE merch_item = $2 AND variant = $3 AND stock IS NOT NULL
+`
+
+type ReturnMerchVariantStockParams struct {
+	Quantity  int64
+	MerchItem string
+	Variant   string
+}
+
+func (q *Queries) ReturnMerchVariantStock(ctx context.Context, arg ReturnMerchVariantStockParams) error {
+	_, err := q.db.Exec(ctx, returnMerchVariantStock, arg.Quantity, arg.MerchItem, arg.Variant)
+	return err
+}
+
+const revokeAuthTokens = `-- name: RevokeAuthTokens :exec
+UPDATE auth_tokens
+SET
+    used_at = now()
+WHERE user_name = $1 AND purpose = $2 AND used_at IS NULL
+`
+
+type RevokeAuthTokensParams struct {
+	UserName string
+	Purpose  string
+}
+
+func (q *Queries) RevokeAuthTokens(ctx context.Context, arg RevokeAuthTokensParams) error {
+	_, err := q.db.Exec(ctx, revokeAuthTokens, arg.UserName, arg.Purpose)
+	return err
+}
+
+const sequenceUserEvents = `-- name: SequenceUserEvents :execrows
+UPDATE user_events
+SET
+    seq = ordered.seq
+FROM (SELECT id, nextval('user_events_seq') AS seq
+      FROM (SELECT id FROM user_events WHERE seq IS NULL ORDER BY id) AS ids) AS ordered
+WHERE user_events.id = ordered.id
+`
+
+func (q *Queries) SequenceUserEvents(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, sequenceUserEvents)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const takeListingUnits = `-- name: TakeListingUnits :one
+UPDATE market_listings
+SET
+    quantity = quantity - $1,
+    status = CASE WHEN quantity = $1 THEN 'sold' ELSE status END,
+    closed_at = CASE WHEN quantity = $1 THEN now() ELSE closed_at END
+WHERE id = $2 AND status = 'active'
+RETURNING id, seller, merch_item, variant, quantity, price, status, created_at, closed_at
+`
+
+type TakeListingUnitsParams struct {
+	Quantity int64
+	ID       int64
+}
+
+func (q *Queries) TakeListingUnits(ctx context.Context, arg TakeListingUnitsParams) (MarketListing, error) {
+	row := q.db.QueryRow(ctx, takeListingUnits, arg.Quantity, arg.ID)
+	var i MarketListing
+	err := row.Scan(
+		&i.ID,
+		&i.Seller,
+		&i.MerchItem,
+		&i.Variant,
+		&i.Quantity,
+		&i.Price,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const takeMerchItems = `-- name: TakeMerchItems :one
+UPDATE merch_ownership
+SET
+    quantity = quantity - $1
+WHERE user_name = $2 AND merch_item = $3 AND variant = $4
+RETURNING user_name, merch_item, quantity, variant
+`
+
+type TakeMerchItemsParams struct {
+	Quantity  int64
+	UserName  string
+	MerchItem string
+	Variant   string
+}
+
+func (q *Queries) TakeMerchItems(ctx context.Context, arg TakeMerchItemsParams) (MerchOwnership, error) {
+	row := q.db.QueryRow(ctx, takeMerchItems,
+		arg.Quantity,
+		arg.UserName,
+		arg.MerchItem,
+		arg.Variant,
+	)
+	var i MerchOwnership
+	err := row.Scan(
+		&i.UserName,
+		&i.MerchItem,
+		&i.Quantity,
+		&i.Variant,
+	)
+	return i, err
+}
+
+const takeMerchVariantStock = `-- name: TakeMerchVariantStock :one
+UPDATE merch_variants
+SET
+    stock = stock - $1::bigint
+WHERE merch_item = $2 AND variant = $3 AND stock IS NOT NULL
+RETURNING merch_item, variant, attributes, price, stock
+`
+
+type TakeMerchVariantStockParams struct {
+	Quantity  int64
+	MerchItem string
+	Variant   string
+}
+
+func (q *Queries) TakeMerchVariantStock(ctx context.Context, arg TakeMerchVariantStockParams) (MerchVariant, error) {
+	row := q.db.QueryRow(ctx, takeMerchVariantStock, arg.Quantity, arg.MerchItem, arg.Variant)
+	var i MerchVariant
+	err := row.Scan(
+		&i.MerchItem,
+		&i.Variant,
+		&i.Attributes,
+		&i.Price,
+		&i.Stock,
+	)
+	return i, err
+}
+
+const updateAuctionLead = `-- name: UpdateAuctionLead :one
+UPDATE auctions
+SET
+    leader = $1,
+    leading_bid = $2,
+    -- anti-sniping: a late bid gives others the extension time to answer
+    ends_at = greatest(ends_at, now() + extension_seconds * interval '1 second')
+WHERE id = $3 AND status = 'open' AND ends_at > now()
+RETURNING *
+`
+
+type UpdateAuctionLeadParams struct {
+	Leader     pgtype.Text
+	LeadingBid pgtype.Int8
+	ID         int64
+}
+
+func (q *Queries) UpdateAuctionLead(ctx context.Context, arg UpdateAuctionLeadParams) (Auction, error) {
+	row := q.db.QueryRow(ctx, updateAuctionLead, arg.Leader, arg.LeadingBid, arg.ID)
+	var i Auction
+	err := row.Scan(
+		&i.ID,
+		&i.MerchItem,
+		&i.Variant,
+		&i.StartPrice,
+		&i.MinStep,
+		&i.ExtensionSeconds,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Status,
+		&i.Leader,
+		&i.LeadingBid,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const updateOrderStatus = `-- name: UpdateOrderStatus :one
+UPDATE merch_orders
+SET
+    status = $1,
+    updated_at = now(),
+    updated_by = $2
+WHERE id = $3
+RETURNING id, user_name, payer, merch_item, variant, price, purchase_id, gift_id, status, pickup_code, created_at, updated_at, updated_by
+`
+
+type UpdateOrderStatusParams struct {
+	Status    string
+	UpdatedBy pgtype.Text
+	ID        int64
+}
+
+func (q *Queries) UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusParams) (MerchOrder, error) {
+	row := q.db.QueryRow(ctx, updateOrderStatus, arg.Status, arg.UpdatedBy, arg.ID)
+	var i MerchOrder
+	err := row.Scan(
+		&i.ID,
+		&i.UserName,
+		&i.Payer,
+		&i.MerchItem,
+		&i.Variant,
+		&i.Price,
+		&i.PurchaseID,
+		&i.GiftID,
+		&i.Status,
+		&i.PickupCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+	)
+	return i, err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :execrows
+UPDATE users
+SET
+    password = $1
+WHERE name = $2
+`
+
+type UpdateUserPasswordParams struct {
+	Password string
+	Name     string
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateUserPassword, arg.Password, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertEmailSettings = `-- name: UpsertEmailSettings :one
+INSERT INTO email_settings (user_name, enabled, language)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_name) DO UPDATE
+SET
+    enabled = excluded.enabled,
+    language = excluded.language
+RETURNING user_name, enabled, language
+`
+
+type UpsertEmailSettingsParams struct {
+	UserName string
+	Enabled  bool
+	Language string
+}
+
+func (q *Queries) UpsertEmailSettings(ctx context.Context, arg UpsertEmailSettingsParams) (EmailSetting, error) {
+	row := q.db.QueryRow(ctx, upsertEmailSettings, arg.UserName, arg.Enabled, arg.Language)
+	var i EmailSetting
+	err := row.Scan(&i.UserName, &i.Enabled, &i.Language)
+	return i, err
+}
+
+const upsertUserTotp = `-- name: UpsertUserTotp :one
+INSERT INTO user_totp (user_name, secret)
+VALUES ($1, $2)
+ON CONFLICT (user_name) DO UPDATE
+SET
+    secret = excluded.secret,
+    created_at = now()
+WHERE user_totp.confirmed_at IS NULL
+RETURNING *
+`
+
+type UpsertUserTotpParams struct {
+	UserName string
+	Secret   []byte
+}
+
+func (q *Queries) UpsertUserTotp(ctx context.Context, arg UpsertUserTotpParams) (UserTotp, error) {
+	row := q.db.QueryRow(ctx, upsertUserTotp, arg.UserName, arg.Secret)
+	var i UserTotp
+	err := row.Scan(
+		&i.UserName,
+		&i.Secret,
+		&i.ConfirmedAt,
+		&i.LastUsedStep,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const useAuthToken = `-- name: UseAuthToken :one
+UPDATE auth_tokens
+SET
+    used_at = now()
+WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > now()
+RETURNING user_name
+`
+
+type UseAuthTokenParams struct {
+	TokenHash []byte
+	Purpose   string
+}
+
+func (q *Queries) UseAuthToken(ctx context.Context, arg UseAuthTokenParams) (string, error) {
+	row := q.db.QueryRow(ctx, useAuthToken, arg.TokenHash, arg.Purpose)
+	var user_name string
+	err := row.Scan(&user_name)
+	return user_name, err
+}
+
+const useTotpRecoveryCode = `-- name: UseTotpRecoveryCode :execrows
+UPDATE totp_recovery_codes
+SET
+    used_at = now()
+WHERE user_name = $1 AND code_hash = $2 AND used_at IS NULL
+`
+
+type UseTotpRecoveryCodeParams struct {
+	UserName string
+	CodeHash []byte
+}
+
+func (q *Queries) UseTotpRecoveryCode(ctx context.Context, arg UseTotpRecoveryCodeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useTotpRecoveryCode, arg.UserName, arg.CodeHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const useTotpStep = `-- name: UseTotpStep :execrows
+UPDATE user_totp
+SET
+    last_used_step = $1
+WHERE user_name = $2 AND confirmed_at IS NOT NULL AND last_used_step < $1
+`
+
+type UseTotpStepParams struct {
+	Step     int64
+	UserName string
+}
+
+func (q *Queries) UseTotpStep(ctx context.Context, arg UseTotpStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useTotpStep, arg.Step, arg.UserName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const winAuctionBid = `-- name: WinAuctionBid :exec
+UPDATE auction_bids
+SET
+    status = 'won',
+    updated_at = now()
+WHERE auction_id = $1 AND status = 'held'
+`
+
+func (q *Queries) WinAuctionBid(ctx context.Context, auctionID int64) error {
+	_, err := q.db.Exec(ctx, winAuctionBid, auctionID)
+	return err
+}
+
+const winRaffleTicket = `-- name: WinRaffleTicket :exec
+UPDATE raffle_tickets
+SET
+    won = true
+WHERE id = $1
+`
+
+func (q *Queries) WinRaffleTicket(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, winRaffleTicket, id)
+	return err
+}
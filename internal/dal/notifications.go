@@ -0,0 +1,127 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+const (
+	NotificationItemOnSale    = "item_on_sale"
+	NotificationItemRestocked = "item_restocked"
+	NotificationCoinsReceived = "coins_received"
+	NotificationOrderReady    = "order_ready"
+)
+
+// NotificationKinds are the categories a user can mute
+var NotificationKinds = []string{
+	NotificationItemOnSale,
+	NotificationItemRestocked,
+	NotificationCoinsReceived,
+	NotificationOrderReady,
+}
+
+// NotifyWishlistedUsers notifies once every user who has the merch item in the wishlist and has not muted the kind,
+// null merch item means any item and null variant means any variant
+func (r Dal) NotifyWishlistedUsers(ctx context.Context, notifyArgs models.NotifyWishlistedUsersParams) (int64, error) {
+	notified, err := r.Queries.NotifyWishlistedUsers(ctx, notifyArgs)
+	if err == nil {
+		return notified, nil
+	}
+	return 0, notificationError(err, notifyArgs.Kind)
+}
+
+// AddNotification notifies the user unless the kind is muted by the user, it returns false then
+func (r Dal) AddNotification(ctx context.Context, addArgs models.AddNotificationParams) (bool, error) {
+	added, err := r.Queries.AddNotification(ctx, addArgs)
+	if err == nil {
+		return added > 0, nil
+	}
+	return false, notificationError(err, addArgs.Kind)
+}
+
+func notificationError(err error, kind string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "notifications_kind_valid", "notification_mutes_kind_valid":
+			return fmt.Errorf("%w: invalid notification kind %q: this constraint must check upper layer", ErrInternal, kind)
+		case "notifications_fk_user_name", "notifications_fk_merch_item", "notification_mutes_fk_user_name":
+			return fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+		}
+	}
+	return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+// ListUserNotifications returns a page of notifications of the user, newest first
+func (r Dal) ListUserNotifications(ctx context.Context, listArgs models.ListUserNotificationsParams) ([]models.Notification, error) {
+	notifications, err := r.Queries.ListUserNotifications(ctx, listArgs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(notifications), nil
+}
+
+// CountUserNotifications returns the number of notifications matching the filter and the number of unread ones
+func (r Dal) CountUserNotifications(ctx context.Context, userName string, unreadOnly bool) (total int64, unread int64, err error) {
+	counts, err := r.Queries.CountUserNotifications(ctx, models.CountUserNotificationsParams{
+		UnreadOnly: unreadOnly,
+		UserName:   userName,
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return counts.Total, counts.Unread, nil
+}
+
+var ErrNotificationNotFound = errors.New("notification not found")
+
+// MarkNotificationRead marks the notification of the user, a read notification keeps the first read time
+func (r Dal) MarkNotificationRead(ctx context.Context, notificationID int64, userName string) (*models.Notification, error) {
+	notification, err := r.Queries.MarkNotificationRead(ctx, models.MarkNotificationReadParams{
+		ID:       notificationID,
+		UserName: userName,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotificationNotFound
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &notification, nil
+}
+
+func (r Dal) MarkAllNotificationsRead(ctx context.Context, userName string) (int64, error) {
+	marked, err := r.Queries.MarkAllNotificationsRead(ctx, userName)
+	if err != nil {
+		return 0, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return marked, nil
+}
+
+func (r Dal) ListNotificationMutes(ctx context.Context, userName string) ([]string, error) {
+	kinds, err := r.Queries.ListNotificationMutes(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(kinds), nil
+}
+
+// SetNotificationMutes replaces muted kinds of the user, call it in a transaction
+func (r Dal) SetNotificationMutes(ctx context.Context, userName string, kinds []string) error {
+	if err := r.Queries.DeleteNotificationMutes(ctx, userName); err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	err := r.Queries.AddNotificationMutes(ctx, models.AddNotificationMutesParams{
+		UserName: userName,
+		Kinds:    kinds,
+	})
+	if err != nil {
+		return notificationError(err, fmt.Sprint(kinds))
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+const (
+	OrderStatusPlaced     = "placed"
+	OrderStatusReady      = "ready"
+	OrderStatusHandedOver = "handed_over"
+	OrderStatusCancelled  = "cancelled"
+)
+
+func (r Dal) CreateOrder(ctx context.Context, createArgs models.CreateOrderParams) (*models.MerchOrder, error) {
+	order, err := r.Queries.CreateOrder(ctx, createArgs)
+	if err == nil {
+		return &order, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "merch_orders_fk_user_name":
+			return nil, ErrInvalidRecipient
+		case "merch_orders_fk_payer":
+			return nil, ErrInvalidUser
+		case "merch_orders_fk_merch_item":
+			return nil, ErrInvalidMerchItem
+		case "merch_orders_price_non_negative":
+			return nil, ErrNegativePrice
+		case "merch_orders_fk_purchase_id", "merch_orders_fk_gift_id":
+			return nil, fmt.Errorf("%w: order source not found: this constraint must check upper layer", ErrInternal)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+var ErrOrderNotFound = errors.New("order not found")
+
+// GetOrder reads the order without locking it, for responses only
+func (r Dal) GetOrder(ctx context.Context, orderID int64) (*models.MerchOrder, error) {
+	order, err := r.Queries.GetOrder(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &order, nil
+}
+
+// GetOrderForUpdate locks the order row until the end of the transaction
+func (r Dal) GetOrderForUpdate(ctx context.Context, orderID int64) (*models.MerchOrder, error) {
+	order, err := r.Queries.GetOrderForUpdate(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &order, nil
+}
+
+// GetRefundOrderForUpdate locks the order of the refunded purchase until the end of the transaction,
+// purchases made before orders were introduced have no order
+func (r Dal) GetRefundOrderForUpdate(ctx context.Context, refundID int64) (*models.MerchOrder, error) {
+	order, err := r.Queries.GetRefundOrderForUpdate(ctx, refundID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &order, nil
+}
+
+func (r Dal) ListOrders(ctx context.Context, status string) ([]models.MerchOrder, error) {
+	orders, err := r.Queries.ListOrdersByStatus(ctx, status)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(orders), nil
+}
+
+// ListUserOrders returns orders the user has to pick up and orders the user paid for
+func (r Dal) ListUserOrders(ctx context.Context, userName string) ([]models.MerchOrder, error) {
+	orders, err := r.Queries.ListUserOrders(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(orders), nil
+}
+
+func (r Dal) UpdateOrderStatus(ctx context.Context, orderID int64, status string, updatedBy string) (*models.MerchOrder, error) {
+	order, err := r.Queries.UpdateOrderStatus(ctx, models.UpdateOrderStatusParams{
+		Status:    status,
+		UpdatedBy: pgtype.Text{String: updatedBy, Valid: true},
+		ID:        orderID,
+	})
+	if err == nil {
+		return &order, nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrOrderNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "merch_orders_status_valid":
+			return nil, fmt.Errorf("%w: invalid order status %q: this constraint must check upper layer", ErrInternal, status)
+		case "merch_orders_fk_updated_by":
+			return nil, ErrInvalidUser
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+// CancelPurchaseOrder cancels the not handed over order of the purchase without returning coins,
+// it is used when the purchase is refunded another way
+func (r Dal) CancelPurchaseOrder(ctx context.Context, purchaseID int64, updatedBy string) error {
+	err := r.Queries.CancelPurchaseOrder(ctx, models.CancelPurchaseOrderParams{
+		UpdatedBy:  pgtype.Text{String: updatedBy, Valid: true},
+		PurchaseID: purchaseID,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return nil
+}
+
+// RejectPurchaseRefunds rejects requested refunds of the purchase, it is used when coins
+// for the purchase are returned another way
+func (r Dal) RejectPurchaseRefunds(ctx context.Context, purchaseID int64, resolvedBy string) error {
+	err := r.Queries.RejectPurchaseRefunds(ctx, models.RejectPurchaseRefundsParams{
+		ResolvedBy: pgtype.Text{String: resolvedBy, Valid: true},
+		PurchaseID: purchaseID,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return nil
+}
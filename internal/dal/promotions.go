@@ -0,0 +1,182 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+var ErrPromoCodeAlreadyExists = errors.New("promo code already exists")
+var ErrInvalidPromotion = errors.New("invalid promotion parameters")
+
+func (r Dal) CreatePromotion(ctx context.Context, createArgs models.CreatePromotionParams) (*models.Promotion, error) {
+	promotion, err := r.Queries.CreatePromotion(ctx, createArgs)
+	if err == nil {
+		return &promotion, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "promotions_code_unique":
+			return nil, ErrPromoCodeAlreadyExists
+		case "promotions_fk_merch_item":
+			return nil, ErrInvalidMerchItem
+		case "promotions_percent_off_range",
+			"promotions_amount_off_non_negative",
+			"promotions_period_valid",
+			"promotions_max_redemptions_positive",
+			"promotions_max_redemptions_per_user_positive":
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPromotion, pgErr.ConstraintName)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+func (r Dal) ListPromotions(ctx context.Context) ([]models.Promotion, error) {
+	promotions, err := r.Queries.ListPromotions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(promotions), nil
+}
+
+// ListActiveSales returns running promotions without code applicable to the merch item,
+// rows are locked until the end of the transaction to keep redemption limits exact
+func (r Dal) ListActiveSales(ctx context.Context, merchName string) ([]models.Promotion, error) {
+	sales, err := r.Queries.ListActiveSales(ctx, merchName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return sales, nil
+}
+
+var ErrInvalidPromoCode = errors.New("invalid promo code")
+var ErrPromoCodeNotApplicable = errors.New("promo code is not applicable to this item now")
+
+// GetPromotionByCodeForUpdate locks the promotion row until the end of the transaction
+func (r Dal) GetPromotionByCodeForUpdate(ctx context.Context, code string) (*models.Promotion, error) {
+	promotion, err := r.Queries.GetPromotionByCodeForUpdate(ctx, code)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidPromoCode
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &promotion, nil
+}
+
+var ErrPromotionExhausted = errors.New("promotion redemption limit reached")
+
+// CheckPromotionLimits returns ErrPromotionExhausted if the promotion can not be redeemed
+// by the user anymore. The promotion row must be locked by the caller
+func (r Dal) CheckPromotionLimits(ctx context.Context, promotion models.Promotion, userName string) error {
+	if !promotion.MaxRedemptions.Valid && !promotion.MaxRedemptionsPerUser.Valid {
+		return nil
+	}
+
+	redemptions, err := r.Queries.CountPromotionRedemptions(ctx, models.CountPromotionRedemptionsParams{
+		UserName:    userName,
+		PromotionID: promotion.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+
+	if promotion.MaxRedemptions.Valid && redemptions.Total >= promotion.MaxRedemptions.Int64 {
+		return ErrPromotionExhausted
+	}
+	if promotion.MaxRedemptionsPerUser.Valid && redemptions.ByUser >= promotion.MaxRedemptionsPerUser.Int64 {
+		return ErrPromotionExhausted
+	}
+	return nil
+}
+
+func (r Dal) AddPromotionRedemption(ctx context.Context, promotionID int64, userName string, discount int64) (*models.PromotionRedemption, error) {
+	redemption, err := r.Queries.CreatePromotionRedemption(ctx, models.CreatePromotionRedemptionParams{
+		PromotionID: promotionID,
+		UserName:    userName,
+		Discount:    discount,
+	})
+	if err == nil {
+		return &redemption, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "promotion_redemptions_fk_promotion_id":
+			return nil, fmt.Errorf("%w: promotion not found: this constraint must check upper layer", ErrInternal)
+		case "promotion_redemptions_fk_user_name":
+			return nil, ErrInvalidUser
+		case "promotion_redemptions_discount_non_negative":
+			return nil, fmt.Errorf("%w: negative discount: this constraint must check upper layer", ErrInternal)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+var ErrBundleAlreadyExists = errors.New("bundle already exists")
+var ErrInvalidBundle = errors.New("invalid bundle")
+
+func (r Dal) CreateBundle(ctx context.Context, createArgs models.CreateBundleParams) (*models.MerchBundle, error) {
+	bundle, err := r.Queries.CreateBundle(ctx, createArgs)
+	if err == nil {
+		return &bundle, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "merch_bundles_pkey":
+			return nil, ErrBundleAlreadyExists
+		case "merch_bundles_price_non_negative", "merch_bundles_period_valid":
+			return nil, fmt.Errorf("%w: %s", ErrInvalidBundle, pgErr.ConstraintName)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+func (r Dal) AddBundleItem(ctx context.Context, createArgs models.CreateBundleItemParams) (*models.MerchBundleItem, error) {
+	item, err := r.Queries.CreateBundleItem(ctx, createArgs)
+	if err == nil {
+		return &item, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "merch_bundle_items_fk_merch_item":
+			return nil, ErrInvalidMerchItem
+		case "merch_bundle_items_fk_bundle":
+			return nil, fmt.Errorf("%w: bundle not found: this constraint must check upper layer", ErrInternal)
+		case "merch_bundle_items_pkey", "merch_bundle_items_quantity_positive":
+			return nil, fmt.Errorf("%w: %s", ErrInvalidBundle, pgErr.ConstraintName)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+func (r Dal) ListActiveBundles(ctx context.Context) ([]models.ListActiveBundlesRow, error) {
+	rows, err := r.Queries.ListActiveBundles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(rows), nil
+}
+
+// GetActiveBundleItems returns ErrInvalidBundle if the bundle does not exist or is not on sale now
+func (r Dal) GetActiveBundleItems(ctx context.Context, slug string) ([]models.GetActiveBundleItemsRow, error) {
+	items, err := r.Queries.GetActiveBundleItems(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	if len(items) == 0 {
+		return nil, ErrInvalidBundle
+	}
+	return items, nil
+}
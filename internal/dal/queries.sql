@@ -31,10 +31,27 @@ VALUES (@sender, @recipient, @amount)
 RETURNING *
 ;
 
+-- name: GetTransfer :one
+SELECT * FROM coin_transfers
+WHERE id = @id
+;
+
+-- name: ListUserTransfers :many
+SELECT * FROM coin_transfers
+WHERE sender = @user_name OR recipient = @user_name
+ORDER BY id DESC
+LIMIT @page_limit OFFSET @page_offset
+;
+
+-- name: CountUserTransfers :one
+SELECT count(*) FROM coin_transfers
+WHERE sender = @user_name OR recipient = @user_name
+;
+
 -- name: AddMerchItem :one
-INSERT INTO merch_ownership (user_name, merch_item, quantity)
-VALUES (@user_name, @merch_item, 1)
-ON CONFLICT (user_name, merch_item) DO UPDATE
+INSERT INTO merch_ownership (user_name, merch_item, variant, quantity)
+VALUES (@user_name, @merch_item, @variant, 1)
+ON CONFLICT (user_name, merch_item, variant) DO UPDATE
     SET quantity = merch_ownership.quantity + 1
 RETURNING *
 ;
@@ -45,10 +62,11 @@ WITH
         SELECT
             user_name,
             json_agg(
-                    json_build_array(merch_item, quantity)
-                ) as merch_item_quantity -- key_value json format
+                    json_build_array(merch_item, quantity, variant)
+                    ORDER BY merch_item, variant
+                ) as merch_item_quantity -- tuple json format
         FROM merch_ownership
-        WHERE user_name = @ft_user_name
+        WHERE user_name = @ft_user_name AND quantity > 0
         GROUP BY user_name
     ),
 
@@ -73,6 +91,29 @@ WITH
         FROM coin_transfers
         WHERE sender = @ft_user_name
         GROUP BY sender
+    ),
+
+    -- gifts received
+    user_name_gift_recipient AS (
+        SELECT
+            recipient as user_name,
+            json_agg(
+                    json_build_array(sender, merch_item, message)
+                ) as sender_item_message -- tuple json format
+        FROM merch_gifts
+        WHERE recipient = @ft_user_name
+        GROUP BY recipient
+    ),
+    -- gifts sent
+    user_name_gift_sender AS (
+        SELECT
+            sender as user_name,
+            json_agg(
+                    json_build_array(recipient, merch_item, message)
+                ) as recipient_item_message -- tuple json format
+        FROM merch_gifts
+        WHERE sender = @ft_user_name
+        GROUP BY sender
     )
 
 SELECT
@@ -80,7 +121,9 @@ SELECT
     coins,
     merch_item_quantity as inventory,
     recipient_amount as sent,
-    sender_amount as recived
+    sender_amount as recived,
+    recipient_item_message as gifts_sent,
+    sender_item_message as gifts_received
 FROM users
          LEFT JOIN user_name_inventory
                    ON users.name = user_name_inventory.user_name
@@ -88,5 +131,940 @@ FROM users
                    ON users.name = user_name_sender.user_name
          LEFT JOIN user_name_recipient
                    ON users.name = user_name_recipient.user_name
+         LEFT JOIN user_name_gift_sender
+                   ON users.name = user_name_gift_sender.user_name
+         LEFT JOIN user_name_gift_recipient
+                   ON users.name = user_name_gift_recipient.user_name
 WHERE name = @ft_user_name
-;
\ No newline at end of file
+;
+-- name: MinusMerchItem :one
+UPDATE merch_ownership
+SET
+    quantity = quantity - 1
+WHERE user_name = @user_name AND merch_item = @merch_item AND variant = @variant
+RETURNING *
+;
+
+-- name: CreatePurchase :one
+INSERT INTO merch_purchases (user_name, merch_item, variant, price, discount, bundle)
+VALUES (@user_name, @merch_item, @variant, @price, @discount, @bundle)
+RETURNING *
+;
+
+-- name: GetLastRefundablePurchase :one
+SELECT p.* FROM merch_purchases p
+WHERE p.user_name = @user_name
+  AND p.merch_item = @merch_item
+  AND p.variant = @variant
+  AND p.created_at >= @since
+  AND NOT EXISTS (
+      SELECT 1 FROM merch_refunds r
+      WHERE r.purchase_id = p.id AND r.status != 'rejected'
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM merch_orders o
+      WHERE o.purchase_id = p.id AND o.status = 'cancelled'
+  )
+ORDER BY p.created_at DESC
+LIMIT 1
+;
+
+-- name: CreateRefund :one
+INSERT INTO merch_refunds (purchase_id)
+VALUES (@purchase_id)
+RETURNING *
+;
+
+-- name: GetRefundForUpdate :one
+SELECT
+    r.*,
+    p.user_name,
+    p.merch_item,
+    p.variant,
+    p.price
+FROM merch_refunds r
+         JOIN merch_purchases p
+              ON p.id = r.purchase_id
+WHERE r.id = @id
+FOR UPDATE OF r
+;
+
+-- name: ListRefundsByStatus :many
+SELECT
+    r.*,
+    p.user_name,
+    p.merch_item,
+    p.variant,
+    p.price
+FROM merch_refunds r
+         JOIN merch_purchases p
+              ON p.id = r.purchase_id
+WHERE r.status = @status
+ORDER BY r.requested_at
+;
+
+-- name: ResolveRefund :one
+UPDATE merch_refunds
+SET
+    status = @status,
+    resolved_at = now(),
+    resolved_by = @resolved_by
+WHERE id = @id AND status = 'requested'
+RETURNING *
+;
+
+-- name: CreateGift :one
+INSERT INTO merch_gifts (sender, recipient, merch_item, variant, price, discount, message)
+VALUES (@sender, @recipient, @merch_item, @variant, @price, @discount, @message)
+RETURNING *
+;
+
+-- name: GetMerchVariant :one
+SELECT * FROM merch_variants
+WHERE merch_item = @merch_item AND variant = @variant
+;
+
+-- name: GetMerchVariantForUpdate :one
+SELECT * FROM merch_variants
+WHERE merch_item = @merch_item AND variant = @variant
+FOR UPDATE
+;
+
+-- name: TakeMerchVariantStock :one
+UPDATE merch_variants
+SET
+    stock = stock - @quantity::bigint
+WHERE merch_item = @merch_item AND variant = @variant AND stock IS NOT NULL
+RETURNING *
+;
+
+-- name: ReturnMerchVariantStock :exec
+UPDATE merch_variants
+SET
+    stock = stock + @quantity::bigint
+WHERE merch_item = @merch_item AND variant = @variant AND stock IS NOT NULL
+;
+
+-- name: CreatePromotion :one
+INSERT INTO promotions (
+    code, merch_item, percent_off, amount_off, stackable,
+    starts_at, ends_at, max_redemptions, max_redemptions_per_user
+)
+VALUES (
+    @code, @merch_item, @percent_off, @amount_off, @stackable,
+    @starts_at, @ends_at, @max_redemptions, @max_redemptions_per_user
+)
+RETURNING *
+;
+
+-- name: ListPromotions :many
+SELECT * FROM promotions
+ORDER BY id
+;
+
+-- name: ListActiveSales :many
+SELECT * FROM promotions
+WHERE code IS NULL
+  AND (merch_item IS NULL OR merch_item = @merch_item::varchar)
+  AND starts_at <= now() AND ends_at > now()
+ORDER BY id
+FOR UPDATE
+;
+
+-- name: GetPromotionByCodeForUpdate :one
+SELECT * FROM promotions
+WHERE code = @code::varchar
+FOR UPDATE
+;
+
+-- name: CountPromotionRedemptions :one
+SELECT
+    count(*) as total,
+    count(*) FILTER (WHERE user_name = @user_name) as by_user
+FROM promotion_redemptions
+WHERE promotion_id = @promotion_id
+;
+
+-- name: CreatePromotionRedemption :one
+INSERT INTO promotion_redemptions (promotion_id, user_name, discount)
+VALUES (@promotion_id, @user_name, @discount)
+RETURNING *
+;
+
+-- name: CreateBundle :one
+INSERT INTO merch_bundles (slug, price, starts_at, ends_at)
+VALUES (@slug, @price, @starts_at, @ends_at)
+RETURNING *
+;
+
+-- name: CreateBundleItem :one
+INSERT INTO merch_bundle_items (bundle, merch_item, quantity)
+VALUES (@bundle, @merch_item, @quantity)
+RETURNING *
+;
+
+-- name: ListActiveBundles :many
+SELECT
+    b.slug,
+    b.price,
+    b.starts_at,
+    b.ends_at,
+    i.merch_item,
+    i.quantity
+FROM merch_bundles b
+         JOIN merch_bundle_items i
+              ON i.bundle = b.slug
+WHERE b.starts_at <= now() AND b.ends_at > now()
+ORDER BY b.slug, i.merch_item
+;
+
+-- name: GetActiveBundleItems :many
+SELECT
+    b.price,
+    i.merch_item,
+    i.quantity
+FROM merch_bundles b
+         JOIN merch_bundle_items i
+              ON i.bundle = b.slug
+WHERE b.slug = @slug AND b.starts_at <= now() AND b.ends_at > now()
+ORDER BY i.merch_item
+;
+
+-- name: CreateOrder :one
+INSERT INTO merch_orders (user_name, payer, merch_item, variant, price, purchase_id, gift_id, pickup_code)
+VALUES (@user_name, @payer, @merch_item, @variant, @price, @purchase_id, @gift_id, @pickup_code)
+RETURNING *
+;
+
+-- name: GetOrder :one
+SELECT * FROM merch_orders
+WHERE id = @id
+;
+
+-- name: GetOrderForUpdate :one
+SELECT * FROM merch_orders
+WHERE id = @id
+FOR UPDATE
+;
+
+-- name: GetRefundOrderForUpdate :one
+SELECT o.* FROM merch_orders o
+         JOIN merch_refunds r
+              ON r.purchase_id = o.purchase_id
+WHERE r.id = @id
+FOR UPDATE OF o
+;
+
+-- name: ListOrdersByStatus :many
+SELECT * FROM merch_orders
+WHERE status = @status
+ORDER BY id
+;
+
+-- name: ListUserOrders :many
+SELECT * FROM merch_orders
+WHERE user_name = @user_name OR payer = @user_name
+ORDER BY id DESC
+;
+
+-- name: UpdateOrderStatus :one
+UPDATE merch_orders
+SET
+    status = @status,
+    updated_at = now(),
+    updated_by = @updated_by
+WHERE id = @id
+RETURNING *
+;
+
+-- name: CancelPurchaseOrder :exec
+UPDATE merch_orders
+SET
+    status = 'cancelled',
+    updated_at = now(),
+    updated_by = @updated_by
+WHERE purchase_id = @purchase_id::bigint AND status IN ('placed', 'ready')
+;
+
+-- name: RejectPurchaseRefunds :exec
+UPDATE merch_refunds
+SET
+    status = 'rejected',
+    resolved_at = now(),
+    resolved_by = @resolved_by
+WHERE purchase_id = @purchase_id AND status = 'requested'
+;
+
+-- name: TakeMerchItems :one
+UPDATE merch_ownership
+SET
+    quantity = quantity - @quantity
+WHERE user_name = @user_name AND merch_item = @merch_item AND variant = @variant
+RETURNING *
+;
+
+-- name: AddMerchItems :one
+INSERT INTO merch_ownership (user_name, merch_item, variant, quantity)
+VALUES (@user_name, @merch_item, @variant, @quantity)
+ON CONFLICT (user_name, merch_item, variant) DO UPDATE
+    SET quantity = merch_ownership.quantity + EXCLUDED.quantity
+RETURNING *
+;
+
+-- name: CreateListing :one
+INSERT INTO market_listings (seller, merch_item, variant, quantity, price)
+VALUES (@seller, @merch_item, @variant, @quantity, @price)
+RETURNING *
+;
+
+-- name: GetListingForUpdate :one
+SELECT * FROM market_listings
+WHERE id = @id
+FOR UPDATE
+;
+
+-- name: ListActiveListings :many
+SELECT * FROM market_listings
+WHERE status = 'active'
+  AND (@merch_item::varchar = '' OR merch_item = @merch_item::varchar)
+ORDER BY price, id
+;
+
+-- name: TakeListingUnits :one
+UPDATE market_listings
+SET
+    quantity = quantity - @quantity,
+    status = CASE WHEN quantity = @quantity THEN 'sold' ELSE status END,
+    closed_at = CASE WHEN quantity = @quantity THEN now() ELSE closed_at END
+WHERE id = @id AND status = 'active'
+RETURNING *
+;
+
+-- name: CancelListing :one
+UPDATE market_listings
+SET
+    quantity = 0,
+    status = 'cancelled',
+    closed_at = now()
+WHERE id = @id AND status = 'active'
+RETURNING *
+;
+
+-- name: CreateMarketSale :one
+INSERT INTO market_sales (listing_id, buyer, quantity, amount, fee)
+VALUES (@listing_id, @buyer, @quantity, @amount, @fee)
+RETURNING *
+;
+
+-- name: ListMerch :many
+SELECT * FROM merch
+WHERE (sqlc.narg(category)::varchar IS NULL OR category = sqlc.narg(category)::varchar)
+  AND (sqlc.narg(min_price)::bigint IS NULL OR price >= sqlc.narg(min_price)::bigint)
+  AND (sqlc.narg(max_price)::bigint IS NULL OR price <= sqlc.narg(max_price)::bigint)
+  AND (@search::varchar = ''
+    OR slug ILIKE '%' || @search::varchar || '%'
+    OR name_ru ILIKE '%' || @search::varchar || '%'
+    OR name_en ILIKE '%' || @search::varchar || '%'
+    OR description_ru ILIKE '%' || @search::varchar || '%'
+    OR description_en ILIKE '%' || @search::varchar || '%')
+ORDER BY
+    CASE WHEN @sort::varchar = 'price' THEN price END,
+    CASE WHEN @sort::varchar = '-price' THEN price END DESC,
+    CASE WHEN @sort::varchar = 'name' AND @lang::varchar = 'ru' THEN name_ru END,
+    CASE WHEN @sort::varchar = 'name' AND @lang::varchar != 'ru' THEN name_en END,
+    slug
+LIMIT @page_limit OFFSET @page_offset
+;
+
+-- name: CountMerch :one
+SELECT count(*) FROM merch
+WHERE (sqlc.narg(category)::varchar IS NULL OR category = sqlc.narg(category)::varchar)
+  AND (sqlc.narg(min_price)::bigint IS NULL OR price >= sqlc.narg(min_price)::bigint)
+  AND (sqlc.narg(max_price)::bigint IS NULL OR price <= sqlc.narg(max_price)::bigint)
+  AND (@search::varchar = ''
+    OR slug ILIKE '%' || @search::varchar || '%'
+    OR name_ru ILIKE '%' || @search::varchar || '%'
+    OR name_en ILIKE '%' || @search::varchar || '%'
+    OR description_ru ILIKE '%' || @search::varchar || '%'
+    OR description_en ILIKE '%' || @search::varchar || '%')
+;
+
+-- name: ListMerchCategories :many
+SELECT * FROM merch_categories
+ORDER BY slug
+;
+
+-- name: ListMerchBySlugs :many
+SELECT * FROM merch
+WHERE slug = ANY(@slugs::varchar[])
+ORDER BY slug
+;
+
+-- name: ListUserInventory :many
+SELECT * FROM merch_ownership
+WHERE user_name = @user_name AND quantity > 0
+ORDER BY merch_item, variant
+;
+
+-- name: AddWishlistItem :one
+INSERT INTO wishlists (user_name, merch_item, variant)
+VALUES (@user_name, @merch_item, @variant)
+RETURNING *
+;
+
+-- name: DeleteWishlistItem :execrows
+DELETE FROM wishlists
+WHERE user_name = @user_name AND merch_item = @merch_item AND variant = @variant
+;
+
+-- name: ListWishlist :many
+SELECT w.merch_item, w.variant, w.created_at,
+       COALESCE(v.price, m.price)::bigint AS price,
+       v.stock
+FROM wishlists w
+    JOIN merch m ON m.slug = w.merch_item
+    LEFT JOIN merch_variants v ON v.merch_item = w.merch_item AND v.variant = w.variant
+WHERE w.user_name = @user_name
+ORDER BY w.created_at, w.merch_item, w.variant
+;
+
+-- name: ListRunningSales :many
+SELECT * FROM promotions
+WHERE code IS NULL
+  AND starts_at <= now() AND ends_at > now()
+ORDER BY id
+;
+
+-- name: RestockMerchVariant :one
+UPDATE merch_variants
+SET
+    stock = coalesce(stock, 0) + @quantity::bigint
+WHERE merch_item = @merch_item AND variant = @variant
+RETURNING *
+;
+
+-- name: NotifyWishlistedUsers :execrows
+INSERT INTO notifications (user_name, kind, merch_item, message)
+SELECT DISTINCT user_name, @kind::varchar, merch_item, @message::text
+FROM wishlists
+WHERE (sqlc.narg(merch_item)::varchar IS NULL OR merch_item = sqlc.narg(merch_item))
+  AND (sqlc.narg(variant)::varchar IS NULL OR variant IN ('', sqlc.narg(variant)))
+  AND NOT EXISTS (
+    SELECT 1 FROM notification_mutes m
+    WHERE m.user_name = wishlists.user_name AND m.kind = @kind::varchar
+  )
+;
+
+-- name: AddNotification :execrows
+INSERT INTO notifications (user_name, kind, merch_item, message)
+SELECT @user_name::varchar, @kind::varchar, sqlc.narg(merch_item)::varchar, @message::text
+WHERE NOT EXISTS (
+    SELECT 1 FROM notification_mutes m
+    WHERE m.user_name = @user_name::varchar AND m.kind = @kind::varchar
+)
+;
+
+-- name: ListUserNotifications :many
+SELECT * FROM notifications
+WHERE user_name = @user_name
+  AND (NOT @unread_only::boolean OR read_at IS NULL)
+ORDER BY id DESC
+LIMIT @page_limit OFFSET @page_offset
+;
+
+-- name: CountUserNotifications :one
+SELECT
+    count(*) FILTER (WHERE NOT @unread_only::boolean OR read_at IS NULL) AS total,
+    count(*) FILTER (WHERE read_at IS NULL) AS unread
+FROM notifications
+WHERE user_name = @user_name
+;
+
+-- name: MarkNotificationRead :one
+UPDATE notifications
+SET
+    read_at = coalesce(read_at, now())
+WHERE id = @id AND user_name = @user_name
+RETURNING *
+;
+
+-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications
+SET
+    read_at = now()
+WHERE user_name = @user_name AND read_at IS NULL
+;
+
+-- name: ListNotificationMutes :many
+SELECT kind FROM notification_mutes
+WHERE user_name = @user_name
+ORDER BY kind
+;
+
+-- name: DeleteNotificationMutes :exec
+DELETE FROM notification_mutes
+WHERE user_name = @user_name
+;
+
+-- name: AddNotificationMutes :exec
+INSERT INTO notification_mutes (user_name, kind)
+SELECT @user_name, unnest(@kinds::varchar[])
+ON CONFLICT DO NOTHING
+;
+
+-- name: CreateAuction :one
+INSERT INTO auctions (merch_item, variant, start_price, min_step, extension_seconds, starts_at, ends_at)
+VALUES (@merch_item, @variant, @start_price, @min_step, @extension_seconds, @starts_at, @ends_at)
+RETURNING *
+;
+
+-- name: GetAuction :one
+SELECT * FROM auctions
+WHERE id = @id
+;
+
+-- name: GetAuctionForUpdate :one
+SELECT * FROM auctions
+WHERE id = @id
+FOR UPDATE
+;
+
+-- name: ListOpenAuctions :many
+SELECT * FROM auctions
+WHERE status = 'open'
+ORDER BY ends_at, id
+;
+
+-- name: ListAuctionBids :many
+SELECT * FROM auction_bids
+WHERE auction_id = @auction_id
+ORDER BY id DESC
+;
+
+-- name: CreateAuctionBid :one
+INSERT INTO auction_bids (auction_id, bidder, amount)
+VALUES (@auction_id, @bidder, @amount)
+RETURNING *
+;
+
+-- name: ReleaseAuctionBids :many
+UPDATE auction_bids
+SET
+    status = 'released',
+    updated_at = now()
+WHERE auction_id = @auction_id AND status = 'held'
+RETURNING *
+;
+
+-- name: WinAuctionBid :exec
+UPDATE auction_bids
+SET
+    status = 'won',
+    updated_at = now()
+WHERE auction_id = @auction_id AND status = 'held'
+;
+
+-- name: UpdateAuctionLead :one
+UPDATE auctions
+SET
+    leader = @leader,
+    leading_bid = @leading_bid,
+    -- anti-sniping: a late bid gives others the extension time to answer
+    ends_at = greatest(ends_at, now() + extension_seconds * interval '1 second')
+WHERE id = @id AND status = 'open' AND ends_at > now()
+RETURNING *
+;
+
+-- name: GetTransactionTime :one
+SELECT now()::timestamptz AS now
+;
+
+-- name: ListDueAuctionsForUpdate :many
+SELECT * FROM auctions
+WHERE status = 'open' AND ends_at <= now()
+ORDER BY ends_at, id
+LIMIT @max_auctions
+FOR UPDATE SKIP LOCKED
+;
+
+-- name: CloseAuction :one
+UPDATE auctions
+SET
+    status = 'closed',
+    closed_at = now()
+WHERE id = @id AND status = 'open'
+RETURNING *
+;
+
+-- name: CreateRaffle :one
+INSERT INTO raffles (merch_item, variant, ticket_price, winners, max_tickets_per_user, sales_end_at, seed_hash, seed, created_by)
+VALUES (@merch_item, @variant, @ticket_price, @winners, @max_tickets_per_user, @sales_end_at, @seed_hash, @seed, @created_by)
+RETURNING *
+;
+
+-- name: GetRaffle :one
+SELECT * FROM raffles
+WHERE id = @id
+;
+
+-- name: GetRaffleForUpdate :one
+SELECT * FROM raffles
+WHERE id = @id
+FOR UPDATE
+;
+
+-- name: ListRaffles :many
+SELECT * FROM raffles
+ORDER BY id DESC
+;
+
+-- name: CreateRaffleTicket :one
+INSERT INTO raffle_tickets (raffle_id, user_name, price)
+VALUES (@raffle_id, @user_name, @price)
+RETURNING *
+;
+
+-- name: CountUserRaffleTickets :one
+SELECT count(*) FROM raffle_tickets
+WHERE raffle_id = @raffle_id AND user_name = @user_name
+;
+
+-- name: ListRaffleTickets :many
+SELECT * FROM raffle_tickets
+WHERE raffle_id = @raffle_id
+ORDER BY id
+;
+
+-- name: WinRaffleTicket :exec
+UPDATE raffle_tickets
+SET
+    won = true
+WHERE id = @id
+;
+
+-- name: DrawRaffle :one
+UPDATE raffles
+SET
+    status = 'drawn',
+    drawn_at = now(),
+    drawn_by = @drawn_by
+WHERE id = @id AND status = 'open'
+RETURNING *
+;
+
+-- name: ListUserEventsAfter :many
+SELECT * FROM user_events
+WHERE user_name = @user_name AND seq > @after_seq::bigint
+ORDER BY seq
+LIMIT @page_limit
+;
+
+-- name: GetLastUserEventSeq :one
+SELECT coalesce(max(seq), 0)::bigint AS last_seq FROM user_events
+WHERE user_name = @user_name
+;
+
+-- name: LockUserEventsSequencer :exec
+SELECT pg_advisory_xact_lock(hashtext('user_events_seq'))
+;
+
+-- name: SequenceUserEvents :execrows
+UPDATE user_events
+SET
+    seq = ordered.seq
+FROM (SELECT id, nextval('user_events_seq') AS seq
+      FROM (SELECT id FROM user_events WHERE seq IS NULL ORDER BY id) AS ids) AS ordered
+WHERE user_events.id = ordered.id
+;
+
+-- name: DeleteUserEventsBefore :execrows
+DELETE FROM user_events
+WHERE created_at < @before
+;
+
+-- name: AddOutboxEvent :one
+INSERT INTO outbox_events (event_type, payload)
+VALUES (@event_type, @payload)
+RETURNING *
+;
+
+-- name: ListUnpublishedOutboxEventsForUpdate :many
+SELECT * FROM outbox_events
+WHERE bus_published_at IS NULL
+ORDER BY id
+LIMIT @batch_size
+FOR UPDATE SKIP LOCKED
+;
+
+-- name: MarkOutboxEventsPublished :exec
+UPDATE outbox_events
+SET
+    bus_published_at = now()
+WHERE id = ANY(@ids::bigint[])
+;
+
+-- name: DispatchOutboxEventsToWebhooks :execrows
+WITH due AS (
+    SELECT id, event_type FROM outbox_events
+    WHERE webhooks_dispatched_at IS NULL
+    ORDER BY id
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+), deliveries AS (
+    INSERT INTO webhook_deliveries (subscription_id, event_id)
+    SELECT s.id, due.id
+    FROM due
+    JOIN webhook_subscriptions s ON due.event_type = ANY (s.event_types)
+    ON CONFLICT DO NOTHING
+)
+UPDATE outbox_events
+SET
+    webhooks_dispatched_at = now()
+WHERE id IN (SELECT id FROM due)
+;
+
+-- name: ClaimDueWebhookDeliveries :many
+WITH claimed AS (
+    UPDATE webhook_deliveries
+    SET
+        next_attempt_at = @lease_until
+    WHERE webhook_deliveries.id IN (
+        SELECT id FROM webhook_deliveries
+        WHERE status = 'pending' AND next_attempt_at <= now()
+        ORDER BY next_attempt_at
+        LIMIT @batch_size
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING *
+)
+SELECT claimed.id, claimed.attempts, s.url, s.secret, e.id AS event_id, e.event_type, e.payload, e.created_at AS event_created_at
+FROM claimed
+JOIN webhook_subscriptions s ON s.id = claimed.subscription_id
+JOIN outbox_events e ON e.id = claimed.event_id
+ORDER BY claimed.id
+;
+
+-- name: MarkWebhookDelivered :exec
+UPDATE webhook_deliveries
+SET
+    status = 'delivered',
+    attempts = attempts + 1,
+    last_status_code = @last_status_code,
+    last_error = NULL,
+    delivered_at = now()
+WHERE id = @id
+;
+
+-- name: MarkWebhookFailed :exec
+UPDATE webhook_deliveries
+SET
+    status = CASE WHEN attempts + 1 >= @max_attempts::int THEN 'dead' ELSE 'pending' END,
+    attempts = attempts + 1,
+    last_status_code = @last_status_code,
+    last_error = @last_error,
+    next_attempt_at = @next_attempt_at
+WHERE id = @id
+;
+
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (url, secret, event_types, created_by)
+VALUES (@url, @secret, @event_types, @created_by)
+RETURNING *
+;
+
+-- name: ListWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+ORDER BY id
+;
+
+-- name: GetWebhookSubscription :one
+SELECT * FROM webhook_subscriptions
+WHERE id = @id
+;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = @id
+;
+
+-- name: ListWebhookDeliveries :many
+SELECT d.*, e.event_type
+FROM webhook_deliveries d
+JOIN outbox_events e ON e.id = d.event_id
+WHERE d.subscription_id = @subscription_id AND (@status::varchar = '' OR d.status = @status)
+ORDER BY d.id DESC
+LIMIT @page_limit OFFSET @page_offset
+;
+
+-- name: RedeliverWebhookDelivery :one
+WITH redelivered AS (
+    UPDATE webhook_deliveries
+    SET
+        status = 'pending',
+        attempts = 0,
+        next_attempt_at = now(),
+        delivered_at = NULL
+    WHERE webhook_deliveries.id = @id
+    RETURNING *
+)
+SELECT redelivered.*, e.event_type
+FROM redelivered
+JOIN outbox_events e ON e.id = redelivered.event_id
+;
+
+-- name: GetEmailSettings :one
+SELECT user_name, enabled, language FROM email_settings
+WHERE user_name = @user_name
+;
+
+-- name: UpsertEmailSettings :one
+INSERT INTO email_settings (user_name, enabled, language)
+VALUES (@user_name, @enabled, @language)
+ON CONFLICT (user_name) DO UPDATE
+SET
+    enabled = excluded.enabled,
+    language = excluded.language
+RETURNING user_name, enabled, language
+;
+
+-- name: AddEmailMessage :exec
+INSERT INTO email_messages (recipient, kind, subject, text_body, html_body)
+VALUES (@recipient, @kind, @subject, @text_body, @html_body)
+;
+
+-- name: ClaimDueEmailMessages :many
+UPDATE email_messages
+SET
+    next_attempt_at = @lease_until
+WHERE email_messages.id IN (
+    SELECT id FROM email_messages
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, recipient, kind, subject, text_body, html_body, status, attempts, next_attempt_at, last_error, sent_at, created_at
+;
+
+-- name: MarkEmailSent :exec
+UPDATE email_messages
+SET
+    status = 'sent',
+    attempts = attempts + 1,
+    last_error = NULL,
+    sent_at = now()
+WHERE id = @id
+;
+
+-- name: MarkEmailFailed :exec
+UPDATE email_messages
+SET
+    status = CASE WHEN attempts + 1 >= @max_attempts::int THEN 'dead' ELSE 'pending' END,
+    attempts = attempts + 1,
+    last_error = @last_error,
+    next_attempt_at = @next_attempt_at
+WHERE id = @id
+;
+
+-- name: CountAuthTokensSince :one
+SELECT count(*) FROM auth_tokens
+WHERE user_name = @user_name AND purpose = @purpose AND created_at > @since
+;
+
+-- name: AddAuthToken :exec
+INSERT INTO auth_tokens (user_name, purpose, token_hash, expires_at)
+VALUES (@user_name, @purpose, @token_hash, @expires_at)
+;
+
+-- name: UseAuthToken :one
+UPDATE auth_tokens
+SET
+    used_at = now()
+WHERE token_hash = @token_hash AND purpose = @purpose AND used_at IS NULL AND expires_at > now()
+RETURNING user_name
+;
+
+-- name: RevokeAuthTokens :exec
+UPDATE auth_tokens
+SET
+    used_at = now()
+WHERE user_name = @user_name AND purpose = @purpose AND used_at IS NULL
+;
+
+-- name: MarkEmailVerified :exec
+INSERT INTO email_verifications (user_name)
+VALUES (@user_name)
+ON CONFLICT DO NOTHING
+;
+
+-- name: IsEmailVerified :one
+SELECT EXISTS (
+    SELECT 1 FROM email_verifications
+    WHERE user_name = @user_name
+)
+;
+
+-- name: UpdateUserPassword :execrows
+UPDATE users
+SET
+    password = @password
+WHERE name = @name
+;
+
+-- name: GetUserTotp :one
+SELECT * FROM user_totp
+WHERE user_name = @user_name
+;
+
+-- name: UpsertUserTotp :one
+INSERT INTO user_totp (user_name, secret)
+VALUES (@user_name, @secret)
+ON CONFLICT (user_name) DO UPDATE
+SET
+    secret = excluded.secret,
+    created_at = now()
+WHERE user_totp.confirmed_at IS NULL
+RETURNING *
+;
+
+-- name: ConfirmUserTotp :execrows
+UPDATE user_totp
+SET
+    confirmed_at = now(),
+    last_used_step = @step
+WHERE user_name = @user_name AND confirmed_at IS NULL AND last_used_step < @step
+;
+
+-- name: UseTotpStep :execrows
+UPDATE user_totp
+SET
+    last_used_step = @step
+WHERE user_name = @user_name AND confirmed_at IS NOT NULL AND last_used_step < @step
+;
+
+-- name: DeleteUserTotp :exec
+DELETE FROM user_totp
+WHERE user_name = @user_name
+;
+
+-- name: AddTotpRecoveryCodes :exec
+INSERT INTO totp_recovery_codes (user_name, code_hash)
+SELECT @user_name::varchar, unnest(@code_hashes::bytea[])
+;
+
+-- name: UseTotpRecoveryCode :execrows
+UPDATE totp_recovery_codes
+SET
+    used_at = now()
+WHERE user_name = @user_name AND code_hash = @code_hash AND used_at IS NULL
+;
+
+-- name: CountTotpRecoveryCodes :one
+SELECT count(*) FROM totp_recovery_codes
+WHERE user_name = @user_name AND used_at IS NULL
+;
+
+-- name: DeleteTotpRecoveryCodes :exec
+DELETE FROM totp_recovery_codes
+WHERE user_name = @user_name
+;
@@ -0,0 +1,146 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+const (
+	RaffleStatusOpen  = "open"
+	RaffleStatusDrawn = "drawn"
+)
+
+var ErrInvalidRaffle = errors.New("invalid raffle parameters")
+
+func (r Dal) CreateRaffle(ctx context.Context, createArgs models.CreateRaffleParams) (*models.Raffle, error) {
+	raffle, err := r.Queries.CreateRaffle(ctx, createArgs)
+	if err == nil {
+		return &raffle, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "raffles_fk_merch_item":
+			return nil, ErrInvalidMerchItem
+		case "raffles_fk_created_by":
+			return nil, ErrInvalidUser
+		case "raffles_ticket_price_positive", "raffles_winners_positive", "raffles_max_tickets_per_user_positive":
+			return nil, fmt.Errorf("%w: %s", ErrInvalidRaffle, pgErr.ConstraintName)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+var ErrRaffleNotFound = errors.New("raffle not found")
+
+func (r Dal) GetRaffle(ctx context.Context, raffleID int64) (*models.Raffle, error) {
+	raffle, err := r.Queries.GetRaffle(ctx, raffleID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRaffleNotFound
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &raffle, nil
+}
+
+// GetRaffleForUpdate locks the raffle row until the end of the transaction,
+// ticket sales and the draw are serialized by this lock
+func (r Dal) GetRaffleForUpdate(ctx context.Context, raffleID int64) (*models.Raffle, error) {
+	raffle, err := r.Queries.GetRaffleForUpdate(ctx, raffleID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRaffleNotFound
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &raffle, nil
+}
+
+func (r Dal) ListRaffles(ctx context.Context) ([]models.Raffle, error) {
+	raffles, err := r.Queries.ListRaffles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(raffles), nil
+}
+
+func (r Dal) AddRaffleTicket(ctx context.Context, createArgs models.CreateRaffleTicketParams) (*models.RaffleTicket, error) {
+	ticket, err := r.Queries.CreateRaffleTicket(ctx, createArgs)
+	if err == nil {
+		return &ticket, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "raffle_tickets_fk_user_name":
+			return nil, ErrInvalidUser
+		case "raffle_tickets_fk_raffle_id":
+			return nil, fmt.Errorf("%w: raffle not found: this constraint must check upper layer", ErrInternal)
+		case "raffle_tickets_price_non_negative":
+			return nil, ErrNegativePrice
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+func (r Dal) CountUserRaffleTickets(ctx context.Context, raffleID int64, userName string) (int64, error) {
+	count, err := r.Queries.CountUserRaffleTickets(ctx, models.CountUserRaffleTicketsParams{
+		RaffleID: raffleID,
+		UserName: userName,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return count, nil
+}
+
+// ListRaffleTickets returns tickets in the order they were sold, the draw relies on this order
+func (r Dal) ListRaffleTickets(ctx context.Context, raffleID int64) ([]models.RaffleTicket, error) {
+	tickets, err := r.Queries.ListRaffleTickets(ctx, raffleID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(tickets), nil
+}
+
+func (r Dal) WinRaffleTicket(ctx context.Context, ticketID int64) error {
+	if err := r.Queries.WinRaffleTicket(ctx, ticketID); err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return nil
+}
+
+var ErrRaffleDrawn = errors.New("raffle is already drawn")
+
+func (r Dal) DrawRaffle(ctx context.Context, raffleID int64, drawnBy string) (*models.Raffle, error) {
+	raffle, err := r.Queries.DrawRaffle(ctx, models.DrawRaffleParams{
+		DrawnBy: pgtype.Text{String: drawnBy, Valid: true},
+		ID:      raffleID,
+	})
+	if err == nil {
+		return &raffle, nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRaffleDrawn
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "raffles_fk_drawn_by":
+			return nil, ErrInvalidUser
+		case "raffles_status_valid":
+			return nil, fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
@@ -0,0 +1,161 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+const (
+	RefundStatusRequested = "requested"
+	RefundStatusApproved  = "approved"
+	RefundStatusRejected  = "rejected"
+)
+
+var ErrNotEnoughMerch = errors.New("the user does not have enough merch items")
+
+func (r Dal) MinusMerchFromUser(ctx context.Context, userName string, merchName string) (*models.MerchOwnership, error) {
+	return r.MinusMerchVariantFromUser(ctx, userName, merchName, "")
+}
+
+func (r Dal) MinusMerchVariantFromUser(ctx context.Context, userName string, merchName string, variant string) (*models.MerchOwnership, error) {
+	merchOwn, err := r.Queries.MinusMerchItem(ctx, models.MinusMerchItemParams{
+		UserName:  userName,
+		MerchItem: merchName,
+		Variant:   variant,
+	})
+	if err == nil {
+		return &merchOwn, nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotEnoughMerch
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "merch_ownership_quantity_non_negative":
+			return nil, ErrNotEnoughMerch
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+var ErrNegativePrice = errors.New("price must be non negative")
+
+func (r Dal) AddPurchase(ctx context.Context, createArgs models.CreatePurchaseParams) (*models.MerchPurchase, error) {
+	purchase, err := r.Queries.CreatePurchase(ctx, createArgs)
+	if err == nil {
+		return &purchase, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "merch_purchases_fk_user_name":
+			return nil, ErrInvalidUser
+		case "merch_purchases_fk_merch_item":
+			return nil, ErrInvalidMerchItem
+		case "merch_purchases_price_non_negative", "merch_purchases_discount_non_negative":
+			return nil, ErrNegativePrice
+		case "merch_purchases_fk_bundle":
+			return nil, ErrInvalidBundle
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+var ErrNoRefundablePurchase = errors.New("no purchase of this item available for refund")
+
+// GetLastRefundablePurchase returns the newest purchase made after since
+// that has no requested or approved refund yet
+func (r Dal) GetLastRefundablePurchase(ctx context.Context, userName string, merchName string, variant string, since time.Time) (*models.MerchPurchase, error) {
+	purchase, err := r.Queries.GetLastRefundablePurchase(ctx, models.GetLastRefundablePurchaseParams{
+		UserName:  userName,
+		MerchItem: merchName,
+		Variant:   variant,
+		Since:     pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNoRefundablePurchase
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &purchase, nil
+}
+
+var ErrRefundAlreadyRequested = errors.New("refund for this purchase already requested")
+
+func (r Dal) CreateRefund(ctx context.Context, purchaseID int64) (*models.MerchRefund, error) {
+	refund, err := r.Queries.CreateRefund(ctx, purchaseID)
+	if err == nil {
+		return &refund, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "merch_refunds_active_purchase_id":
+			return nil, ErrRefundAlreadyRequested
+		case "merch_refunds_fk_purchase_id":
+			return nil, fmt.Errorf("%w: purchase to refund not found: this constraint must check upper layer", ErrInternal)
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+var ErrRefundNotFound = errors.New("refund not found")
+
+// GetRefundForUpdate locks the refund row until the end of the transaction
+func (r Dal) GetRefundForUpdate(ctx context.Context, refundID int64) (*models.GetRefundForUpdateRow, error) {
+	refund, err := r.Queries.GetRefundForUpdate(ctx, refundID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRefundNotFound
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &refund, nil
+}
+
+func (r Dal) ListRefunds(ctx context.Context, status string) ([]models.ListRefundsByStatusRow, error) {
+	refunds, err := r.Queries.ListRefundsByStatus(ctx, status)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(refunds), nil
+}
+
+var ErrRefundAlreadyResolved = errors.New("refund already resolved")
+
+func (r Dal) ResolveRefund(ctx context.Context, refundID int64, status string, resolvedBy string) (*models.MerchRefund, error) {
+	refund, err := r.Queries.ResolveRefund(ctx, models.ResolveRefundParams{
+		Status:     status,
+		ResolvedBy: pgtype.Text{String: resolvedBy, Valid: true},
+		ID:         refundID,
+	})
+	if err == nil {
+		return &refund, nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRefundAlreadyResolved
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "merch_refunds_status_valid":
+			return nil, fmt.Errorf("%w: invalid refund status %q: this constraint must check upper layer", ErrInternal, status)
+		case "merch_refunds_fk_resolved_by":
+			return nil, ErrInvalidUser
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
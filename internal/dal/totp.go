@@ -0,0 +1,131 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+var ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+var ErrTwoFactorNotEnabled = errors.New("two-factor authentication is not enabled")
+var ErrInvalidOTP = errors.New("one-time password is invalid or already used")
+
+// GetUserTotp returns nil if the user never enrolled, enrolled secrets are not confirmed until ConfirmedAt is set
+func (r Dal) GetUserTotp(ctx context.Context, userName string) (*models.UserTotp, error) {
+	userTotp, err := r.Queries.GetUserTotp(ctx, userName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &userTotp, nil
+}
+
+// EnrollTotp replaces the secret of a not confirmed enrollment
+func (r Dal) EnrollTotp(ctx context.Context, userName string, secret []byte) (*models.UserTotp, error) {
+	userTotp, err := r.Queries.UpsertUserTotp(ctx, models.UpsertUserTotpParams{
+		UserName: userName,
+		Secret:   secret,
+	})
+	if err == nil {
+		return &userTotp, nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == "user_totp_fk_user_name" {
+		return nil, ErrInvalidUser
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+// ConfirmTotp enables the enrollment, step is the one of the code the user entered
+func (r Dal) ConfirmTotp(ctx context.Context, userName string, step int64) error {
+	confirmed, err := r.Queries.ConfirmUserTotp(ctx, models.ConfirmUserTotpParams{
+		Step:     step,
+		UserName: userName,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	if confirmed == 0 {
+		return ErrInvalidOTP
+	}
+	return nil
+}
+
+// UseTotpStep fails with ErrInvalidOTP if a code of the step or a later one was already used
+func (r Dal) UseTotpStep(ctx context.Context, userName string, step int64) error {
+	used, err := r.Queries.UseTotpStep(ctx, models.UseTotpStepParams{
+		Step:     step,
+		UserName: userName,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	if used == 0 {
+		return ErrInvalidOTP
+	}
+	return nil
+}
+
+// DisableTotp deletes the secret and the recovery codes of the user
+func (r Dal) DisableTotp(ctx context.Context, userName string) error {
+	if err := r.Queries.DeleteTotpRecoveryCodes(ctx, userName); err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	if err := r.Queries.DeleteUserTotp(ctx, userName); err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return nil
+}
+
+// ReplaceTotpRecoveryCodes makes the previous recovery codes of the user invalid
+func (r Dal) ReplaceTotpRecoveryCodes(ctx context.Context, userName string, codeHashes [][]byte) error {
+	if err := r.Queries.DeleteTotpRecoveryCodes(ctx, userName); err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+
+	err := r.Queries.AddTotpRecoveryCodes(ctx, models.AddTotpRecoveryCodesParams{
+		UserName:   userName,
+		CodeHashes: codeHashes,
+	})
+	if err == nil {
+		return nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == "totp_recovery_codes_fk_user_name" {
+		return ErrInvalidUser
+	}
+	return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+func (r Dal) UseTotpRecoveryCode(ctx context.Context, userName string, codeHash []byte) error {
+	used, err := r.Queries.UseTotpRecoveryCode(ctx, models.UseTotpRecoveryCodeParams{
+		UserName: userName,
+		CodeHash: codeHash,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	if used == 0 {
+		return ErrInvalidOTP
+	}
+	return nil
+}
+
+func (r Dal) CountTotpRecoveryCodes(ctx context.Context, userName string) (int64, error) {
+	count, err := r.Queries.CountTotpRecoveryCodes(ctx, userName)
+	if err != nil {
+		return 0, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return count, nil
+}
@@ -0,0 +1,105 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+// merch and merch_variants rows are added by migrations only, the dal changes nothing but the stock of variants.
+// So merch_fk_category, merch_price_non_negative, merch_variants_fk_merch_item, merch_variants_price_non_negative
+// and merch_variants_variant_not_empty can not be violated here and have no errors
+
+var ErrInvalidMerchVariant = errors.New("invalid merch variant")
+
+func (r Dal) GetMerchVariant(ctx context.Context, merchName string, variant string) (*models.MerchVariant, error) {
+	merchVariant, err := r.Queries.GetMerchVariant(ctx, models.GetMerchVariantParams{
+		MerchItem: merchName,
+		Variant:   variant,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidMerchVariant
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &merchVariant, nil
+}
+
+// GetMerchVariantForUpdate locks the variant row until the end of the transaction
+func (r Dal) GetMerchVariantForUpdate(ctx context.Context, merchName string, variant string) (*models.MerchVariant, error) {
+	merchVariant, err := r.Queries.GetMerchVariantForUpdate(ctx, models.GetMerchVariantForUpdateParams{
+		MerchItem: merchName,
+		Variant:   variant,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidMerchVariant
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &merchVariant, nil
+}
+
+var ErrOutOfStock = errors.New("merch variant is out of stock")
+
+// TakeMerchVariantStock takes quantity from variant stock, variants without stock are unlimited and stay untouched
+func (r Dal) TakeMerchVariantStock(ctx context.Context, merchName string, variant string, quantity int64) error {
+	_, err := r.Queries.TakeMerchVariantStock(ctx, models.TakeMerchVariantStockParams{
+		Quantity:  quantity,
+		MerchItem: merchName,
+		Variant:   variant,
+	})
+	if err == nil || errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "merch_variants_stock_non_negative":
+			return ErrOutOfStock
+		}
+	}
+	return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+func (r Dal) ReturnMerchVariantStock(ctx context.Context, merchName string, variant string, quantity int64) error {
+	err := r.Queries.ReturnMerchVariantStock(ctx, models.ReturnMerchVariantStockParams{
+		Quantity:  quantity,
+		MerchItem: merchName,
+		Variant:   variant,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return nil
+}
+
+// RestockMerchVariant adds quantity to the variant stock, an unlimited variant gets stock of quantity
+func (r Dal) RestockMerchVariant(ctx context.Context, merchName string, variant string, quantity int64) (*models.MerchVariant, error) {
+	merchVariant, err := r.Queries.RestockMerchVariant(ctx, models.RestockMerchVariantParams{
+		Quantity:  quantity,
+		MerchItem: merchName,
+		Variant:   variant,
+	})
+	if err == nil {
+		return &merchVariant, nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrInvalidMerchVariant
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "merch_variants_stock_non_negative":
+			return nil, ErrOutOfStock
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
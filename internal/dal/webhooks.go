@@ -0,0 +1,160 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+// AddOutboxEvent stores the event in the transaction of r, it is published only if the transaction commits
+func (r Dal) AddOutboxEvent(ctx context.Context, eventType string, payload []byte) (*models.OutboxEvent, error) {
+	event, err := r.Queries.AddOutboxEvent(ctx, models.AddOutboxEventParams{
+		EventType: eventType,
+		Payload:   payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &event, nil
+}
+
+// DispatchOutboxEventsToWebhooks creates deliveries of a batch of new events for subscriptions of their type
+// and returns how many events were dispatched. Events locked by other instances are skipped.
+// A delivery that already exists is kept, so webhook_deliveries_subscription_event_unique is never violated
+func (r Dal) DispatchOutboxEventsToWebhooks(ctx context.Context, batchSize int32) (int64, error) {
+	dispatched, err := r.Queries.DispatchOutboxEventsToWebhooks(ctx, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return dispatched, nil
+}
+
+// ClaimDueWebhookDeliveries leases due deliveries until leaseUntil, so other instances do not send them meanwhile.
+// A delivery of a crashed instance is retried after the lease
+func (r Dal) ClaimDueWebhookDeliveries(ctx context.Context, leaseUntil time.Time, batchSize int32) ([]models.ClaimDueWebhookDeliveriesRow, error) {
+	deliveries, err := r.Queries.ClaimDueWebhookDeliveries(ctx, models.ClaimDueWebhookDeliveriesParams{
+		LeaseUntil: pgtype.Timestamptz{Time: leaseUntil, Valid: true},
+		BatchSize:  batchSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(deliveries), nil
+}
+
+func (r Dal) MarkWebhookDelivered(ctx context.Context, deliveryID int64, statusCode int) error {
+	err := r.Queries.MarkWebhookDelivered(ctx, models.MarkWebhookDeliveredParams{
+		LastStatusCode: pgtype.Int4{Int32: int32(statusCode), Valid: true},
+		ID:             deliveryID,
+	})
+	if err == nil {
+		return nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == "webhook_deliveries_status_valid" {
+		return fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+	}
+	return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+// MarkWebhookFailed schedules the next attempt, the delivery becomes dead after maxAttempts.
+// statusCode is 0 if there was no response
+func (r Dal) MarkWebhookFailed(ctx context.Context, deliveryID int64, statusCode int, lastError string, nextAttemptAt time.Time, maxAttempts int32) error {
+	err := r.Queries.MarkWebhookFailed(ctx, models.MarkWebhookFailedParams{
+		MaxAttempts:    maxAttempts,
+		LastStatusCode: pgtype.Int4{Int32: int32(statusCode), Valid: statusCode != 0},
+		LastError:      pgtype.Text{String: lastError, Valid: true},
+		NextAttemptAt:  pgtype.Timestamptz{Time: nextAttemptAt, Valid: true},
+		ID:             deliveryID,
+	})
+	if err == nil {
+		return nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == "webhook_deliveries_status_valid" {
+		return fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+	}
+	return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+func (r Dal) CreateWebhookSubscription(ctx context.Context, createArgs models.CreateWebhookSubscriptionParams) (*models.WebhookSubscription, error) {
+	subscription, err := r.Queries.CreateWebhookSubscription(ctx, createArgs)
+	if err == nil {
+		return &subscription, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == "webhook_subscriptions_fk_created_by" {
+		return nil, ErrInvalidUser
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+func (r Dal) ListWebhookSubscriptions(ctx context.Context) ([]models.WebhookSubscription, error) {
+	subscriptions, err := r.Queries.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(subscriptions), nil
+}
+
+var ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+
+func (r Dal) GetWebhookSubscription(ctx context.Context, subscriptionID int64) (*models.WebhookSubscription, error) {
+	subscription, err := r.Queries.GetWebhookSubscription(ctx, subscriptionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWebhookSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return &subscription, nil
+}
+
+// DeleteWebhookSubscription deletes the subscription with its deliveries
+func (r Dal) DeleteWebhookSubscription(ctx context.Context, subscriptionID int64) error {
+	deleted, err := r.Queries.DeleteWebhookSubscription(ctx, subscriptionID)
+	if err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	if deleted == 0 {
+		return ErrWebhookSubscriptionNotFound
+	}
+	return nil
+}
+
+// ListWebhookDeliveries returns deliveries of the subscription, newest first, status "" means any
+func (r Dal) ListWebhookDeliveries(ctx context.Context, listArgs models.ListWebhookDeliveriesParams) ([]models.ListWebhookDeliveriesRow, error) {
+	deliveries, err := r.Queries.ListWebhookDeliveries(ctx, listArgs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(deliveries), nil
+}
+
+var ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+
+// RedeliverWebhookDelivery queues the delivery again with all attempts available
+func (r Dal) RedeliverWebhookDelivery(ctx context.Context, deliveryID int64) (*models.RedeliverWebhookDeliveryRow, error) {
+	delivery, err := r.Queries.RedeliverWebhookDelivery(ctx, deliveryID)
+	if err == nil {
+		return &delivery, nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == "webhook_deliveries_status_valid" {
+		return nil, fmt.Errorf("%w: %s: this constraint must check upper layer", ErrInternal, pgErr.ConstraintName)
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
@@ -0,0 +1,71 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+var ErrAlreadyInWishlist = errors.New("merch item is already in the wishlist")
+
+func (r Dal) AddWishlistItem(ctx context.Context, userName string, merchName string, variant string) (*models.Wishlist, error) {
+	wish, err := r.Queries.AddWishlistItem(ctx, models.AddWishlistItemParams{
+		UserName:  userName,
+		MerchItem: merchName,
+		Variant:   variant,
+	})
+	if err == nil {
+		return &wish, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "wishlists_pkey":
+			return nil, ErrAlreadyInWishlist
+		case "wishlists_fk_user_name":
+			return nil, ErrInvalidUser
+		case "wishlists_fk_merch_item":
+			return nil, ErrInvalidMerchItem
+		}
+	}
+	return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+}
+
+var ErrNotInWishlist = errors.New("merch item is not in the wishlist")
+
+func (r Dal) DeleteWishlistItem(ctx context.Context, userName string, merchName string, variant string) error {
+	deleted, err := r.Queries.DeleteWishlistItem(ctx, models.DeleteWishlistItemParams{
+		UserName:  userName,
+		MerchItem: merchName,
+		Variant:   variant,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	if deleted == 0 {
+		return ErrNotInWishlist
+	}
+	return nil
+}
+
+// ListWishlist returns wishlist items with the current list price of the item or its variant
+func (r Dal) ListWishlist(ctx context.Context, userName string) ([]models.ListWishlistRow, error) {
+	wishes, err := r.Queries.ListWishlist(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(wishes), nil
+}
+
+// ListRunningSales returns running sales of all merch items without locking them
+func (r Dal) ListRunningSales(ctx context.Context) ([]models.Promotion, error) {
+	sales, err := r.Queries.ListRunningSales(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexepted error: %w", ErrInternal, err)
+	}
+	return NonNil(sales), nil
+}
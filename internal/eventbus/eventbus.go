@@ -0,0 +1,70 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+// Publisher sends domain events to a message bus. Delivery is at least once: an event may be published again
+// if its outbox row was not marked in time, consumers deduplicate by the event id
+type Publisher interface {
+	// Publish returns after the bus has accepted the event
+	Publish(ctx context.Context, event Event) error
+	Close() error
+}
+
+// Event is a domain event, Data follows the json schema of Type and Version, see Schema
+type Event struct {
+	// ID is the id of the outbox event, the same for every publication of the event
+	ID         int64           `json:"id"`
+	Type       string          `json:"type"`
+	Version    int             `json:"version"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Subject is the bus subject of the event, consumers subscribe to versions they understand
+func (e Event) Subject() string {
+	return subjectPrefix + e.Type + ".v" + strconv.Itoa(e.Version)
+}
+
+const subjectPrefix = "events."
+
+// outbox event types written by handlers
+const (
+	OutboxUserRegistered   = "user.registered"
+	OutboxCoinsTransferred = "coins.transferred"
+	OutboxMerchPurchased   = "merch.purchased"
+)
+
+type eventType struct {
+	name    string
+	version int
+}
+
+// outboxTypes are the domain events of outbox events, a new payload version of an outbox event needs a new entry here
+// and a new schema
+var outboxTypes = map[string]eventType{
+	OutboxUserRegistered:   {"UserRegistered", 1},
+	OutboxCoinsTransferred: {"CoinsTransferred", 1},
+	OutboxMerchPurchased:   {"MerchPurchased", 1},
+}
+
+// FromOutbox converts the outbox event, ok is false for events which are not published to the bus
+func FromOutbox(event models.OutboxEvent) (res Event, ok bool) {
+	t, ok := outboxTypes[event.EventType]
+	if !ok {
+		return Event{}, false
+	}
+	return Event{
+		ID:         event.ID,
+		Type:       t.name,
+		Version:    t.version,
+		OccurredAt: event.CreatedAt.Time,
+		Data:       event.Payload,
+	}, true
+}
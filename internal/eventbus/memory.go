@@ -0,0 +1,38 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+)
+
+// Memory keeps published events in the process, for tests and local runs
+type Memory struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (m *Memory) Publish(ctx context.Context, event Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.events = append(m.events, event)
+	return nil
+}
+
+// Events returns a copy of all published events in the order of publication
+func (m *Memory) Events() []Event {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	events := make([]Event, len(m.events))
+	copy(events, m.events)
+	return events
+}
+
+func (m *Memory) Close() error {
+	return nil
+}
@@ -0,0 +1,66 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	// StreamName is the jetstream stream of all domain events
+	StreamName = "DOMAIN_EVENTS"
+
+	// streamDuplicatesWindow is how long jetstream drops a republished event by its id
+	streamDuplicatesWindow = 10 * time.Minute
+)
+
+// NATS publishes events to a jetstream stream, the stream is created on start if missing
+type NATS struct {
+	conn *nats.Conn
+	js   jetstream.JetStream
+}
+
+func NewNATS(ctx context.Context, url string) (*NATS, error) {
+	conn, err := nats.Connect(url, nats.Name("merch-store"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("fail to connect nats: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("fail to create jetstream context: %w", err)
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       StreamName,
+		Subjects:   []string{subjectPrefix + ">"},
+		Storage:    jetstream.FileStorage,
+		Duplicates: streamDuplicatesWindow,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("fail to create stream %s: %w", StreamName, err)
+	}
+
+	return &NATS{conn: conn, js: js}, nil
+}
+
+// Publish waits for the stream ack, so the event is stored when it returns
+func (p *NATS) Publish(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = p.js.Publish(ctx, event.Subject(), body, jetstream.WithMsgID(strconv.FormatInt(event.ID, 10)))
+	return err
+}
+
+func (p *NATS) Close() error {
+	return p.conn.Drain()
+}
@@ -0,0 +1,38 @@
+package eventbus
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// schemas are json schemas of event data named <Type>.v<Version>.json, a published version is never changed -
+// a breaking change is a new version published next to the old one
+//
+//go:embed schemas/*.json
+var schemas embed.FS
+
+// Schema returns the json schema of the event data
+func Schema(eventType string, version int) ([]byte, error) {
+	return schemas.ReadFile(fmt.Sprintf("schemas/%s.v%d.json", eventType, version))
+}
+
+// Validate checks the event data against its schema
+func Validate(event Event) error {
+	raw, err := Schema(event.Type, event.Version)
+	if err != nil {
+		return fmt.Errorf("no schema of %s v%d: %w", event.Type, event.Version, err)
+	}
+	var schema openapi3.Schema
+	if err = json.Unmarshal(raw, &schema); err != nil {
+		return fmt.Errorf("invalid schema of %s v%d: %w", event.Type, event.Version, err)
+	}
+
+	var data any
+	if err = json.Unmarshal(event.Data, &data); err != nil {
+		return fmt.Errorf("invalid data of %s v%d: %w", event.Type, event.Version, err)
+	}
+	return schema.VisitJSON(data)
+}
@@ -0,0 +1,121 @@
+package graphqlcontroller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+
+	handlers "github.com/maximmihin/aw25/internal/httpcontroller"
+)
+
+const (
+	maxQueryDepth      = 8
+	maxQueryComplexity = 1000
+
+	// defaultListSize is the page size of lists queried without the limit argument
+	defaultListSize = 20
+)
+
+// listFields return lists, their children are counted once per element
+var listFields = map[string]bool{
+	"merch":     true,
+	"inventory": true,
+	"transfers": true,
+}
+
+// checkLimits rejects too deep or too expensive queries before any resolver runs.
+// Every field costs 1, introspection fields are free. Fragments cycles are rejected by validation before it
+func checkLimits(doc *ast.Document, operationName string, variables map[string]interface{}) gqlerrors.ExtendedError {
+	w := limitsWalker{
+		fragments: make(map[string]*ast.FragmentDefinition),
+		variables: variables,
+	}
+	var operations []*ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.FragmentDefinition:
+			w.fragments[def.Name.Value] = def
+		case *ast.OperationDefinition:
+			if operationName == "" || (def.Name != nil && def.Name.Value == operationName) {
+				operations = append(operations, def)
+			}
+		}
+	}
+
+	for _, operation := range operations {
+		complexity, depth := w.selectionSet(operation.SelectionSet, 1)
+		if depth > maxQueryDepth {
+			return limitError(fmt.Sprintf("query depth %d exceeds the limit of %d", depth, maxQueryDepth))
+		}
+		if complexity > maxQueryComplexity {
+			return limitError(fmt.Sprintf("query complexity %d exceeds the limit of %d", complexity, maxQueryComplexity))
+		}
+	}
+	return nil
+}
+
+func limitError(msg string) gqlerrors.ExtendedError {
+	return apiError{&handlers.Error{Status: 400, Code: handlers.ErrorCodeQUERYTOOCOMPLEX, Message: msg}}
+}
+
+type limitsWalker struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+}
+
+// selectionSet returns the cost of the set and the depth of its deepest field
+func (w limitsWalker) selectionSet(set *ast.SelectionSet, depth int) (complexity int, maxDepth int) {
+	if set == nil {
+		return 0, depth - 1
+	}
+	maxDepth = depth
+	for _, selection := range set.Selections {
+		var c, d int
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(selection.Name.Value, "__") {
+				continue
+			}
+			c, d = w.selectionSet(selection.SelectionSet, depth+1)
+			c = 1 + c*w.listSize(selection)
+		case *ast.InlineFragment:
+			c, d = w.selectionSet(selection.SelectionSet, depth)
+		case *ast.FragmentSpread:
+			fragment, ok := w.fragments[selection.Name.Value]
+			if !ok {
+				continue
+			}
+			c, d = w.selectionSet(fragment.SelectionSet, depth)
+		}
+		complexity += c
+		maxDepth = max(maxDepth, d)
+	}
+	return complexity, maxDepth
+}
+
+// listSize is the limit argument of a list field, a literal or a variable
+func (w limitsWalker) listSize(field *ast.Field) int {
+	if !listFields[field.Name.Value] {
+		return 1
+	}
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "limit" {
+			continue
+		}
+		switch value := arg.Value.(type) {
+		case *ast.IntValue:
+			if size, err := strconv.Atoi(value.Value); err == nil && size > 0 {
+				return size
+			}
+		case *ast.Variable:
+			// variables come from json, numbers are float64
+			if size, ok := w.variables[value.Name.Value].(float64); ok && size > 0 {
+				return int(size)
+			}
+		}
+	}
+	return defaultListSize
+}
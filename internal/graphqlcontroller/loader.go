@@ -0,0 +1,99 @@
+package graphqlcontroller
+
+import (
+	"context"
+	"sync"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+// loader batches lookups by key within one request. Resolvers call Load and return the thunk,
+// the executor resolves thunks of a level only after all fields of the level called Load,
+// so the first thunk fetches keys of the whole level with one query
+type loader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	pending []K
+	// requested has keys fetched or waiting for the fetch, found ones are in cache
+	requested map[K]bool
+	cache     map[K]V
+	errs      map[K]error
+}
+
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:     fetch,
+		requested: make(map[K]bool),
+		cache:     make(map[K]V),
+		errs:      make(map[K]error),
+	}
+}
+
+// Load returns a graphql thunk, a missing key resolves to null
+func (l *loader[K, V]) Load(ctx context.Context, key K) func() (interface{}, error) {
+	l.mu.Lock()
+	if !l.requested[key] {
+		l.requested[key] = true
+		l.pending = append(l.pending, key)
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if len(l.pending) > 0 {
+			keys := l.pending
+			l.pending = nil
+
+			values, err := l.fetch(ctx, keys)
+			for _, k := range keys {
+				if err != nil {
+					l.errs[k] = err
+					continue
+				}
+				if v, ok := values[k]; ok {
+					l.cache[k] = v
+				}
+			}
+		}
+
+		if err := l.errs[key]; err != nil {
+			return nil, err
+		}
+		v, ok := l.cache[key]
+		if !ok {
+			return nil, nil
+		}
+		return v, nil
+	}
+}
+
+// loaders live for one request, cached values never outlive it
+type loaders struct {
+	merch *loader[string, models.Merch]
+}
+
+func newLoaders(repo *dal.Dal) *loaders {
+	return &loaders{
+		merch: newLoader(func(ctx context.Context, slugs []string) (map[string]models.Merch, error) {
+			rows, err := repo.ListMerchBySlugs(ctx, slugs)
+			if err != nil {
+				return nil, err
+			}
+			merch := make(map[string]models.Merch, len(rows))
+			for _, row := range rows {
+				merch[row.Slug] = row
+			}
+			return merch, nil
+		}),
+	}
+}
+
+type loadersCtxKey struct{}
+
+func loadersFromContext(ctx context.Context) *loaders {
+	return ctx.Value(loadersCtxKey{}).(*loaders)
+}
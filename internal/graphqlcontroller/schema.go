@@ -0,0 +1,317 @@
+package graphqlcontroller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+	handlers "github.com/maximmihin/aw25/internal/httpcontroller"
+)
+
+// viewer is the root value of every request, the user of the jwt
+type viewer struct {
+	Name string
+}
+
+// newSchema builds the graph over the same handlers and dal as the http api,
+// mutations call v2 handlers, so both apis share validation and transactions
+func (s *Server) newSchema() (graphql.Schema, error) {
+	merchItemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "MerchItem",
+		Fields: graphql.Fields{
+			"slug":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"name":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"description": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"price":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"category":    &graphql.Field{Type: graphql.String},
+			"imageUrl":    &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	merchPageType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "MerchPage",
+		Fields: graphql.Fields{
+			"items":  &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(merchItemType)))},
+			"total":  &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"limit":  &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"offset": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	merchSortType := graphql.NewEnum(graphql.EnumConfig{
+		Name: "MerchSort",
+		Values: graphql.EnumValueConfigMap{
+			"NAME":       &graphql.EnumValueConfig{Value: string(handlers.MerchSortName)},
+			"PRICE":      &graphql.EnumValueConfig{Value: string(handlers.MerchSortPrice)},
+			"PRICE_DESC": &graphql.EnumValueConfig{Value: string(handlers.MerchSortMinusPrice)},
+		},
+	})
+
+	inventoryItemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "InventoryItem",
+		Fields: graphql.Fields{
+			"item": &graphql.Field{
+				Type: graphql.NewNonNull(merchItemType),
+				Resolve: s.resolver(func(p graphql.ResolveParams) (interface{}, error) {
+					return merchItem(p.Context, p.Source.(models.MerchOwnership).MerchItem), nil
+				}),
+			},
+			"variant": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return nonEmpty(p.Source.(models.MerchOwnership).Variant), nil
+				},
+			},
+			"quantity": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	transferType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Transfer",
+		Fields: graphql.Fields{
+			"id":        &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"fromUser":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"toUser":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"amount":    &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"createdAt": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+		},
+	})
+
+	transferPageType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TransferPage",
+		Fields: graphql.Fields{
+			"items":  &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(transferType)))},
+			"total":  &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"limit":  &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"offset": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	orderType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Order",
+		Fields: graphql.Fields{
+			"id":    &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"user":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"payer": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"item": &graphql.Field{
+				Type: graphql.NewNonNull(merchItemType),
+				Resolve: s.resolver(func(p graphql.ResolveParams) (interface{}, error) {
+					return merchItem(p.Context, p.Source.(handlers.Order).Item), nil
+				}),
+			},
+			"variant":    &graphql.Field{Type: graphql.String},
+			"price":      &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"status":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"pickupCode": &graphql.Field{Type: graphql.String},
+			"createdAt":  &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+		},
+	})
+
+	viewerType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Viewer",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"balance": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: s.resolver(func(p graphql.ResolveParams) (interface{}, error) {
+					user, err := s.Handlers.Dal.GetUserByName(p.Context, p.Source.(viewer).Name)
+					if err != nil {
+						return nil, err
+					}
+					if user == nil {
+						return nil, fmt.Errorf("jwt token valid, but user not found in db")
+					}
+					return user.Coins, nil
+				}),
+			},
+			"inventory": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(inventoryItemType))),
+				Resolve: s.resolver(func(p graphql.ResolveParams) (interface{}, error) {
+					return s.Handlers.Dal.ListUserInventory(p.Context, p.Source.(viewer).Name)
+				}),
+			},
+			"transfers": &graphql.Field{
+				Type: graphql.NewNonNull(transferPageType),
+				Args: graphql.FieldConfigArgument{
+					"limit":  &graphql.ArgumentConfig{Type: graphql.Int},
+					"offset": &graphql.ArgumentConfig{Type: graphql.Int},
+				},
+				Resolve: s.resolver(func(p graphql.ResolveParams) (interface{}, error) {
+					res, err := s.Handlers.GetApiV2UsersMeTransactions(p.Context, handlers.GetApiV2UsersMeTransactionsRequestObject{
+						Params: handlers.GetApiV2UsersMeTransactionsParams{
+							Limit:  int32Arg(p.Args, "limit"),
+							Offset: int32Arg(p.Args, "offset"),
+						},
+					})
+					if err != nil {
+						return nil, err
+					}
+					page, ok := res.(handlers.GetApiV2UsersMeTransactions200JSONResponse)
+					if !ok {
+						return nil, fmt.Errorf("%w: %T", errUnexpectedResponse, res)
+					}
+					return handlers.TransferPage(page), nil
+				}),
+			},
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"viewer": &graphql.Field{
+				Type: graphql.NewNonNull(viewerType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Info.RootValue, nil
+				},
+			},
+			"merch": &graphql.Field{
+				Type: graphql.NewNonNull(merchPageType),
+				Args: graphql.FieldConfigArgument{
+					"category": &graphql.ArgumentConfig{Type: graphql.String},
+					"minPrice": &graphql.ArgumentConfig{Type: graphql.Int},
+					"maxPrice": &graphql.ArgumentConfig{Type: graphql.Int},
+					"q":        &graphql.ArgumentConfig{Type: graphql.String},
+					"sort":     &graphql.ArgumentConfig{Type: merchSortType},
+					"limit":    &graphql.ArgumentConfig{Type: graphql.Int},
+					"offset":   &graphql.ArgumentConfig{Type: graphql.Int},
+				},
+				Resolve: s.resolver(func(p graphql.ResolveParams) (interface{}, error) {
+					var sort *handlers.MerchSortOrder
+					if value, ok := p.Args["sort"].(string); ok {
+						sort = (*handlers.MerchSortOrder)(&value)
+					}
+					res, err := s.Handlers.GetApiV2Merch(p.Context, handlers.GetApiV2MerchRequestObject{
+						Params: handlers.GetApiV2MerchParams{
+							Category: stringArg(p.Args, "category"),
+							MinPrice: int64Arg(p.Args, "minPrice"),
+							MaxPrice: int64Arg(p.Args, "maxPrice"),
+							Q:        stringArg(p.Args, "q"),
+							Sort:     sort,
+							Limit:    int32Arg(p.Args, "limit"),
+							Offset:   int32Arg(p.Args, "offset"),
+						},
+					})
+					if err != nil {
+						return nil, err
+					}
+					page, ok := res.(handlers.GetApiV2Merch200JSONResponse)
+					if !ok {
+						return nil, fmt.Errorf("%w: %T", errUnexpectedResponse, res)
+					}
+					return page.Body, nil
+				}),
+			},
+		},
+	})
+
+	mutation := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"sendCoin": &graphql.Field{
+				Type: graphql.NewNonNull(transferType),
+				Args: graphql.FieldConfigArgument{
+					"toUser": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"amount": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: s.resolver(func(p graphql.ResolveParams) (interface{}, error) {
+					res, err := s.Handlers.PostApiV2Transfers(p.Context, handlers.PostApiV2TransfersRequestObject{
+						Body: &handlers.SendCoinRequest{
+							ToUser: p.Args["toUser"].(string),
+							Amount: p.Args["amount"].(int),
+						},
+					})
+					if err != nil {
+						return nil, err
+					}
+					created, ok := res.(handlers.PostApiV2Transfers201JSONResponse)
+					if !ok {
+						return nil, fmt.Errorf("%w: %T", errUnexpectedResponse, res)
+					}
+					return created.Body, nil
+				}),
+			},
+			"buy": &graphql.Field{
+				Type: graphql.NewNonNull(orderType),
+				Args: graphql.FieldConfigArgument{
+					"item":      &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"variant":   &graphql.ArgumentConfig{Type: graphql.String},
+					"forUser":   &graphql.ArgumentConfig{Type: graphql.String},
+					"message":   &graphql.ArgumentConfig{Type: graphql.String},
+					"promoCode": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: s.resolver(func(p graphql.ResolveParams) (interface{}, error) {
+					res, err := s.Handlers.PostApiV2Orders(p.Context, handlers.PostApiV2OrdersRequestObject{
+						Body: &handlers.CreateOrderRequest{
+							Item:      p.Args["item"].(string),
+							Variant:   stringArg(p.Args, "variant"),
+							ForUser:   stringArg(p.Args, "forUser"),
+							Message:   stringArg(p.Args, "message"),
+							PromoCode: stringArg(p.Args, "promoCode"),
+						},
+					})
+					if err != nil {
+						return nil, err
+					}
+					created, ok := res.(handlers.PostApiV2Orders201JSONResponse)
+					if !ok {
+						return nil, fmt.Errorf("%w: %T", errUnexpectedResponse, res)
+					}
+					return created.Body, nil
+				}),
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query:    query,
+		Mutation: mutation,
+	})
+}
+
+// merchItem returns a thunk, so the loader gets slugs of the whole level before the query
+func merchItem(ctx context.Context, slug string) func() (interface{}, error) {
+	load := loadersFromContext(ctx).merch.Load(ctx, slug)
+	return func() (interface{}, error) {
+		merch, err := load()
+		if err != nil || merch == nil {
+			return nil, err
+		}
+		return handlers.LocalizedMerchItem(ctx, merch.(models.Merch)), nil
+	}
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func stringArg(args map[string]interface{}, name string) *string {
+	value, ok := args[name].(string)
+	if !ok {
+		return nil
+	}
+	return &value
+}
+
+func int64Arg(args map[string]interface{}, name string) *int64 {
+	value, ok := args[name].(int)
+	if !ok {
+		return nil
+	}
+	v := int64(value)
+	return &v
+}
+
+func int32Arg(args map[string]interface{}, name string) *int32 {
+	value, ok := args[name].(int)
+	if !ok {
+		return nil
+	}
+	v := int32(value)
+	return &v
+}
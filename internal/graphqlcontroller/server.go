@@ -0,0 +1,152 @@
+package graphqlcontroller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/location"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+
+	handlers "github.com/maximmihin/aw25/internal/httpcontroller"
+)
+
+// Server is the graphql api of the shop, clients fetch only the fields they need in one request
+type Server struct {
+	Handlers handlers.Handlers
+	Logger   *slog.Logger
+
+	schema graphql.Schema
+}
+
+func New(h handlers.Handlers, logger *slog.Logger) (*Server, error) {
+	s := &Server{
+		Handlers: h,
+		Logger:   logger,
+	}
+	schema, err := s.newSchema()
+	if err != nil {
+		return nil, err
+	}
+	s.schema = schema
+	return s, nil
+}
+
+// errUnexpectedResponse means a handler got a response the graphql api has no mapping for
+var errUnexpectedResponse = errors.New("unexpected handler response")
+
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Handle serves POST /api/graphql, the route needs a jwt - every query starts from the viewer
+func (s *Server) Handle(c *fiber.Ctx) error {
+	var req Request
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid graphql request: "+err.Error())
+	}
+	if req.Query == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid graphql request: query is required")
+	}
+
+	ctx, err := handlers.UserContext(c)
+	if err != nil {
+		return err
+	}
+	userName, err := handlers.ExtractUserNameFromJwt(c)
+	if err != nil {
+		return err
+	}
+	ctx = context.WithValue(ctx, loadersCtxKey{}, newLoaders(s.Handlers.Dal))
+
+	return c.JSON(s.execute(ctx, req, viewer{Name: userName}))
+}
+
+func (s *Server) execute(ctx context.Context, req Request, root viewer) *graphql.Result {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{
+			Body: []byte(req.Query),
+			Name: "GraphQL request",
+		}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	validation := graphql.ValidateDocument(&s.schema, doc, nil)
+	if !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}
+	}
+
+	if limitErr := checkLimits(doc, req.OperationName, req.Variables); limitErr != nil {
+		return &graphql.Result{Errors: []gqlerrors.FormattedError{{
+			Message:    limitErr.Error(),
+			Locations:  []location.SourceLocation{},
+			Extensions: limitErr.Extensions(),
+		}}}
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        s.schema,
+		Root:          root,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       ctx,
+	})
+}
+
+// apiError carries the code of the http api into graphql error extensions
+type apiError struct {
+	err *handlers.Error
+}
+
+func (e apiError) Error() string {
+	return e.err.Message
+}
+
+func (e apiError) Extensions() map[string]interface{} {
+	extensions := map[string]interface{}{"code": e.err.Code}
+	if len(e.err.Fields) > 0 {
+		extensions["fields"] = e.err.Fields
+	}
+	return extensions
+}
+
+// resolver converts errors of fn and of the thunk it returns, unexpected ones are logged and hidden
+func (s *Server) resolver(fn graphql.FieldResolveFn) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		res, err := fn(p)
+		if err != nil {
+			return nil, s.apiError(p, err)
+		}
+		thunk, ok := res.(func() (interface{}, error))
+		if !ok {
+			return res, nil
+		}
+		return func() (interface{}, error) {
+			res, err := thunk()
+			if err != nil {
+				return nil, s.apiError(p, err)
+			}
+			return res, nil
+		}, nil
+	}
+}
+
+func (s *Server) apiError(p graphql.ResolveParams, err error) error {
+	apiErr := handlers.AsError(err)
+	if apiErr.Status >= 500 {
+		s.Logger.Error("graphql field failed",
+			slog.String("field", p.Info.ParentType.Name()+"."+p.Info.FieldName),
+			slog.String("error", err.Error()),
+		)
+	}
+	return apiError{apiErr}
+}
@@ -0,0 +1,3 @@
+package grpccontroller
+
+//go:generate protoc -I ../../api --go_out=. --go_opt=module=github.com/maximmihin/aw25/internal/grpccontroller --go-grpc_out=. --go-grpc_opt=module=github.com/maximmihin/aw25/internal/grpccontroller merch.proto
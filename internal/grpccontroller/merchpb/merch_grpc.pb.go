@@ -0,0 +1,373 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: merch.proto
+
+package merchpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MerchShop_Auth_FullMethodName           = "/merch.v1.MerchShop/Auth"
+	MerchShop_LoginOTP_FullMethodName       = "/merch.v1.MerchShop/LoginOTP"
+	MerchShop_ForgotPassword_FullMethodName = "/merch.v1.MerchShop/ForgotPassword"
+	MerchShop_GetInfo_FullMethodName        = "/merch.v1.MerchShop/GetInfo"
+	MerchShop_SendCoin_FullMethodName       = "/merch.v1.MerchShop/SendCoin"
+	MerchShop_Buy_FullMethodName            = "/merch.v1.MerchShop/Buy"
+	MerchShop_ListMerch_FullMethodName      = "/merch.v1.MerchShop/ListMerch"
+)
+
+// MerchShopClient is the client API for MerchShop service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MerchShop - то же API магазина мерча, что и HTTP, для внутренних сервисов.
+// Все методы, кроме Auth, LoginOTP, ForgotPassword и ListMerch, требуют JWT-токен в метаданных: authorization: Bearer <token>.
+// Ошибки возвращаются с кодом gRPC и деталью google.rpc.ErrorInfo, reason в которой - код ошибки из api/v1.yaml.
+type MerchShopClient interface {
+	// Аутентификация и получение JWT-токена. При первой аутентификации пользователь создается автоматически.
+	// Если у пользователя включена двухфакторная аутентификация, вместо токена возвращается challenge для LoginOTP.
+	Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	// Второй шаг входа: challenge из Auth и код из приложения-аутентификатора или резервный код.
+	LoginOTP(ctx context.Context, in *LoginOTPRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	// Отправить письмо для сброса пароля. Ответ не зависит от того, существует ли пользователь.
+	ForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*ForgotPasswordResponse, error)
+	// Получить информацию о монетах, инвентаре и истории транзакций.
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// Отправить монеты другому пользователю.
+	SendCoin(ctx context.Context, in *SendCoinRequest, opts ...grpc.CallOption) (*SendCoinResponse, error)
+	// Купить предмет за монеты, себе или в подарок.
+	Buy(ctx context.Context, in *BuyRequest, opts ...grpc.CallOption) (*BuyResponse, error)
+	// Каталог мерча с фильтрами, поиском и пагинацией.
+	ListMerch(ctx context.Context, in *ListMerchRequest, opts ...grpc.CallOption) (*ListMerchResponse, error)
+}
+
+type merchShopClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMerchShopClient(cc grpc.ClientConnInterface) MerchShopClient {
+	return &merchShopClient{cc}
+}
+
+func (c *merchShopClient) Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, MerchShop_Auth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchShopClient) LoginOTP(ctx context.Context, in *LoginOTPRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, MerchShop_LoginOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchShopClient) ForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*ForgotPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForgotPasswordResponse)
+	err := c.cc.Invoke(ctx, MerchShop_ForgotPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchShopClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, MerchShop_GetInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchShopClient) SendCoin(ctx context.Context, in *SendCoinRequest, opts ...grpc.CallOption) (*SendCoinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendCoinResponse)
+	err := c.cc.Invoke(ctx, MerchShop_SendCoin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchShopClient) Buy(ctx context.Context, in *BuyRequest, opts ...grpc.CallOption) (*BuyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuyResponse)
+	err := c.cc.Invoke(ctx, MerchShop_Buy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchShopClient) ListMerch(ctx context.Context, in *ListMerchRequest, opts ...grpc.CallOption) (*ListMerchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMerchResponse)
+	err := c.cc.Invoke(ctx, MerchShop_ListMerch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MerchShopServer is the server API for MerchShop service.
+// All implementations must embed UnimplementedMerchShopServer
+// for forward compatibility.
+//
+// MerchShop - то же API магазина мерча, что и HTTP, для внутренних сервисов.
+// Все методы, кроме Auth, LoginOTP, ForgotPassword и ListMerch, требуют JWT-токен в метаданных: authorization: Bearer <token>.
+// Ошибки возвращаются с кодом gRPC и деталью google.rpc.ErrorInfo, reason в которой - код ошибки из api/v1.yaml.
+type MerchShopServer interface {
+	// Аутентификация и получение JWT-токена. При первой аутентификации пользователь создается автоматически.
+	// Если у пользователя включена двухфакторная аутентификация, вместо токена возвращается challenge для LoginOTP.
+	Auth(context.Context, *AuthRequest) (*AuthResponse, error)
+	// Второй шаг входа: challenge из Auth и код из приложения-аутентификатора или резервный код.
+	LoginOTP(context.Context, *LoginOTPRequest) (*AuthResponse, error)
+	// Отправить письмо для сброса пароля. Ответ не зависит от того, существует ли пользователь.
+	ForgotPassword(context.Context, *ForgotPasswordRequest) (*ForgotPasswordResponse, error)
+	// Получить информацию о монетах, инвентаре и истории транзакций.
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// Отправить монеты другому пользователю.
+	SendCoin(context.Context, *SendCoinRequest) (*SendCoinResponse, error)
+	// Купить предмет за монеты, себе или в подарок.
+	Buy(context.Context, *BuyRequest) (*BuyResponse, error)
+	// Каталог мерча с фильтрами, поиском и пагинацией.
+	ListMerch(context.Context, *ListMerchRequest) (*ListMerchResponse, error)
+	mustEmbedUnimplementedMerchShopServer()
+}
+
+// UnimplementedMerchShopServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMerchShopServer struct{}
+
+func (UnimplementedMerchShopServer) Auth(context.Context, *AuthRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auth not implemented")
+}
+func (UnimplementedMerchShopServer) LoginOTP(context.Context, *LoginOTPRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginOTP not implemented")
+}
+func (UnimplementedMerchShopServer) ForgotPassword(context.Context, *ForgotPasswordRequest) (*ForgotPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForgotPassword not implemented")
+}
+func (UnimplementedMerchShopServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
+func (UnimplementedMerchShopServer) SendCoin(context.Context, *SendCoinRequest) (*SendCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCoin not implemented")
+}
+func (UnimplementedMerchShopServer) Buy(context.Context, *BuyRequest) (*BuyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Buy not implemented")
+}
+func (UnimplementedMerchShopServer) ListMerch(context.Context, *ListMerchRequest) (*ListMerchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMerch not implemented")
+}
+func (UnimplementedMerchShopServer) mustEmbedUnimplementedMerchShopServer() {}
+func (UnimplementedMerchShopServer) testEmbeddedByValue()                   {}
+
+// UnsafeMerchShopServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MerchShopServer will
+// result in compilation errors.
+type UnsafeMerchShopServer interface {
+	mustEmbedUnimplementedMerchShopServer()
+}
+
+func RegisterMerchShopServer(s grpc.ServiceRegistrar, srv MerchShopServer) {
+	// If the following call pancis, it indicates UnimplementedMerchShopServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MerchShop_ServiceDesc, srv)
+}
+
+func _MerchShop_Auth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchShopServer).Auth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchShop_Auth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchShopServer).Auth(ctx, req.(*AuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchShop_LoginOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchShopServer).LoginOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchShop_LoginOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchShopServer).LoginOTP(ctx, req.(*LoginOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchShop_ForgotPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgotPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchShopServer).ForgotPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchShop_ForgotPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchShopServer).ForgotPassword(ctx, req.(*ForgotPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchShop_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchShopServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchShop_GetInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchShopServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchShop_SendCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchShopServer).SendCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchShop_SendCoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchShopServer).SendCoin(ctx, req.(*SendCoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchShop_Buy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchShopServer).Buy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchShop_Buy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchShopServer).Buy(ctx, req.(*BuyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchShop_ListMerch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMerchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchShopServer).ListMerch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchShop_ListMerch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchShopServer).ListMerch(ctx, req.(*ListMerchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MerchShop_ServiceDesc is the grpc.ServiceDesc for MerchShop service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MerchShop_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "merch.v1.MerchShop",
+	HandlerType: (*MerchShopServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Auth",
+			Handler:    _MerchShop_Auth_Handler,
+		},
+		{
+			MethodName: "LoginOTP",
+			Handler:    _MerchShop_LoginOTP_Handler,
+		},
+		{
+			MethodName: "ForgotPassword",
+			Handler:    _MerchShop_ForgotPassword_Handler,
+		},
+		{
+			MethodName: "GetInfo",
+			Handler:    _MerchShop_GetInfo_Handler,
+		},
+		{
+			MethodName: "SendCoin",
+			Handler:    _MerchShop_SendCoin_Handler,
+		},
+		{
+			MethodName: "Buy",
+			Handler:    _MerchShop_Buy_Handler,
+		},
+		{
+			MethodName: "ListMerch",
+			Handler:    _MerchShop_ListMerch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "merch.proto",
+}
@@ -0,0 +1,363 @@
+package grpccontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/maximmihin/aw25/internal/grpccontroller/merchpb"
+	handlers "github.com/maximmihin/aw25/internal/httpcontroller"
+)
+
+// Server is the grpc api of the shop, it calls the http handlers, so both apis share validation and transactions
+type Server struct {
+	merchpb.UnimplementedMerchShopServer
+
+	Handlers handlers.Handlers
+	Logger   *slog.Logger
+
+	JWTPublicKey string
+}
+
+// NewGRPCServer registers s in a new grpc server with auth and error interceptors
+func (s *Server) NewGRPCServer() *grpc.Server {
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(s.Errors, s.Authenticate))
+	merchpb.RegisterMerchShopServer(srv, s)
+	return srv
+}
+
+// publicMethods need no token, as the same operations of the http api
+var publicMethods = map[string]bool{
+	merchpb.MerchShop_Auth_FullMethodName:           true,
+	merchpb.MerchShop_LoginOTP_FullMethodName:       true,
+	merchpb.MerchShop_ForgotPassword_FullMethodName: true,
+	merchpb.MerchShop_ListMerch_FullMethodName:      true,
+}
+
+var errProblemsWithToken = &handlers.Error{
+	Status:  401,
+	Code:    handlers.ErrorCodeUNAUTHORIZED,
+	Message: "problems with token",
+}
+
+// Authenticate checks the jwt from authorization metadata, the same token as for the http api
+func (s *Server) Authenticate(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if publicMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	auth := md.Get("authorization")
+	if len(auth) != 1 {
+		return nil, errProblemsWithToken
+	}
+	tokenString, ok := strings.CutPrefix(auth[0], "Bearer ")
+	if !ok {
+		return nil, errProblemsWithToken
+	}
+
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
+		return []byte(s.JWTPublicKey), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		s.Logger.Debug("user sent invalid jwt token: " + err.Error())
+		return nil, errProblemsWithToken
+	}
+	userName, err := token.Claims.GetSubject()
+	if err != nil || userName == "" {
+		return nil, errProblemsWithToken
+	}
+
+	return handler(handlers.ContextWithUserName(ctx, userName), req)
+}
+
+// Errors converts handler errors to grpc statuses, the api error code is sent as ErrorInfo reason
+func (s *Server) Errors(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	res, err := handler(ctx, req)
+	if err == nil {
+		return res, nil
+	}
+
+	apiErr := handlers.AsError(err)
+	if apiErr.Status >= 500 {
+		s.Logger.Error("grpc method failed",
+			slog.String("method", info.FullMethod),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	st := status.New(statusCode(apiErr.Status), apiErr.Message)
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason: string(apiErr.Code),
+		Domain: "merch-shop",
+	}}
+	if len(apiErr.Fields) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, field := range apiErr.Fields {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field.Field,
+				Description: field.Message,
+			})
+		}
+		details = append(details, badRequest)
+	}
+	if withDetails, errDetails := st.WithDetails(details...); errDetails == nil {
+		st = withDetails
+	}
+	return nil, st.Err()
+}
+
+// statusCode maps http statuses of api errors, 409, 412 and 422 mean the state does not allow the operation.
+// Other client errors are the fault of the request, so they are never reported as Internal
+func statusCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case 400:
+		return codes.InvalidArgument
+	case 401:
+		return codes.Unauthenticated
+	case 403:
+		return codes.PermissionDenied
+	case 404:
+		return codes.NotFound
+	case 409, 412, 422:
+		return codes.FailedPrecondition
+	case 429:
+		return codes.ResourceExhausted
+	}
+	if httpStatus >= 400 && httpStatus < 500 {
+		return codes.InvalidArgument
+	}
+	return codes.Internal
+}
+
+// errUnexpectedResponse means a handler got a response the grpc api has no mapping for
+var errUnexpectedResponse = errors.New("unexpected handler response")
+
+func (s *Server) Auth(ctx context.Context, req *merchpb.AuthRequest) (*merchpb.AuthResponse, error) {
+	res, err := s.Handlers.PostApiAuth(ctx, handlers.PostApiAuthRequestObject{
+		Body: &handlers.AuthRequest{
+			Username: req.GetUsername(),
+			Password: req.GetPassword(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	auth, ok := res.(handlers.PostApiAuth200JSONResponse)
+	if !ok || (auth.Token == nil && auth.Challenge == nil) {
+		return nil, fmt.Errorf("%w: %T", errUnexpectedResponse, res)
+	}
+	return &merchpb.AuthResponse{Token: deref(auth.Token), Challenge: deref(auth.Challenge)}, nil
+}
+
+func (s *Server) LoginOTP(ctx context.Context, req *merchpb.LoginOTPRequest) (*merchpb.AuthResponse, error) {
+	res, err := s.Handlers.PostApiAuthOtp(ctx, handlers.PostApiAuthOtpRequestObject{
+		Body: &handlers.LoginOTPRequest{
+			Challenge: req.GetChallenge(),
+			Code:      req.GetCode(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	auth, ok := res.(handlers.PostApiAuthOtp200JSONResponse)
+	if !ok || auth.Token == nil {
+		return nil, fmt.Errorf("%w: %T", errUnexpectedResponse, res)
+	}
+	return &merchpb.AuthResponse{Token: *auth.Token}, nil
+}
+
+func (s *Server) ForgotPassword(ctx context.Context, req *merchpb.ForgotPasswordRequest) (*merchpb.ForgotPasswordResponse, error) {
+	res, err := s.Handlers.PostApiAuthForgot(ctx, handlers.PostApiAuthForgotRequestObject{
+		Body: &handlers.ForgotPasswordRequest{
+			Username: req.GetUsername(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := res.(handlers.PostApiAuthForgot204Response); !ok {
+		return nil, fmt.Errorf("%w: %T", errUnexpectedResponse, res)
+	}
+	return &merchpb.ForgotPasswordResponse{}, nil
+}
+
+func (s *Server) GetInfo(ctx context.Context, req *merchpb.GetInfoRequest) (*merchpb.GetInfoResponse, error) {
+	res, err := s.Handlers.GetApiInfo(ctx, handlers.GetApiInfoRequestObject{})
+	if err != nil {
+		return nil, err
+	}
+	info, ok := res.(handlers.GetApiInfo200JSONResponse)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", errUnexpectedResponse, res)
+	}
+
+	// InfoResponse has every field optional, the handler fills all of them
+	infoRes := &merchpb.GetInfoResponse{
+		Coins:       int64(deref(info.Coins)),
+		CoinHistory: &merchpb.CoinHistory{},
+		GiftHistory: &merchpb.GiftHistory{},
+	}
+	for _, item := range deref(info.Inventory) {
+		infoRes.Inventory = append(infoRes.Inventory, &merchpb.InventoryItem{
+			Item:     deref(item.Type),
+			Variant:  deref(item.Variant),
+			Quantity: int64(deref(item.Quantity)),
+		})
+	}
+	if coinHistory := info.CoinHistory; coinHistory != nil {
+		for _, received := range deref(coinHistory.Received) {
+			infoRes.CoinHistory.Received = append(infoRes.CoinHistory.Received, &merchpb.CoinHistory_Received{
+				FromUser: deref(received.FromUser),
+				Amount:   int64(deref(received.Amount)),
+			})
+		}
+		for _, sent := range deref(coinHistory.Sent) {
+			infoRes.CoinHistory.Sent = append(infoRes.CoinHistory.Sent, &merchpb.CoinHistory_Sent{
+				ToUser: deref(sent.ToUser),
+				Amount: int64(deref(sent.Amount)),
+			})
+		}
+	}
+	if giftHistory := info.GiftHistory; giftHistory != nil {
+		for _, received := range deref(giftHistory.Received) {
+			infoRes.GiftHistory.Received = append(infoRes.GiftHistory.Received, &merchpb.GiftHistory_Received{
+				FromUser: deref(received.FromUser),
+				Item:     deref(received.Item),
+				Message:  deref(received.Message),
+			})
+		}
+		for _, sent := range deref(giftHistory.Sent) {
+			infoRes.GiftHistory.Sent = append(infoRes.GiftHistory.Sent, &merchpb.GiftHistory_Sent{
+				ToUser:  deref(sent.ToUser),
+				Item:    deref(sent.Item),
+				Message: deref(sent.Message),
+			})
+		}
+	}
+	return infoRes, nil
+}
+
+func (s *Server) SendCoin(ctx context.Context, req *merchpb.SendCoinRequest) (*merchpb.SendCoinResponse, error) {
+	res, err := s.Handlers.PostApiV2Transfers(ctx, handlers.PostApiV2TransfersRequestObject{
+		Body: &handlers.SendCoinRequest{
+			ToUser: req.GetToUser(),
+			Amount: int(req.GetAmount()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, ok := res.(handlers.PostApiV2Transfers201JSONResponse)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", errUnexpectedResponse, res)
+	}
+	return &merchpb.SendCoinResponse{
+		Transfer: &merchpb.Transfer{
+			Id:        created.Body.Id,
+			FromUser:  created.Body.FromUser,
+			ToUser:    created.Body.ToUser,
+			Amount:    created.Body.Amount,
+			CreatedAt: timestamppb.New(created.Body.CreatedAt),
+		},
+	}, nil
+}
+
+func (s *Server) Buy(ctx context.Context, req *merchpb.BuyRequest) (*merchpb.BuyResponse, error) {
+	res, err := s.Handlers.PostApiV2Orders(ctx, handlers.PostApiV2OrdersRequestObject{
+		Body: &handlers.CreateOrderRequest{
+			Item:      req.GetItem(),
+			Variant:   req.Variant,
+			ForUser:   req.ForUser,
+			Message:   req.Message,
+			PromoCode: req.PromoCode,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, ok := res.(handlers.PostApiV2Orders201JSONResponse)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", errUnexpectedResponse, res)
+	}
+
+	order := created.Body
+	return &merchpb.BuyResponse{
+		Order: &merchpb.Order{
+			Id:         order.Id,
+			User:       order.User,
+			Payer:      order.Payer,
+			Item:       order.Item,
+			Variant:    deref(order.Variant),
+			Price:      order.Price,
+			Status:     string(order.Status),
+			PickupCode: deref(order.PickupCode),
+			CreatedAt:  timestamppb.New(order.CreatedAt),
+		},
+	}, nil
+}
+
+func (s *Server) ListMerch(ctx context.Context, req *merchpb.ListMerchRequest) (*merchpb.ListMerchResponse, error) {
+	var sort *handlers.MerchSortOrder
+	if req.Sort != nil {
+		sort = (*handlers.MerchSortOrder)(req.Sort)
+	}
+
+	res, err := s.Handlers.GetApiV2Merch(handlers.ContextWithLanguage(ctx, req.GetLanguage()), handlers.GetApiV2MerchRequestObject{
+		Params: handlers.GetApiV2MerchParams{
+			Category: req.Category,
+			MinPrice: req.MinPrice,
+			MaxPrice: req.MaxPrice,
+			Q:        req.Q,
+			Sort:     sort,
+			Limit:    req.Limit,
+			Offset:   req.Offset,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	page, ok := res.(handlers.GetApiV2Merch200JSONResponse)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", errUnexpectedResponse, res)
+	}
+
+	items := make([]*merchpb.MerchItem, 0, len(page.Body.Items))
+	for _, item := range page.Body.Items {
+		items = append(items, &merchpb.MerchItem{
+			Slug:        item.Slug,
+			Name:        item.Name,
+			Description: item.Description,
+			Price:       item.Price,
+			Category:    deref(item.Category),
+			ImageUrl:    deref(item.ImageUrl),
+		})
+	}
+	return &merchpb.ListMerchResponse{
+		Items:    items,
+		Total:    page.Body.Total,
+		Limit:    page.Body.Limit,
+		Offset:   page.Body.Offset,
+		Language: page.Headers.ContentLanguage,
+	}, nil
+}
+
+// deref returns the zero value for nil, proto3 scalars have no nil
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
@@ -0,0 +1,260 @@
+package httpcontroller
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+	"github.com/maximmihin/aw25/internal/mailer"
+)
+
+const (
+	verifyEmailTokenTTL   = 24 * time.Hour
+	resetPasswordTokenTTL = time.Hour
+
+	// authTokenRateLimit tokens of one purpose are sent to a user per authTokenRateWindow at most
+	authTokenRateLimit  = 3
+	authTokenRateWindow = time.Hour
+)
+
+var ErrTooManyRequests = errors.New("too many requests, try again later")
+var ErrEmailNotVerified = errors.New("email of the user is not verified")
+
+// authTokenHash signs the token with the jwt key, so tokens can not be made from a leaked auth_tokens table
+func (r Handlers) authTokenHash(purpose string, token string) []byte {
+	mac := hmac.New(sha256.New, []byte(r.JWTPrivateKey))
+	mac.Write([]byte(purpose + "." + token))
+	return mac.Sum(nil)
+}
+
+// sendAuthToken creates a token of the purpose and queues it by email in the transaction of txRepo,
+// the purpose is the email template too. Nothing is sent if emails are off or the user name is not an email
+func (r Handlers) sendAuthToken(ctx context.Context, txRepo *dal.Dal, userName string, purpose string, ttl time.Duration) error {
+	if r.Mailer == nil || is.EmailFormat.Validate(userName) != nil {
+		return nil
+	}
+
+	sent, err := txRepo.CountAuthTokensSince(ctx, userName, purpose, time.Now().Add(-authTokenRateWindow))
+	if err != nil {
+		return err
+	}
+	if sent >= authTokenRateLimit {
+		return ErrTooManyRequests
+	}
+
+	token, err := r.newAuthToken(ctx, txRepo, userName, purpose, ttl)
+	if err != nil {
+		return err
+	}
+	return r.queueEmail(ctx, txRepo, userName, purpose, mailer.AuthToken{
+		Token:      token,
+		ValidHours: int(ttl.Hours()),
+	}, false)
+}
+
+// newAuthToken stores a random single-use token of the purpose and returns it, only its hash is stored
+func (r Handlers) newAuthToken(ctx context.Context, repo *dal.Dal, userName string, purpose string, ttl time.Duration) (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("%w: unexepted error: %w", dal.ErrInternal, err)
+	}
+	token := hex.EncodeToString(raw)
+
+	if err := repo.AddAuthToken(ctx, models.AddAuthTokenParams{
+		UserName:  userName,
+		Purpose:   purpose,
+		TokenHash: r.authTokenHash(purpose, token),
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(ttl), Valid: true},
+	}); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// checkEmailVerified fails with ErrEmailNotVerified if RequireVerifiedEmail is set and the user is not verified
+func (r Handlers) checkEmailVerified(ctx context.Context, userName string) error {
+	if !r.RequireVerifiedEmail {
+		return nil
+	}
+	verified, err := r.Dal.IsEmailVerified(ctx, userName)
+	if err != nil {
+		return fmt.Errorf("fail IsEmailVerified: %w", err)
+	}
+	if !verified {
+		return newError(403, ErrEmailNotVerified)
+	}
+	return nil
+}
+
+func (r Handlers) PostApiAuthVerify(ctx context.Context, request PostApiAuthVerifyRequestObject) (PostApiAuthVerifyResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "VerifyEmail"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	userName, err := txRepo.UseAuthToken(ctx, r.authTokenHash(dal.AuthTokenVerifyEmail, req.Token), dal.AuthTokenVerifyEmail)
+	if err != nil {
+		if errors.Is(err, dal.ErrInvalidAuthToken) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.UseAuthToken: %w", err)
+	}
+	if err = txRepo.MarkEmailVerified(ctx, userName); err != nil {
+		return nil, fmt.Errorf("fail txRepo.MarkEmailVerified: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+	return PostApiAuthVerify204Response{}, nil
+}
+
+func (r Handlers) PostApiAuthVerifySend(ctx context.Context, request PostApiAuthVerifySendRequestObject) (PostApiAuthVerifySendResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "SendVerifyEmail"))
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	verified, err := r.Dal.IsEmailVerified(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("fail IsEmailVerified: %w", err)
+	}
+	if verified {
+		return PostApiAuthVerifySend204Response{}, nil
+	}
+
+	if err = r.sendAuthTokenTx(ctx, log, userName, dal.AuthTokenVerifyEmail, verifyEmailTokenTTL); err != nil {
+		return nil, err
+	}
+	return PostApiAuthVerifySend204Response{}, nil
+}
+
+func (r Handlers) PostApiAuthForgot(ctx context.Context, request PostApiAuthForgotRequestObject) (PostApiAuthForgotResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "ForgotPassword"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	user, err := r.Dal.GetUserByName(ctx, req.Username)
+	if err != nil {
+		return nil, fmt.Errorf("fail GetUserByName: %w", err)
+	}
+	// the response does not tell whether the user exists
+	if user == nil {
+		return PostApiAuthForgot204Response{}, nil
+	}
+
+	if err = r.sendAuthTokenTx(ctx, log, user.Name, dal.AuthTokenResetPassword, resetPasswordTokenTTL); err != nil {
+		return nil, err
+	}
+	return PostApiAuthForgot204Response{}, nil
+}
+
+// sendAuthTokenTx runs sendAuthToken in its own transaction
+func (r Handlers) sendAuthTokenTx(ctx context.Context, log *slog.Logger, userName string, purpose string, ttl time.Duration) error {
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	if err = r.sendAuthToken(ctx, r.Dal.WithTx(tx), userName, purpose, ttl); err != nil {
+		if errors.Is(err, ErrTooManyRequests) {
+			return newError(429, err)
+		}
+		return fmt.Errorf("fail sendAuthToken: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return err
+	}
+	return nil
+}
+
+func (r Handlers) PostApiAuthReset(ctx context.Context, request PostApiAuthResetRequestObject) (PostApiAuthResetResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "ResetPassword"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	userName, err := txRepo.UseAuthToken(ctx, r.authTokenHash(dal.AuthTokenResetPassword, req.Token), dal.AuthTokenResetPassword)
+	if err != nil {
+		if errors.Is(err, dal.ErrInvalidAuthToken) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.UseAuthToken: %w", err)
+	}
+	if err = txRepo.UpdateUserPassword(ctx, userName, req.Password); err != nil {
+		return nil, fmt.Errorf("fail txRepo.UpdateUserPassword: %w", err)
+	}
+	if err = txRepo.RevokeAuthTokens(ctx, userName, dal.AuthTokenResetPassword); err != nil {
+		return nil, fmt.Errorf("fail txRepo.RevokeAuthTokens: %w", err)
+	}
+	// the token came by email, so the email is verified too
+	if err = txRepo.MarkEmailVerified(ctx, userName); err != nil {
+		return nil, fmt.Errorf("fail txRepo.MarkEmailVerified: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+	return PostApiAuthReset204Response{}, nil
+}
@@ -0,0 +1,384 @@
+package httpcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+const (
+	defaultAuctionMinStep          = 1
+	defaultAuctionExtensionSeconds = 60
+
+	// auctionCloseBatch is how many auctions are settled in one transaction
+	auctionCloseBatch = 10
+)
+
+var ErrAuctionNotStarted = errors.New("auction is not started yet")
+var ErrBidTooLow = errors.New("bid is too low")
+
+func newAuction(auction models.Auction) Auction {
+	return Auction{
+		Id:               auction.ID,
+		Item:             auction.MerchItem,
+		Variant:          nonEmptyPtr(auction.Variant),
+		StartPrice:       auction.StartPrice,
+		MinStep:          auction.MinStep,
+		ExtensionSeconds: auction.ExtensionSeconds,
+		StartsAt:         auction.StartsAt.Time,
+		EndsAt:           auction.EndsAt.Time,
+		Status:           AuctionStatus(auction.Status),
+		Leader:           textPtr(auction.Leader),
+		LeadingBid:       int8Ptr(auction.LeadingBid),
+		ClosedAt:         timePtr(auction.ClosedAt),
+	}
+}
+
+func newAuctionBid(bid models.AuctionBid) AuctionBid {
+	return AuctionBid{
+		Id:        bid.ID,
+		Bidder:    bid.Bidder,
+		Amount:    bid.Amount,
+		Status:    AuctionBidStatus(bid.Status),
+		CreatedAt: bid.CreatedAt.Time,
+	}
+}
+
+func (r Handlers) PostApiAdminAuctions(ctx context.Context, request PostApiAdminAuctionsRequestObject) (PostApiAdminAuctionsResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "CreateAuction"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	createArgs := models.CreateAuctionParams{
+		MerchItem:        req.Item,
+		StartPrice:       req.StartPrice,
+		MinStep:          defaultAuctionMinStep,
+		ExtensionSeconds: defaultAuctionExtensionSeconds,
+		StartsAt:         pgtype.Timestamptz{Time: req.StartsAt, Valid: true},
+		EndsAt:           pgtype.Timestamptz{Time: req.EndsAt, Valid: true},
+	}
+	if req.Variant != nil {
+		createArgs.Variant = *req.Variant
+	}
+	if req.MinStep != nil {
+		createArgs.MinStep = *req.MinStep
+	}
+	if req.ExtensionSeconds != nil {
+		createArgs.ExtensionSeconds = *req.ExtensionSeconds
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	if createArgs.Variant != "" {
+		if _, err = txRepo.GetMerchVariant(ctx, createArgs.MerchItem, createArgs.Variant); err != nil {
+			if errors.Is(err, dal.ErrInvalidMerchVariant) {
+				return nil, newError(400, err)
+			}
+			return nil, fmt.Errorf("fail txRepo.GetMerchVariant: %w", err)
+		}
+	}
+
+	auction, err := txRepo.CreateAuction(ctx, createArgs)
+	if err != nil {
+		if errors.Is(err, dal.ErrInternal) {
+			return nil, fmt.Errorf("fail txRepo.CreateAuction: %w", err)
+		}
+		return nil, newError(400, err)
+	}
+
+	// the lot is taken from stock until the auction is closed, it is returned if nobody bids
+	if createArgs.Variant != "" {
+		if err = txRepo.TakeMerchVariantStock(ctx, createArgs.MerchItem, createArgs.Variant, 1); err != nil {
+			if errors.Is(err, dal.ErrOutOfStock) {
+				return nil, newError(400, err)
+			}
+			return nil, fmt.Errorf("fail txRepo.TakeMerchVariantStock: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("auction created", slog.Int64("auction_id", auction.ID))
+	return PostApiAdminAuctions200JSONResponse(newAuction(*auction)), nil
+}
+
+func (r Handlers) GetApiAuctions(ctx context.Context, request GetApiAuctionsRequestObject) (GetApiAuctionsResponseObject, error) {
+	rows, err := r.Dal.ListOpenAuctions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	auctions := make([]Auction, 0, len(rows))
+	for _, row := range rows {
+		auctions = append(auctions, newAuction(row))
+	}
+	return GetApiAuctions200JSONResponse(auctions), nil
+}
+
+func (r Handlers) GetApiAuctionsId(ctx context.Context, request GetApiAuctionsIdRequestObject) (GetApiAuctionsIdResponseObject, error) {
+	auctionID := request.Id
+	if auctionID <= 0 {
+		return nil, fiber.NewError(400, "invalid auction id")
+	}
+
+	auction, err := r.Dal.GetAuction(ctx, auctionID)
+	if err != nil {
+		if errors.Is(err, dal.ErrAuctionNotFound) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail GetAuction: %w", err)
+	}
+
+	rows, err := r.Dal.ListAuctionBids(ctx, auction.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fail ListAuctionBids: %w", err)
+	}
+
+	bids := make([]AuctionBid, 0, len(rows))
+	for _, row := range rows {
+		bids = append(bids, newAuctionBid(row))
+	}
+
+	res := newAuction(*auction)
+	res.Bids = &bids
+	return GetApiAuctionsId200JSONResponse(res), nil
+}
+
+func (r Handlers) PostApiAuctionsIdBids(ctx context.Context, request PostApiAuctionsIdBidsRequestObject) (PostApiAuctionsIdBidsResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "PlaceBid"))
+
+	auctionID := request.Id
+	if auctionID <= 0 {
+		return nil, fiber.NewError(400, "invalid auction id")
+	}
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	pending, err := txRepo.GetAuctionForUpdate(ctx, auctionID)
+	if err != nil {
+		if errors.Is(err, dal.ErrAuctionNotFound) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.GetAuctionForUpdate: %w", err)
+	}
+
+	// the closer compares ends_at with the database time, so bids do too
+	now, err := txRepo.TransactionTime(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail txRepo.TransactionTime: %w", err)
+	}
+	if pending.Status != dal.AuctionStatusOpen || !now.Before(pending.EndsAt.Time) {
+		return nil, newError(409, dal.ErrAuctionClosed)
+	}
+	if now.Before(pending.StartsAt.Time) {
+		return nil, newError(409, ErrAuctionNotStarted)
+	}
+
+	minBid := pending.StartPrice
+	if pending.LeadingBid.Valid {
+		minBid = pending.LeadingBid.Int64 + pending.MinStep
+	}
+	if req.Amount < minBid {
+		return nil, newError(400, fmt.Errorf("%w: minimal bid is %d", ErrBidTooLow, minBid))
+	}
+
+	// the outbid leader gets coins back at once, it may be the same user raising own bid
+	released, err := txRepo.ReleaseAuctionBids(ctx, pending.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fail txRepo.ReleaseAuctionBids: %w", err)
+	}
+	for _, bid := range released {
+		if _, err = txRepo.PlusCoins(ctx, bid.Bidder, bid.Amount); err != nil {
+			return nil, fmt.Errorf("fail txRepo.PlusCoins: %w", err)
+		}
+	}
+
+	_, err = txRepo.MinusCoins(ctx, userName, req.Amount)
+	if err != nil {
+		if errors.Is(err, dal.ErrNotEnoughCoins) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.MinusCoins: %w", err)
+	}
+
+	_, err = txRepo.AddAuctionBid(ctx, models.CreateAuctionBidParams{
+		AuctionID: pending.ID,
+		Bidder:    userName,
+		Amount:    req.Amount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail txRepo.AddAuctionBid: %w", err)
+	}
+
+	// anti-sniping extends the auction in the query, against the database time
+	auction, err := txRepo.UpdateAuctionLead(ctx, models.UpdateAuctionLeadParams{
+		Leader:     pgtype.Text{String: userName, Valid: true},
+		LeadingBid: pgtype.Int8{Int64: req.Amount, Valid: true},
+		ID:         pending.ID,
+	})
+	if err != nil {
+		if errors.Is(err, dal.ErrAuctionClosed) {
+			return nil, newError(409, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.UpdateAuctionLead: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("auction bid placed",
+		slog.Int64("auction_id", auction.ID),
+		slog.String("bidder", userName),
+		slog.Int64("amount", req.Amount),
+	)
+	return PostApiAuctionsIdBids200JSONResponse(newAuction(*auction)), nil
+}
+
+// RunAuctionCloser settles due auctions every interval until ctx is done.
+// It is safe to run in every instance, due auctions are locked with skip locked
+func (r Handlers) RunAuctionCloser(ctx context.Context, interval time.Duration) {
+	log := r.Logger.With(slog.String("worker_name", "AuctionCloser"))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			closed, err := r.closeDueAuctions(ctx)
+			if err != nil {
+				log.Error("fail to close due auctions: " + err.Error())
+				break
+			}
+			if closed < auctionCloseBatch {
+				break
+			}
+		}
+	}
+}
+
+// closeDueAuctions settles a batch of due auctions in one transaction and returns how many were closed.
+// The winner gets the item into inventory, the held bid is kept as the price. The stock taken
+// for the lot is returned if nobody won
+func (r Handlers) closeDueAuctions(ctx context.Context) (int, error) {
+	log := r.Logger.With(slog.String("worker_name", "AuctionCloser"))
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail start db transaction: %w", err)
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	due, err := txRepo.ListDueAuctionsForUpdate(ctx, auctionCloseBatch)
+	if err != nil {
+		return 0, fmt.Errorf("fail txRepo.ListDueAuctionsForUpdate: %w", err)
+	}
+
+	for _, auction := range due {
+		if auction.Leader.Valid {
+			if err = txRepo.WinAuctionBid(ctx, auction.ID); err != nil {
+				return 0, fmt.Errorf("fail txRepo.WinAuctionBid: %w", err)
+			}
+
+			_, err = txRepo.AddMerchVariantToUser(ctx, auction.Leader.String, auction.MerchItem, auction.Variant)
+			if err != nil {
+				return 0, fmt.Errorf("fail txRepo.AddMerchVariantToUser: %w", err)
+			}
+
+			_, err = placeOrder(ctx, txRepo, models.CreateOrderParams{
+				UserName:  auction.Leader.String,
+				Payer:     auction.Leader.String,
+				MerchItem: auction.MerchItem,
+				Variant:   auction.Variant,
+				Price:     auction.LeadingBid.Int64,
+			})
+			if err != nil {
+				return 0, err
+			}
+		} else if auction.Variant != "" {
+			if err = txRepo.ReturnMerchVariantStock(ctx, auction.MerchItem, auction.Variant, 1); err != nil {
+				return 0, fmt.Errorf("fail txRepo.ReturnMerchVariantStock: %w", err)
+			}
+		}
+
+		if _, err = txRepo.CloseAuction(ctx, auction.ID); err != nil {
+			return 0, fmt.Errorf("fail txRepo.CloseAuction: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("fail tx.Commit: %w", err)
+	}
+
+	for _, auction := range due {
+		log.Info("auction closed",
+			slog.Int64("auction_id", auction.ID),
+			slog.String("winner", auction.Leader.String),
+			slog.Int64("price", auction.LeadingBid.Int64),
+		)
+	}
+	return len(due), nil
+}
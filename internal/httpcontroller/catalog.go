@@ -0,0 +1,171 @@
+package httpcontroller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+const (
+	langEn = "en"
+	langRu = "ru"
+
+	defaultMerchPageLimit = 20
+	maxMerchPageLimit     = 100
+)
+
+// acceptedLanguage picks the catalog language from Accept-Language, english is the fallback
+func acceptedLanguage(c *fiber.Ctx) string {
+	lang := c.AcceptsLanguages(langEn, langRu)
+	if lang == "" {
+		lang = langEn
+	}
+	return lang
+}
+
+// ContextWithLanguage sets the catalog language for strict handlers called outside of fiber,
+// languages other than ru fall back to english
+func ContextWithLanguage(ctx context.Context, lang string) context.Context {
+	if lang != langRu {
+		lang = langEn
+	}
+	return context.WithValue(ctx, languageCtxKey, lang)
+}
+
+// languageFromContext returns the language negotiated by RequestContext
+func languageFromContext(ctx context.Context) string {
+	lang, ok := ctx.Value(languageCtxKey).(string)
+	if !ok {
+		return langEn
+	}
+	return lang
+}
+
+func localized(lang string, ru string, en string) string {
+	if lang == langRu {
+		return ru
+	}
+	return en
+}
+
+// escapeLike makes user search text match literally in ILIKE
+var escapeLike = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace
+
+func newMerchItem(merch models.Merch, lang string) MerchItem {
+	return MerchItem{
+		Slug:        merch.Slug,
+		Name:        localized(lang, merch.NameRu, merch.NameEn),
+		Description: localized(lang, merch.DescriptionRu, merch.DescriptionEn),
+		Category:    textPtr(merch.Category),
+		ImageUrl:    textPtr(merch.ImageUrl),
+		Price:       merch.Price,
+	}
+}
+
+// LocalizedMerchItem converts a db merch item for the language set in ctx
+func LocalizedMerchItem(ctx context.Context, merch models.Merch) MerchItem {
+	return newMerchItem(merch, languageFromContext(ctx))
+}
+
+func (r Handlers) GetApiMerch(ctx context.Context, request GetApiMerchRequestObject) (GetApiMerchResponseObject, error) {
+	page, err := r.listMerch(ctx, request.Params)
+	if err != nil {
+		return nil, err
+	}
+	return GetApiMerch200JSONResponse{
+		Body: *page,
+		Headers: GetApiMerch200ResponseHeaders{
+			ContentLanguage: languageFromContext(ctx),
+			Vary:            fiber.HeaderAcceptLanguage,
+		},
+	}, nil
+}
+
+// listMerch is the catalog page of both api versions, names are in the language negotiated by RequestContext
+func (r Handlers) listMerch(ctx context.Context, params GetApiMerchParams) (*MerchPage, error) {
+	log := r.Logger.With(slog.String("handler_name", "ListMerch"))
+
+	if errValidate := params.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	lang := languageFromContext(ctx)
+
+	countArgs := models.CountMerchParams{
+		Category: pgText(params.Category),
+		MinPrice: pgInt8(params.MinPrice),
+		MaxPrice: pgInt8(params.MaxPrice),
+	}
+	if params.Q != nil {
+		countArgs.Search = escapeLike(*params.Q)
+	}
+
+	listArgs := models.ListMerchParams{
+		Category:   countArgs.Category,
+		MinPrice:   countArgs.MinPrice,
+		MaxPrice:   countArgs.MaxPrice,
+		Search:     countArgs.Search,
+		Lang:       lang,
+		PageLimit:  defaultMerchPageLimit,
+		PageOffset: 0,
+	}
+	if params.Sort != nil {
+		listArgs.Sort = string(*params.Sort)
+	}
+	if params.Limit != nil {
+		listArgs.PageLimit = *params.Limit
+	}
+	if params.Offset != nil {
+		listArgs.PageOffset = *params.Offset
+	}
+
+	rows, err := r.Dal.ListMerch(ctx, listArgs)
+	if err != nil {
+		return nil, fmt.Errorf("fail ListMerch: %w", err)
+	}
+
+	total, err := r.Dal.CountMerch(ctx, countArgs)
+	if err != nil {
+		return nil, fmt.Errorf("fail CountMerch: %w", err)
+	}
+
+	items := make([]MerchItem, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, newMerchItem(row, lang))
+	}
+	return &MerchPage{
+		Items:  items,
+		Total:  total,
+		Limit:  listArgs.PageLimit,
+		Offset: listArgs.PageOffset,
+	}, nil
+}
+
+func (r Handlers) GetApiMerchCategories(ctx context.Context, request GetApiMerchCategoriesRequestObject) (GetApiMerchCategoriesResponseObject, error) {
+	lang := languageFromContext(ctx)
+
+	rows, err := r.Dal.ListMerchCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail ListMerchCategories: %w", err)
+	}
+
+	categories := make([]MerchCategory, 0, len(rows))
+	for _, row := range rows {
+		categories = append(categories, MerchCategory{
+			Slug: row.Slug,
+			Name: localized(lang, row.NameRu, row.NameEn),
+		})
+	}
+	return GetApiMerchCategories200JSONResponse{
+		Body: categories,
+		Headers: GetApiMerchCategories200ResponseHeaders{
+			ContentLanguage: lang,
+			Vary:            fiber.HeaderAcceptLanguage,
+		},
+	}, nil
+}
@@ -0,0 +1,104 @@
+package httpcontroller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/filesystem"
+	swaggerFiles "github.com/swaggo/files/v2"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DocsPath     = "/api/docs"
+	SpecYAMLPath = "/api/openapi.yaml"
+	SpecJSONPath = "/api/openapi.json"
+)
+
+// swaggerInitializer replaces the one from swagger ui dist, which loads the petstore example
+var swaggerInitializer = fmt.Sprintf(`window.onload = function() {
+  window.ui = SwaggerUIBundle({
+    url: %q,
+    dom_id: '#swagger-ui',
+    deepLinking: true,
+    presets: [
+      SwaggerUIBundle.presets.apis,
+      SwaggerUIStandalonePreset
+    ],
+    plugins: [
+      SwaggerUIBundle.plugins.DownloadUrl
+    ],
+    layout: "StandaloneLayout"
+  });
+};
+`, SpecJSONPath)
+
+// Docs serves the embedded openapi spec and swagger ui, ui assets are embedded too, so docs work offline
+type Docs struct {
+	specJSON []byte
+	specYAML []byte
+
+	assets fiber.Handler
+}
+
+// NewDocs renders the spec once, serverURL replaces servers of api/v1.yaml. A relative url like "/"
+// makes swagger ui send requests to the host it was loaded from
+func NewDocs(serverURL string) (*Docs, error) {
+	doc, err := GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("fail to load openapi spec: %w", err)
+	}
+	doc.Servers = openapi3.Servers{{URL: serverURL}}
+
+	specJSON, err := doc.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("fail to marshal openapi spec to json: %w", err)
+	}
+	specYAML, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("fail to marshal openapi spec to yaml: %w", err)
+	}
+
+	return &Docs{
+		specJSON: specJSON,
+		specYAML: specYAML,
+		assets: filesystem.New(filesystem.Config{
+			Root:  http.FS(swaggerFiles.FS),
+			Index: "index.html",
+		}),
+	}, nil
+}
+
+// Register adds docs routes, they are not in the spec and need no token
+func (d *Docs) Register(router fiber.Router) {
+	router.Get(SpecYAMLPath, d.SpecYAML)
+	router.Get(SpecJSONPath, d.SpecJSON)
+	router.Get(DocsPath+"/swagger-initializer.js", d.SwaggerInitializer)
+	// filesystem middleware strips the mount path, it works only with Use
+	router.Use(DocsPath, d.UI)
+}
+
+func (d *Docs) SpecYAML(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, "application/yaml")
+	return c.Send(d.specYAML)
+}
+
+func (d *Docs) SpecJSON(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	return c.Send(d.specJSON)
+}
+
+func (d *Docs) SwaggerInitializer(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJavaScriptCharsetUTF8)
+	return c.SendString(swaggerInitializer)
+}
+
+// UI serves swagger ui assets, index.html links them relatively, so the docs path needs a trailing slash
+func (d *Docs) UI(c *fiber.Ctx) error {
+	if c.Path() == DocsPath {
+		return c.Redirect(DocsPath+"/", fiber.StatusMovedPermanently)
+	}
+	return d.assets(c)
+}
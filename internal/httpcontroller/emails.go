@@ -0,0 +1,127 @@
+package httpcontroller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+	"github.com/maximmihin/aw25/internal/mailer"
+)
+
+const (
+	emailBatchSize   = 100
+	emailSendTimeout = 30 * time.Second
+	// emailLease must outlast a send, otherwise another instance sends the email once more
+	emailLease         = 2 * emailSendTimeout
+	emailMaxAttempts   = 8
+	emailMaxRetryDelay = time.Hour
+)
+
+// queueEmail renders the email of kind in the language of the user and queues it in the transaction of txRepo.
+// Users whose name is not an email get nothing, optional emails are skipped for users who opted out too
+func (r Handlers) queueEmail(ctx context.Context, txRepo *dal.Dal, userName string, kind string, data any, optional bool) error {
+	if r.Mailer == nil || is.EmailFormat.Validate(userName) != nil {
+		return nil
+	}
+
+	settings, err := txRepo.GetEmailSettings(ctx, userName)
+	if err != nil {
+		return err
+	}
+	if optional && !settings.Enabled {
+		return nil
+	}
+
+	msg, err := mailer.Render(kind, settings.Language, data)
+	if err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", dal.ErrInternal, err)
+	}
+	return txRepo.AddEmailMessage(ctx, models.AddEmailMessageParams{
+		Recipient: userName,
+		Kind:      kind,
+		Subject:   msg.Subject,
+		TextBody:  msg.Text,
+		HtmlBody:  msg.HTML,
+	})
+}
+
+// RunEmailSender sends queued emails until ctx is done, every instance runs its own.
+// Failed emails are retried with exponential backoff starting from EmailRetryDelay
+func (r Handlers) RunEmailSender(ctx context.Context, interval time.Duration) {
+	log := r.Logger.With(slog.String("worker_name", "EmailSender"))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			messages, err := r.Dal.ClaimDueEmailMessages(ctx, time.Now().Add(emailLease), emailBatchSize)
+			if err != nil {
+				log.Error("fail to claim emails: " + err.Error())
+				break
+			}
+
+			var wg sync.WaitGroup
+			for _, message := range messages {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					r.sendEmail(ctx, log, message)
+				}()
+			}
+			wg.Wait()
+
+			if len(messages) < emailBatchSize {
+				break
+			}
+		}
+	}
+}
+
+func (r Handlers) sendEmail(ctx context.Context, log *slog.Logger, message models.EmailMessage) {
+	log = log.With(slog.Int64("email_id", message.ID))
+
+	sendCtx, cancel := context.WithTimeout(ctx, emailSendTimeout)
+	defer cancel()
+
+	err := r.Mailer.Send(sendCtx, mailer.Message{
+		ID:      "email-" + strconv.FormatInt(message.ID, 10) + "@merch-store",
+		To:      message.Recipient,
+		Subject: message.Subject,
+		Text:    message.TextBody,
+		HTML:    message.HtmlBody,
+	})
+	if err == nil {
+		if err = r.Dal.MarkEmailSent(ctx, message.ID); err != nil {
+			log.Error("fail to mark sent: " + err.Error())
+		}
+		return
+	}
+	if ctx.Err() != nil {
+		// the lease ends and the email is sent again, the attempt is not counted
+		return
+	}
+
+	attempt := message.Attempts + 1
+	nextAttemptAt := time.Now().Add(retryDelay(r.EmailRetryDelay, emailMaxRetryDelay, attempt))
+	if err = r.Dal.MarkEmailFailed(ctx, message.ID, err.Error(), nextAttemptAt, emailMaxAttempts); err != nil {
+		log.Error("fail to mark failed: " + err.Error())
+		return
+	}
+	if attempt >= emailMaxAttempts {
+		log.Warn("email is dead", slog.Int("attempts", int(attempt)))
+	}
+}
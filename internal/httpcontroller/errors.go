@@ -3,24 +3,215 @@ package httpcontroller
 import (
 	"errors"
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/maximmihin/aw25/internal/dal"
 )
 
+// Error is a client facing error with a stable machine-readable code
+type Error struct {
+	Status  int
+	Code    ErrorCode
+	Message string
+	// Fields lists invalid request fields, set only for validation errors
+	Fields []FieldError
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+var ErrWrongPassword = errors.New("wrong password")
+var ErrUserDeleted = errors.New("user was deleted")
+
+// errorCodes is the only place where domain errors get their codes,
+// codes are part of the api contract - document new ones in api/v1.yaml
+var errorCodes = []struct {
+	err  error
+	code ErrorCode
+}{
+	{dal.ErrInternal, ErrorCodeINTERNAL},
+	{dal.ErrUserMerchPairExist, ErrorCodeINTERNAL},
+
+	{dal.ErrUserAlreadyExists, ErrorCodeUSERALREADYEXISTS},
+	{dal.ErrInvalidUser, ErrorCodeINVALIDUSER},
+	{dal.ErrNotEnoughCoins, ErrorCodeNOTENOUGHCOINS},
+	{dal.ErrNonPositiveAmount, ErrorCodeNONPOSITIVEAMOUNT},
+	{dal.ErrInvalidSender, ErrorCodeINVALIDSENDER},
+	{dal.ErrInvalidRecipient, ErrorCodeINVALIDRECIPIENT},
+	{dal.ErrSelfTransfer, ErrorCodeSELFTRANSFER},
+	{dal.ErrTransferNotFound, ErrorCodeTRANSFERNOTFOUND},
+
+	{dal.ErrInvalidMerchItem, ErrorCodeUNKNOWNITEM},
+	{dal.ErrInvalidMerchVariant, ErrorCodeUNKNOWNVARIANT},
+	{dal.ErrOutOfStock, ErrorCodeOUTOFSTOCK},
+	{dal.ErrNotEnoughMerch, ErrorCodeNOTENOUGHMERCH},
+	{dal.ErrGiftToSelf, ErrorCodeGIFTTOSELF},
+
+	{dal.ErrNegativePrice, ErrorCodeNEGATIVEPRICE},
+	{dal.ErrNoRefundablePurchase, ErrorCodeNOREFUNDABLEPURCHASE},
+	{dal.ErrRefundAlreadyRequested, ErrorCodeREFUNDALREADYREQUESTED},
+	{dal.ErrRefundNotFound, ErrorCodeREFUNDNOTFOUND},
+	{dal.ErrRefundAlreadyResolved, ErrorCodeREFUNDALREADYRESOLVED},
+
+	{dal.ErrInvalidPromotion, ErrorCodeINVALIDPROMOTION},
+	{dal.ErrPromoCodeAlreadyExists, ErrorCodePROMOCODEALREADYEXISTS},
+	{dal.ErrInvalidPromoCode, ErrorCodeINVALIDPROMOCODE},
+	{dal.ErrPromoCodeNotApplicable, ErrorCodePROMOCODENOTAPPLICABLE},
+	{dal.ErrPromotionExhausted, ErrorCodePROMOTIONEXHAUSTED},
+	{dal.ErrInvalidBundle, ErrorCodeINVALIDBUNDLE},
+	{dal.ErrBundleAlreadyExists, ErrorCodeBUNDLEALREADYEXISTS},
+
+	{dal.ErrOrderNotFound, ErrorCodeORDERNOTFOUND},
+
+	{dal.ErrInvalidListing, ErrorCodeINVALIDLISTING},
+	{dal.ErrListingNotFound, ErrorCodeLISTINGNOTFOUND},
+	{dal.ErrListingClosed, ErrorCodeLISTINGCLOSED},
+	{dal.ErrNotEnoughListed, ErrorCodeNOTENOUGHLISTED},
+
+	{dal.ErrAlreadyInWishlist, ErrorCodeALREADYINWISHLIST},
+	{dal.ErrNotInWishlist, ErrorCodeNOTINWISHLIST},
+
+	{dal.ErrInvalidAuction, ErrorCodeINVALIDAUCTION},
+	{dal.ErrAuctionNotFound, ErrorCodeAUCTIONNOTFOUND},
+	{dal.ErrAuctionClosed, ErrorCodeAUCTIONCLOSED},
+
+	{dal.ErrInvalidRaffle, ErrorCodeINVALIDRAFFLE},
+	{dal.ErrRaffleNotFound, ErrorCodeRAFFLENOTFOUND},
+	{dal.ErrRaffleDrawn, ErrorCodeRAFFLEDRAWN},
+
+	{dal.ErrWebhookSubscriptionNotFound, ErrorCodeWEBHOOKNOTFOUND},
+	{dal.ErrWebhookDeliveryNotFound, ErrorCodeWEBHOOKDELIVERYNOTFOUND},
+
+	{dal.ErrNotificationNotFound, ErrorCodeNOTIFICATIONNOTFOUND},
+
+	{dal.ErrInvalidAuthToken, ErrorCodeINVALIDTOKEN},
+
+	{dal.ErrInvalidOTP, ErrorCodeINVALIDOTP},
+	{dal.ErrTwoFactorAlreadyEnabled, ErrorCodeTWOFACTORALREADYENABLED},
+	{dal.ErrTwoFactorNotEnabled, ErrorCodeTWOFACTORNOTENABLED},
+
+	{ErrWrongPassword, ErrorCodeWRONGPASSWORD},
+	{ErrUserDeleted, ErrorCodeUSERDELETED},
+	{ErrOrderStatusTransition, ErrorCodeORDERSTATUSTRANSITION},
+	{ErrWrongPickupCode, ErrorCodeWRONGPICKUPCODE},
+	{ErrRefundOrderCancelled, ErrorCodeREFUNDORDERCANCELLED},
+	{ErrBuyOwnListing, ErrorCodeBUYOWNLISTING},
+	{ErrAuctionNotStarted, ErrorCodeAUCTIONNOTSTARTED},
+	{ErrBidTooLow, ErrorCodeBIDTOOLOW},
+	{ErrRaffleSalesOver, ErrorCodeRAFFLESALESOVER},
+	{ErrRaffleSalesNotOver, ErrorCodeRAFFLESALESNOTOVER},
+	{ErrRaffleTicketsLimit, ErrorCodeRAFFLETICKETSLIMIT},
+	{ErrTooManyRequests, ErrorCodeTOOMANYREQUESTS},
+	{ErrEmailNotVerified, ErrorCodeEMAILNOTVERIFIED},
+	{ErrTwoFactorRequired, ErrorCodeTWOFACTORREQUIRED},
+}
+
+// newError keeps the message of err and takes the code of the first known error in its chain
+func newError(status int, err error) *Error {
+	return &Error{
+		Status:  status,
+		Code:    errorCode(status, err),
+		Message: err.Error(),
+	}
+}
+
+// errInternalServer hides details of an unexpected failure from the client
+func errInternalServer() *Error {
+	return &Error{Status: 500, Code: ErrorCodeINTERNAL, Message: "Internal error"}
+}
+
+func errorCode(status int, err error) ErrorCode {
+	for _, known := range errorCodes {
+		if errors.Is(err, known.err) {
+			return known.code
+		}
+	}
+	return statusErrorCode(status)
+}
+
+// statusErrorCode is a fallback for errors without a domain code
+func statusErrorCode(status int) ErrorCode {
+	switch status {
+	case 400:
+		return ErrorCodeBADREQUEST
+	case 401:
+		return ErrorCodeUNAUTHORIZED
+	case 403:
+		return ErrorCodeFORBIDDEN
+	case 404:
+		return ErrorCodeNOTFOUND
+	case 409:
+		return ErrorCodeCONFLICT
+	case 429:
+		return ErrorCodeTOOMANYREQUESTS
+	}
+	return ErrorCodeINTERNAL
+}
+
+// AsError converts any handler error to a client facing one, unexpected errors become internal
+func AsError(err error) *Error {
+	apiErr := new(Error)
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	fiberErr := new(fiber.Error)
+	if errors.As(err, &fiberErr) {
+		return &Error{Status: fiberErr.Code, Code: statusErrorCode(fiberErr.Code), Message: fiberErr.Message}
+	}
+	return errInternalServer()
+}
+
 func ErrorHandler(c *fiber.Ctx, err error) error {
 
 	if err != nil {
-		fiberErr := new(fiber.Error)
-		if errors.As(err, &fiberErr) {
-			return ResErr(c, fiberErr.Code, fiberErr.Message)
-		}
-		return ResErr(c, 500, "Internal error")
+		return resError(c, AsError(err))
 	}
 
 	return nil
 }
 
-func ResErr(c *fiber.Ctx, code int, msg string) error {
+func ResErr(c *fiber.Ctx, status int, code ErrorCode, msg string) error {
+	return resError(c, &Error{Status: status, Code: code, Message: msg})
+}
+
+const (
+	mimeProblemJSON = "application/problem+json"
+
+	problemTypePrefix = "urn:merch-shop:error:"
+)
+
+// resError writes the legacy ErrorResponse unless the client asked for RFC 7807 problem details
+func resError(c *fiber.Ctx, apiErr *Error) error {
+	c.Vary(fiber.HeaderAccept)
+	if c.Accepts(fiber.MIMEApplicationJSON, mimeProblemJSON) == mimeProblemJSON {
+		return resProblem(c, apiErr)
+	}
+
 	c.Response().Header.Set("Content-Type", "application/json")
-	c.Status(code)
+	c.Status(apiErr.Status)
 
-	return c.JSON(&ErrorResponse{Errors: &msg})
+	res := ErrorResponse{Code: apiErr.Code, Errors: &apiErr.Message}
+	if len(apiErr.Fields) > 0 {
+		res.Fields = &apiErr.Fields
+	}
+	return c.JSON(&res)
+}
+
+func resProblem(c *fiber.Ctx, apiErr *Error) error {
+	c.Status(apiErr.Status)
+
+	res := ProblemDetails{
+		Type:   problemTypePrefix + string(apiErr.Code),
+		Title:  utils.StatusMessage(apiErr.Status),
+		Status: apiErr.Status,
+		Detail: &apiErr.Message,
+		Code:   apiErr.Code,
+	}
+	if requestID := c.GetRespHeader(fiber.HeaderXRequestID); requestID != "" {
+		res.Instance = &requestID
+	}
+	if len(apiErr.Fields) > 0 {
+		res.Fields = &apiErr.Fields
+	}
+	return c.JSON(&res, mimeProblemJSON)
 }
@@ -0,0 +1,96 @@
+package httpcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/maximmihin/aw25/internal/eventbus"
+)
+
+const eventBusBatchSize = 100
+
+// userRegistered is the data of eventbus.OutboxUserRegistered, the api has no model of it
+type userRegistered struct {
+	Name  string `json:"name"`
+	Coins int64  `json:"coins"`
+}
+
+// RunEventPublisher publishes outbox events to EventBus until ctx is done, every instance runs its own
+func (r Handlers) RunEventPublisher(ctx context.Context, interval time.Duration) {
+	log := r.Logger.With(slog.String("worker_name", "EventPublisher"))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			published, err := r.publishOutboxEvents(ctx)
+			if err != nil {
+				log.Error("fail to publish events: " + err.Error())
+				break
+			}
+			if published < eventBusBatchSize {
+				break
+			}
+		}
+	}
+}
+
+// publishOutboxEvents publishes a batch of events in the order of the outbox and returns how many were published.
+// Events are marked only after the bus accepted them, so a crash in between publishes them again
+func (r Handlers) publishOutboxEvents(ctx context.Context) (int, error) {
+	log := r.Logger.With(slog.String("worker_name", "EventPublisher"))
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail start db transaction: %w", err)
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	outboxEvents, err := txRepo.ListUnpublishedOutboxEventsForUpdate(ctx, eventBusBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("fail txRepo.ListUnpublishedOutboxEventsForUpdate: %w", err)
+	}
+
+	// a failed event stops the batch, events after it wait for the next try to keep the order
+	published := make([]int64, 0, len(outboxEvents))
+	var errPublish error
+	for _, outboxEvent := range outboxEvents {
+		if event, ok := eventbus.FromOutbox(outboxEvent); ok {
+			if errPublish = r.EventBus.Publish(ctx, event); errPublish != nil {
+				break
+			}
+		}
+		published = append(published, outboxEvent.ID)
+	}
+
+	if len(published) > 0 {
+		if err = txRepo.MarkOutboxEventsPublished(ctx, published); err != nil {
+			return 0, fmt.Errorf("fail txRepo.MarkOutboxEventsPublished: %w", err)
+		}
+		if err = tx.Commit(ctx); err != nil {
+			return 0, fmt.Errorf("fail tx.Commit: %w", err)
+		}
+	}
+	if errPublish != nil {
+		return len(published), fmt.Errorf("fail EventBus.Publish: %w", errPublish)
+	}
+	return len(published), nil
+}
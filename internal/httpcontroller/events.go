@@ -0,0 +1,307 @@
+package httpcontroller
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+const (
+	eventsHeartbeatInterval = 15 * time.Second
+	// eventsPageSize is how many events a stream reads from the database at once
+	eventsPageSize = 1000
+	// eventsRetention is how long events are kept for resumption
+	eventsRetention = 24 * time.Hour
+	// eventsSequenceInterval is how often events are sequenced without a notification,
+	// in case the one of their insert was lost
+	eventsSequenceInterval = 10 * time.Second
+)
+
+// EventHub wakes open event streams of a user when the listener of this instance sees new events of the user.
+// Streams read the events from the database themselves, so a wake-up is never lost for a slow client
+type EventHub struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan struct{}]struct{}
+	closed      bool
+}
+
+func NewEventHub() *EventHub {
+	return &EventHub{subscribers: make(map[string]map[chan struct{}]struct{})}
+}
+
+// Publish never blocks, wake-ups of a subscriber that has not read the previous one yet are merged
+func (h *EventHub) Publish(userName string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for wakeups := range h.subscribers[userName] {
+		wake(wakeups)
+	}
+}
+
+// PublishAll wakes every subscriber, notifications may have been lost while the listener was reconnecting
+func (h *EventHub) PublishAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, userSubscribers := range h.subscribers {
+		for wakeups := range userSubscribers {
+			wake(wakeups)
+		}
+	}
+}
+
+// Subscribe returns wake-ups for new events of the user, the channel is closed when the hub is closed
+func (h *EventHub) Subscribe(userName string) (<-chan struct{}, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	wakeups := make(chan struct{}, 1)
+	if h.closed {
+		close(wakeups)
+		return wakeups, func() {}
+	}
+	if h.subscribers[userName] == nil {
+		h.subscribers[userName] = make(map[chan struct{}]struct{})
+	}
+	h.subscribers[userName][wakeups] = struct{}{}
+
+	return wakeups, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.remove(userName, wakeups)
+	}
+}
+
+// Close ends all streams, the server can not shut down while they are open
+func (h *EventHub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for userName, userSubscribers := range h.subscribers {
+		for wakeups := range userSubscribers {
+			h.remove(userName, wakeups)
+		}
+	}
+}
+
+func (h *EventHub) remove(userName string, wakeups chan struct{}) {
+	if _, ok := h.subscribers[userName][wakeups]; !ok {
+		return
+	}
+	delete(h.subscribers[userName], wakeups)
+	if len(h.subscribers[userName]) == 0 {
+		delete(h.subscribers, userName)
+	}
+	close(wakeups)
+}
+
+func wake(wakeups chan struct{}) {
+	select {
+	case wakeups <- struct{}{}:
+	default:
+	}
+}
+
+// sequenceUserEvents makes committed events readable by streams, see dal.SequenceUserEvents
+func (r Handlers) sequenceUserEvents(ctx context.Context, log *slog.Logger) (int64, error) {
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail to start db transaction: %w", err)
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	if err = txRepo.LockUserEventsSequencer(ctx); err != nil {
+		return 0, fmt.Errorf("fail txRepo.LockUserEventsSequencer: %w", err)
+	}
+	sequenced, err := txRepo.SequenceUserEvents(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail txRepo.SequenceUserEvents: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("fail tx.Commit: %w", err)
+	}
+	return sequenced, nil
+}
+
+// RunEventListener wakes streams of the hub from postgres notifications until ctx is done, every instance runs its own.
+// It also sequences new events and deletes events older than eventsRetention
+func (r Handlers) RunEventListener(ctx context.Context, retryInterval time.Duration) {
+	log := r.Logger.With(slog.String("worker_name", "EventListener"))
+
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+
+		for {
+			deleted, err := r.Dal.DeleteUserEventsBefore(ctx, time.Now().Add(-eventsRetention))
+			if err != nil {
+				log.Error("fail to delete old events: " + err.Error())
+			} else if deleted > 0 {
+				log.Info("old events deleted", slog.Int64("deleted", deleted))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	sequence := make(chan struct{}, 1)
+	go func() {
+		ticker := time.NewTicker(eventsSequenceInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sequence:
+			case <-ticker.C:
+			}
+
+			if _, err := r.sequenceUserEvents(ctx, log); err != nil && ctx.Err() == nil {
+				log.Error("fail to sequence events: " + err.Error())
+			}
+		}
+	}()
+
+	listener := dal.UserEventListener{
+		Listening: func() {
+			wake(sequence)
+			r.Events.PublishAll()
+		},
+		Added: func() {
+			wake(sequence)
+		},
+		Sequenced: r.Events.Publish,
+	}
+
+	for {
+		err := r.Dal.ListenUserEvents(ctx, listener)
+		if err != nil {
+			log.Error("fail to listen events: " + err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// GetEvents streams events of the user as server-sent events. Event ids are seq of the events,
+// a reconnecting client sends Last-Event-ID and gets missed events first
+func (r Handlers) GetEvents(c *fiber.Ctx) error {
+	log := r.Logger.With(slog.String("handler_name", "GetEvents"))
+
+	userName, err := ExtractUserNameFromJwt(c)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return err
+	}
+
+	var lastSeq int64
+	resume := false
+	if header := c.Get("Last-Event-ID"); header != "" {
+		lastSeq, err = strconv.ParseInt(header, 10, 64)
+		if err != nil || lastSeq < 0 {
+			return fiber.NewError(400, "invalid Last-Event-ID")
+		}
+		resume = true
+	}
+
+	// the fiber context is reused after the handler returns, the stream writer must not touch it
+	ctx := c.UserContext()
+
+	// subscribe before reading the cursor, so nothing sequenced in between is lost
+	wakeups, unsubscribe := r.Events.Subscribe(userName)
+
+	if !resume {
+		lastSeq, err = r.Dal.LastUserEventSeq(ctx, userName)
+		if err != nil {
+			unsubscribe()
+			return newError(500, err)
+		}
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	// proxies must not buffer the stream
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer unsubscribe()
+
+		// writeNew sends everything sequenced after lastSeq, a wake-up may be for events already sent
+		writeNew := func() bool {
+			for {
+				events, err := r.Dal.ListUserEventsAfter(ctx, userName, lastSeq, eventsPageSize)
+				if err != nil {
+					log.Error("fail to list events: " + err.Error())
+					return false
+				}
+				for _, event := range events {
+					writeEvent(w, event)
+					lastSeq = event.Seq.Int64
+				}
+				if len(events) < eventsPageSize {
+					return true
+				}
+			}
+		}
+
+		if !writeNew() || w.Flush() != nil {
+			return
+		}
+
+		heartbeat := time.NewTicker(eventsHeartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case _, ok := <-wakeups:
+				if !ok {
+					return
+				}
+				if !writeNew() {
+					return
+				}
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": heartbeat\n\n")
+			}
+			// a failed flush means the client has gone
+			if w.Flush() != nil {
+				return
+			}
+		}
+	})
+	return nil
+}
+
+func writeEvent(w *bufio.Writer, event models.UserEvent) {
+	fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Seq.Int64, event.Kind, event.Payload)
+}
@@ -1,3 +1,4 @@
 package httpcontroller
 
 //go:generate oapi-codegen --config=oapi-codegen.yaml ../../api/v1.yaml
+//go:generate oapi-codegen --config=oapi-codegen-server.yaml ../../api/v1.yaml
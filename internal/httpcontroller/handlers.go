@@ -1,16 +1,23 @@
 package httpcontroller
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/gofiber/fiber/v2"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
 	"log/slog"
+	"slices"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 
 	"github.com/maximmihin/aw25/internal/dal"
+	"github.com/maximmihin/aw25/internal/eventbus"
+	"github.com/maximmihin/aw25/internal/mailer"
 )
 
 type Handlers struct {
@@ -18,6 +25,32 @@ type Handlers struct {
 	Logger *slog.Logger
 
 	JWTPrivateKey string
+
+	// Admins are user names allowed to use /api/admin routes, with a jwt issued after the second factor only
+	Admins []string
+
+	RefundWindow time.Duration
+
+	// MarketFeePercent is the share of each marketplace sale which goes to HouseAccount
+	MarketFeePercent int64
+	HouseAccount     string
+
+	// Events delivers user events to open GET /api/events streams
+	Events *EventHub
+
+	// WebhookRetryDelay is the delay after the first failed webhook delivery, it doubles with every next failure
+	WebhookRetryDelay time.Duration
+
+	// EventBus gets domain events of the outbox, nil if the bus is disabled
+	EventBus eventbus.Publisher
+
+	// Mailer sends email notifications to users named by an email, nil if emails are disabled
+	Mailer mailer.Sender
+	// EmailRetryDelay is the delay after the first failed email, it doubles with every next failure
+	EmailRetryDelay time.Duration
+
+	// RequireVerifiedEmail forbids users to send coins until they verify the email of their name
+	RequireVerifiedEmail bool
 }
 
 const (
@@ -37,29 +70,35 @@ var MerchShowCase = map[string]int64{ // TODO hide in dal with cash
 	"pink-hoody": 500,
 }
 
-func newJWT(userName string, JWTPrivateKey string) (string, error) {
-	claims := jwt.RegisteredClaims{
-		Subject: userName,
-	}
+// amrOTP is the authentication method (RFC 8176) of tokens issued after the second factor was checked
+const amrOTP = "otp"
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(JWTPrivateKey))
+type jwtClaims struct {
+	jwt.RegisteredClaims
+	AMR []string `json:"amr,omitempty"`
 }
 
-func resAuth(c *fiber.Ctx, jwt string) error {
-	c.Response().Header.Set("Content-Type", "application/json")
-	c.Status(200)
+func newJWT(userName string, JWTPrivateKey string, amr ...string) (string, error) {
+	claims := jwtClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: userName,
+		},
+		AMR: amr,
+	}
 
-	return c.JSON(&AuthResponse{
-		Token: &jwt,
-	})
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(JWTPrivateKey))
 }
 
-func resUserInfo(c *fiber.Ctx, info models.UserInfo) error { // TODO use view  models
-	c.Response().Header.Set("Content-Type", "application/json")
-	c.Status(200)
-
-	return c.JSON(&info)
+// newInfoResponse converts the dal view, its json shape is the InfoResponse one
+func newInfoResponse(info models.UserInfo) (InfoResponse, error) {
+	var res InfoResponse
+	raw, err := json.Marshal(info)
+	if err != nil {
+		return res, err
+	}
+	err = json.Unmarshal(raw, &res)
+	return res, err
 }
 
 var ErrJWTWasNotSet = errors.New("jwt was not set or set on unexpected fiber local name")
@@ -86,81 +125,266 @@ func ExtractUserNameFromJwt(c *fiber.Ctx) (string, error) {
 	return subj, nil
 }
 
-func (r Handlers) Auth(c *fiber.Ctx) error { // TODO add bcrypt check
+// jwtHasOTP tells whether the jwt of the request was issued after the second factor was checked
+func jwtHasOTP(c *fiber.Ctx) bool {
+	token, ok := c.Locals("user").(*jwt.Token)
+	if !ok {
+		return false
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return false
+	}
+	amr, _ := claims["amr"].([]any)
+	return slices.Contains(amr, any(amrOTP))
+}
+
+type ctxKey int
+
+const (
+	userNameCtxKey ctxKey = iota
+	languageCtxKey
+)
 
-	ctx := c.Context()
+// UserContext is what handlers need from the fiber context: the catalog language and the user of a checked jwt, if any
+func UserContext(c *fiber.Ctx) (context.Context, error) {
+	ctx := context.WithValue(c.UserContext(), languageCtxKey, acceptedLanguage(c))
 
-	log := r.Logger.With(slog.String("handler_name", "Auth"))
+	userName, err := ExtractUserNameFromJwt(c)
+	switch {
+	case err == nil:
+		ctx = ContextWithUserName(ctx, userName)
+	case !errors.Is(err, ErrJWTWasNotSet):
+		return nil, err
+	}
+	return ctx, nil
+}
+
+// RequestContext passes to strict handlers what they need from the fiber context
+func (r Handlers) RequestContext(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+	return func(c *fiber.Ctx, request interface{}) (interface{}, error) {
+		ctx, err := UserContext(c)
+		if err != nil {
+			r.Logger.Error("error via extract name from jwt token: " + err.Error())
+			return nil, err
+		}
+
+		c.SetUserContext(ctx)
+		return f(c, request)
+	}
+}
+
+// StrictErrors renders handler errors with ErrorHandler, generated strict handlers would reply 400 to any error
+func (r Handlers) StrictErrors(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+	return func(c *fiber.Ctx, request interface{}) (interface{}, error) {
+		response, err := f(c, request)
+		if err == nil {
+			return response, nil
+		}
+
+		apiErr := new(Error)
+		fiberErr := new(fiber.Error)
+		if !errors.As(err, &apiErr) && !errors.As(err, &fiberErr) {
+			r.Logger.Error("operation failed",
+				slog.String("operation_id", operationID),
+				slog.String("error", err.Error()),
+			)
+		}
+		return nil, ErrorHandler(c, err)
+	}
+}
+
+// ContextWithUserName authenticates strict handlers called outside of fiber, like the grpc server
+func ContextWithUserName(ctx context.Context, userName string) context.Context {
+	return context.WithValue(ctx, userNameCtxKey, userName)
+}
+
+// userNameFromContext returns the authenticated user, it is set by RequestContext for secured operations
+func userNameFromContext(ctx context.Context) (string, error) {
+	userName, ok := ctx.Value(userNameCtxKey).(string)
+	if !ok {
+		return "", ErrJWTWasNotSet
+	}
+	return userName, nil
+}
 
-	var req AuthRequest
-	if err := c.BodyParser(&req); err != nil {
-		return fiber.NewError(400, "invalid body: "+err.Error())
+func (r Handlers) AdminOnly(c *fiber.Ctx) error {
+	userName, err := ExtractUserNameFromJwt(c)
+	if errors.Is(err, ErrJWTWasNotSet) {
+		return newError(401, err)
+	}
+	if err != nil {
+		r.Logger.Error("error via extract name from jwt token: " + err.Error())
+		return err
+	}
+	if !r.isAdmin(userName) {
+		return fiber.NewError(403, "admin rights required")
 	}
+	if !jwtHasOTP(c) {
+		return newError(403, ErrTwoFactorRequired)
+	}
+	return c.Next()
+}
+
+func (r Handlers) isAdmin(userName string) bool {
+	return slices.Contains(r.Admins, userName)
+}
+
+func (r Handlers) PostApiAuth(ctx context.Context, request PostApiAuthRequestObject) (PostApiAuthResponseObject, error) { // TODO add bcrypt check
+
+	log := r.Logger.With(slog.String("handler_name", "Auth"))
+
+	req := *request.Body
 
 	if errValidate := req.Validate(log); errValidate != nil {
-		return errValidate
+		return nil, errValidate
 	}
 
 	user, err := r.Dal.GetUserByName(ctx, req.Username)
 	if err != nil {
 		log.Error("fail to GetUserByName: " + err.Error())
-		return err
+		return nil, err
 	}
 
 	if user != nil {
 		if user.Password != req.Password {
-			return fiber.NewError(401, "wrong password")
+			return nil, newError(401, ErrWrongPassword)
+		}
+		challenge, err := r.newLoginChallenge(ctx, user.Name)
+		if err != nil {
+			log.Error("fail to create login challenge: " + err.Error())
+			return nil, err
+		}
+		if challenge != "" {
+			return PostApiAuth200JSONResponse{Challenge: &challenge}, nil
 		}
 		jwtt, err := newJWT(user.Name, r.JWTPrivateKey)
 		if err != nil {
 			log.Error("fail to create new JWT: " + err.Error())
-			return err
+			return nil, err
 		}
-		return resAuth(c, jwtt)
+		return PostApiAuth200JSONResponse{Token: &jwtt}, nil
 	}
 
-	user, err = r.Dal.AddNewUser(ctx, models.CreateUserParams{
+	user, err = r.registerUser(ctx, models.CreateUserParams{
 		Name:     req.Username,
 		Password: req.Password,
 		Coins:    welcomeBonusCoins,
 	})
 	if err != nil {
 		log.Error("fail to add new user AddNewUser: " + err.Error())
-		return err
+		return nil, err
 	}
 	jwtt, err := newJWT(user.Name, r.JWTPrivateKey)
 	if err != nil {
 		log.Error("fail to create new JWT: " + err.Error())
-		return err
+		return nil, err
+	}
+	return PostApiAuth200JSONResponse{Token: &jwtt}, nil
+}
+
+// registerUser creates the user with the UserRegistered event
+func (r Handlers) registerUser(ctx context.Context, createArgs models.CreateUserParams) (*models.User, error) {
+	log := r.Logger.With(slog.String("handler_name", "Auth"))
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail start db transaction: %w", err)
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	user, err := txRepo.AddNewUser(ctx, createArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = addOutboxEvent(ctx, txRepo, eventbus.OutboxUserRegistered, userRegistered{
+		Name:  user.Name,
+		Coins: user.Coins,
+	}); err != nil {
+		return nil, fmt.Errorf("fail addOutboxEvent: %w", err)
+	}
+
+	if err = r.sendAuthToken(ctx, txRepo, user.Name, dal.AuthTokenVerifyEmail, verifyEmailTokenTTL); err != nil {
+		return nil, fmt.Errorf("fail sendAuthToken: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+	return user, nil
+}
+
+func (r Handlers) GetApiBuyItem(ctx context.Context, request GetApiBuyItemRequestObject) (GetApiBuyItemResponseObject, error) {
+	var req BuyMerchRequest
+	if variant := request.Params.Variant; variant != nil && *variant != "" {
+		req.Variant = variant
+	}
+	if promoCode := request.Params.PromoCode; promoCode != nil && *promoCode != "" {
+		req.PromoCode = promoCode
+	}
+	if _, err := r.buyMerch(ctx, request.Item, req); err != nil {
+		return nil, err
+	}
+	return GetApiBuyItem200Response{}, nil
+}
+
+func (r Handlers) PostApiBuyItem(ctx context.Context, request PostApiBuyItemRequestObject) (PostApiBuyItemResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "BuyMerchWithBody"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
 	}
-	return resAuth(c, jwtt)
+
+	if _, err := r.buyMerch(ctx, request.Item, req); err != nil {
+		return nil, err
+	}
+	return PostApiBuyItem200Response{}, nil
 }
 
-func (r Handlers) BuyMerch(c *fiber.Ctx) error {
+// buyMerch charges the user and places the order, for gifts the order is picked up by the recipient
+func (r Handlers) buyMerch(ctx context.Context, merchItem string, req BuyMerchRequest) (*models.MerchOrder, error) {
 
 	log := r.Logger.With(slog.String("handler_name", "BuyMerch"))
 
-	merchItem := c.Params("item")
 	if merchItem == "" {
-		return fiber.NewError(400, dal.ErrInvalidMerchItem.Error())
+		return nil, newError(400, dal.ErrInvalidMerchItem)
 	}
 	merchCost, ok := MerchShowCase[merchItem]
 	if !ok {
-		return fiber.NewError(400, "invalid merch item") // TODO return enum
+		return nil, newError(400, dal.ErrInvalidMerchItem)
 	}
 
-	userName, err := ExtractUserNameFromJwt(c)
+	userName, err := userNameFromContext(ctx)
 	if err != nil {
 		log.Error("error via extract name from jwt token: " + err.Error())
-		return err
+		return nil, err
+	}
+
+	recipient := userName
+	if req.ForUser != nil {
+		recipient = *req.ForUser
 	}
+	isGift := recipient != userName
 
-	ctx := c.Context()
+	var variant string
+	if req.Variant != nil {
+		variant = *req.Variant
+	}
 
 	tx, err := r.Dal.PgxPool.Begin(ctx)
 	if err != nil {
 		log.Error("error via start db transaction: " + err.Error())
-		return err
+		return nil, err
 	}
 	defer func() {
 		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
@@ -170,59 +394,156 @@ func (r Handlers) BuyMerch(c *fiber.Ctx) error {
 
 	txRepo := r.Dal.WithTx(tx)
 
-	_, err = txRepo.MinusCoins(ctx, userName, merchCost)
+	if variant != "" {
+		merchVariant, err := txRepo.GetMerchVariant(ctx, merchItem, variant)
+		if err != nil {
+			if errors.Is(err, dal.ErrInvalidMerchVariant) {
+				return nil, newError(400, err)
+			}
+			return nil, fmt.Errorf("fail txRepo.GetMerchVariant: %w", err)
+		}
+		if merchVariant.Price.Valid {
+			merchCost = merchVariant.Price.Int64
+		}
+
+		if err = txRepo.TakeMerchVariantStock(ctx, merchItem, variant, 1); err != nil {
+			if errors.Is(err, dal.ErrOutOfStock) {
+				return nil, newError(400, err)
+			}
+			return nil, fmt.Errorf("fail txRepo.TakeMerchVariantStock: %w", err)
+		}
+	}
+
+	promotions, err := eligiblePromotions(ctx, txRepo, userName, merchItem, req.PromoCode)
+	if err != nil {
+		return nil, err
+	}
+	merchPrice, applied := applyPromotions(merchCost, promotions)
+
+	_, err = txRepo.MinusCoins(ctx, userName, merchPrice)
 	if err != nil {
 		if errors.Is(err, dal.ErrNotEnoughCoins) {
-			return fiber.NewError(400, err.Error())
+			return nil, newError(400, err)
 		}
-		return fmt.Errorf("fail txRepo.MinusCoins: %w", err)
+		return nil, fmt.Errorf("fail txRepo.MinusCoins: %w", err)
 	}
 
-	_, err = txRepo.AddMerchToUser(ctx, userName, merchItem)
+	_, err = txRepo.AddMerchVariantToUser(ctx, recipient, merchItem, variant)
 	if err != nil {
 		if errors.Is(err, dal.ErrInvalidUser) {
-			return fiber.NewError(400, "user was deleted")
+			if isGift {
+				return nil, newError(400, dal.ErrInvalidRecipient)
+			}
+			return nil, newError(400, ErrUserDeleted)
 		}
 		if errors.Is(err, dal.ErrInvalidMerchItem) {
 			log.Error("fail via AddMerchToUser: " + err.Error()) // this must be checked in code before
-			return fiber.NewError(400, err.Error())              // TODO return enum
+			return nil, newError(400, err)
 		}
 		if errors.Is(err, dal.ErrUserMerchPairExist) {
 			log.Error("fail via AddMerchToUser: " +
 				"this method should never have allowed this limitation to be constraint " +
 				err.Error())
 		}
-		return fmt.Errorf("fail txRepo.AddMerchToUser: %w", err)
+		return nil, fmt.Errorf("fail txRepo.AddMerchToUser: %w", err)
+	}
+
+	order := models.CreateOrderParams{
+		UserName:  recipient,
+		Payer:     userName,
+		MerchItem: merchItem,
+		Variant:   variant,
+		Price:     merchPrice,
+	}
+
+	if isGift {
+		var message string
+		if req.Message != nil {
+			message = *req.Message
+		}
+		gift, err := txRepo.CreateGift(ctx, models.CreateGiftParams{
+			Sender:    userName,
+			Recipient: recipient,
+			MerchItem: merchItem,
+			Variant:   variant,
+			Price:     merchPrice,
+			Discount:  merchCost - merchPrice,
+			Message:   message,
+		})
+		if err != nil {
+			if errors.Is(err, dal.ErrInvalidRecipient) {
+				return nil, newError(400, err)
+			}
+			return nil, fmt.Errorf("fail txRepo.CreateGift: %w", err)
+		}
+		order.GiftID = pgtype.Int8{Int64: gift.ID, Valid: true}
+	} else {
+		// gifts are not refundable, so only own purchases are recorded
+		purchase, err := txRepo.AddPurchase(ctx, models.CreatePurchaseParams{
+			UserName:  userName,
+			MerchItem: merchItem,
+			Variant:   variant,
+			Price:     merchPrice,
+			Discount:  merchCost - merchPrice,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail txRepo.AddPurchase: %w", err)
+		}
+		order.PurchaseID = pgtype.Int8{Int64: purchase.ID, Valid: true}
+	}
+
+	placed, err := placeOrder(ctx, txRepo, order)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, promo := range applied {
+		if _, err = txRepo.AddPromotionRedemption(ctx, promo.promotion.ID, userName, promo.discount); err != nil {
+			return nil, fmt.Errorf("fail txRepo.AddPromotionRedemption: %w", err)
+		}
+	}
+
+	if err = addOutboxEvent(ctx, txRepo, eventbus.OutboxMerchPurchased, newOrder(*placed, "")); err != nil {
+		return nil, fmt.Errorf("fail addOutboxEvent: %w", err)
 	}
 
 	if err = tx.Commit(ctx); err != nil {
 		log.Error("fail tx.Commit: " + err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	return placed, nil
 }
 
-func (r Handlers) CoinTransfer(c *fiber.Ctx) error {
+func (r Handlers) PostApiSendCoin(ctx context.Context, request PostApiSendCoinRequestObject) (PostApiSendCoinResponseObject, error) {
+	if _, err := r.sendCoin(ctx, *request.Body); err != nil {
+		return nil, err
+	}
+	return PostApiSendCoin200Response{}, nil
+}
+
+// sendCoin moves coins from the user to req.ToUser, v1 and v2 transfers share it
+func (r Handlers) sendCoin(ctx context.Context, req SendCoinRequest) (*models.CoinTransfer, error) {
 	log := r.Logger.With(slog.String("handler_name", "CoinTransfer"))
 
-	var req SendCoinRequest
-	if err := c.BodyParser(&req); err != nil {
-		return fiber.NewError(400, "invalid request body")
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
 	}
 
-	userName, err := ExtractUserNameFromJwt(c)
+	userName, err := userNameFromContext(ctx)
 	if err != nil {
 		log.Error("error via extract name from jwt token: " + err.Error())
-		return err
+		return nil, err
 	}
 
-	ctx := c.Context()
+	if err = r.checkEmailVerified(ctx, userName); err != nil {
+		return nil, err
+	}
 
 	tx, err := r.Dal.PgxPool.Begin(ctx)
 	if err != nil {
 		log.Error("fail to start tx: " + err.Error())
-		return err
+		return nil, err
 	}
 	defer func() {
 		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
@@ -232,52 +553,65 @@ func (r Handlers) CoinTransfer(c *fiber.Ctx) error {
 
 	txRepo := r.Dal.WithTx(tx)
 
-	_, err = txRepo.CreateTransfer(ctx, userName, req.ToUser, int64(req.Amount))
+	transfer, err := txRepo.CreateTransfer(ctx, userName, req.ToUser, int64(req.Amount))
 	if err != nil {
 		if errors.Is(err, dal.ErrInternal) {
 			log.Error("fail to create transfer: " + err.Error())
-			return err
+			return nil, err
 		}
-		return fiber.NewError(400, err.Error())
+		return nil, newError(400, err)
 	}
 
 	_, err = txRepo.MinusCoins(ctx, userName, int64(req.Amount))
 	if err != nil {
 		if errors.Is(err, dal.ErrNotEnoughCoins) {
-			return fiber.NewError(400, err.Error())
+			return nil, newError(400, err)
 		}
-		return fmt.Errorf("fail txRepo.MinusCoins: %w", err)
+		return nil, fmt.Errorf("fail txRepo.MinusCoins: %w", err)
 	}
 
 	if _, err = txRepo.PlusCoins(ctx, req.ToUser, int64(req.Amount)); err != nil {
 		log.Error("fail to add coins: " + err.Error())
-		return err
+		return nil, err
+	}
+
+	message := fmt.Sprintf("%s sent you %d coins", transfer.Sender, transfer.Amount)
+	email := mailer.CoinsReceived{From: transfer.Sender, Amount: transfer.Amount}
+	if err = r.notify(ctx, txRepo, transfer.Recipient, dal.NotificationCoinsReceived, nil, message, email); err != nil {
+		return nil, fmt.Errorf("fail notify: %w", err)
+	}
+
+	if err = addOutboxEvent(ctx, txRepo, eventbus.OutboxCoinsTransferred, newTransfer(*transfer)); err != nil {
+		return nil, fmt.Errorf("fail addOutboxEvent: %w", err)
 	}
 
 	if err = tx.Commit(ctx); err != nil {
 		log.Error("fail to commit tx: " + err.Error())
+		return nil, err
 	}
-	return nil
+	return transfer, nil
 }
 
-func (r Handlers) Info(c *fiber.Ctx) error {
+func (r Handlers) GetApiInfo(ctx context.Context, request GetApiInfoRequestObject) (GetApiInfoResponseObject, error) {
 	log := r.Logger.With(slog.String("handler_name", "Info"))
 
-	userName, err := ExtractUserNameFromJwt(c)
+	userName, err := userNameFromContext(ctx)
 	if err != nil {
 		log.Error("error via extract name from jwt token: " + err.Error())
-		return err
+		return nil, err
 	}
 
-	ctx := c.Context()
-
 	userInfo, err := r.Dal.GetCompositeUserInfo(ctx, userName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if userInfo == nil {
-		return fmt.Errorf("jwt token valid, but user not found in db")
+		return nil, fmt.Errorf("jwt token valid, but user not found in db")
 	}
 
-	return resUserInfo(c, *userInfo)
+	res, err := newInfoResponse(*userInfo)
+	if err != nil {
+		return nil, fmt.Errorf("fail newInfoResponse: %w", err)
+	}
+	return GetApiInfo200JSONResponse(res), nil
 }
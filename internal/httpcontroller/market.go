@@ -0,0 +1,301 @@
+package httpcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+var ErrBuyOwnListing = errors.New("you can not buy your own listing")
+
+func newListing(listing models.MarketListing) Listing {
+	return Listing{
+		Id:        listing.ID,
+		Seller:    listing.Seller,
+		Item:      listing.MerchItem,
+		Variant:   nonEmptyPtr(listing.Variant),
+		Quantity:  listing.Quantity,
+		Price:     listing.Price,
+		Status:    ListingStatus(listing.Status),
+		CreatedAt: listing.CreatedAt.Time,
+		ClosedAt:  timePtr(listing.ClosedAt),
+	}
+}
+
+// marketFee is the house share of the sale amount, rounded down
+func (r Handlers) marketFee(amount int64) int64 {
+	return amount * r.MarketFeePercent / 100
+}
+
+func (r Handlers) GetApiMarketListings(ctx context.Context, request GetApiMarketListingsRequestObject) (GetApiMarketListingsResponseObject, error) {
+	var item string
+	if request.Params.Item != nil {
+		item = *request.Params.Item
+	}
+
+	rows, err := r.Dal.ListActiveListings(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+
+	listings := make([]Listing, 0, len(rows))
+	for _, row := range rows {
+		listings = append(listings, newListing(row))
+	}
+	return GetApiMarketListings200JSONResponse(listings), nil
+}
+
+func (r Handlers) PostApiMarketListings(ctx context.Context, request PostApiMarketListingsRequestObject) (PostApiMarketListingsResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "CreateListing"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	var variant string
+	if req.Variant != nil {
+		variant = *req.Variant
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	// listed units are reserved by taking them out of the inventory
+	_, err = txRepo.TakeMerchFromUser(ctx, userName, req.Item, variant, req.Quantity)
+	if err != nil {
+		if errors.Is(err, dal.ErrNotEnoughMerch) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.TakeMerchFromUser: %w", err)
+	}
+
+	listing, err := txRepo.CreateListing(ctx, models.CreateListingParams{
+		Seller:    userName,
+		MerchItem: req.Item,
+		Variant:   variant,
+		Quantity:  req.Quantity,
+		Price:     req.Price,
+	})
+	if err != nil {
+		if errors.Is(err, dal.ErrInternal) {
+			return nil, fmt.Errorf("fail txRepo.CreateListing: %w", err)
+		}
+		return nil, newError(400, err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("listing created",
+		slog.Int64("listing_id", listing.ID),
+		slog.String("seller", userName),
+	)
+	return PostApiMarketListings200JSONResponse(newListing(*listing)), nil
+}
+
+func (r Handlers) PostApiMarketListingsIdBuy(ctx context.Context, request PostApiMarketListingsIdBuyRequestObject) (PostApiMarketListingsIdBuyResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "BuyListing"))
+
+	listingID := request.Id
+	if listingID <= 0 {
+		return nil, fiber.NewError(400, "invalid listing id")
+	}
+
+	var req BuyListingRequest
+	if request.Body != nil {
+		req = *request.Body
+	}
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	quantity := int64(1)
+	if req.Quantity != nil {
+		quantity = *req.Quantity
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	listing, err := txRepo.GetListingForUpdate(ctx, listingID)
+	if err != nil {
+		if errors.Is(err, dal.ErrListingNotFound) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.GetListingForUpdate: %w", err)
+	}
+	if listing.Status != dal.ListingStatusActive {
+		return nil, newError(409, dal.ErrListingClosed)
+	}
+	if listing.Seller == userName {
+		return nil, newError(400, ErrBuyOwnListing)
+	}
+
+	if _, err = txRepo.TakeListingUnits(ctx, listing.ID, quantity); err != nil {
+		if errors.Is(err, dal.ErrNotEnoughListed) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.TakeListingUnits: %w", err)
+	}
+
+	amount := listing.Price * quantity
+	fee := r.marketFee(amount)
+
+	_, err = txRepo.MinusCoins(ctx, userName, amount)
+	if err != nil {
+		if errors.Is(err, dal.ErrNotEnoughCoins) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.MinusCoins: %w", err)
+	}
+
+	if _, err = txRepo.PlusCoins(ctx, listing.Seller, amount-fee); err != nil {
+		return nil, fmt.Errorf("fail txRepo.PlusCoins seller: %w", err)
+	}
+
+	if fee > 0 {
+		if _, err = txRepo.PlusCoins(ctx, r.HouseAccount, fee); err != nil {
+			return nil, fmt.Errorf("fail txRepo.PlusCoins house account: %w", err)
+		}
+	}
+
+	if _, err = txRepo.GiveMerchToUser(ctx, userName, listing.MerchItem, listing.Variant, quantity); err != nil {
+		if errors.Is(err, dal.ErrInvalidUser) {
+			return nil, newError(400, ErrUserDeleted)
+		}
+		return nil, fmt.Errorf("fail txRepo.GiveMerchToUser: %w", err)
+	}
+
+	_, err = txRepo.AddMarketSale(ctx, models.CreateMarketSaleParams{
+		ListingID: listing.ID,
+		Buyer:     userName,
+		Quantity:  quantity,
+		Amount:    amount,
+		Fee:       fee,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail txRepo.AddMarketSale: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("listing bought",
+		slog.Int64("listing_id", listing.ID),
+		slog.String("buyer", userName),
+		slog.Int64("quantity", quantity),
+		slog.Int64("fee", fee),
+	)
+	return PostApiMarketListingsIdBuy200Response{}, nil
+}
+
+func (r Handlers) PostApiMarketListingsIdCancel(ctx context.Context, request PostApiMarketListingsIdCancelRequestObject) (PostApiMarketListingsIdCancelResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "CancelListing"))
+
+	listingID := request.Id
+	if listingID <= 0 {
+		return nil, fiber.NewError(400, "invalid listing id")
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	pending, err := txRepo.GetListingForUpdate(ctx, listingID)
+	if err != nil {
+		if errors.Is(err, dal.ErrListingNotFound) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.GetListingForUpdate: %w", err)
+	}
+	if pending.Seller != userName {
+		return nil, newError(404, dal.ErrListingNotFound)
+	}
+	if pending.Status != dal.ListingStatusActive {
+		return nil, newError(409, dal.ErrListingClosed)
+	}
+
+	if _, err = txRepo.GiveMerchToUser(ctx, userName, pending.MerchItem, pending.Variant, pending.Quantity); err != nil {
+		return nil, fmt.Errorf("fail txRepo.GiveMerchToUser: %w", err)
+	}
+
+	listing, err := txRepo.CancelListing(ctx, pending.ID)
+	if err != nil {
+		if errors.Is(err, dal.ErrListingClosed) {
+			return nil, newError(409, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.CancelListing: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("listing cancelled",
+		slog.Int64("listing_id", listing.ID),
+		slog.String("seller", userName),
+	)
+	return PostApiMarketListingsIdCancel200JSONResponse(newListing(*listing)), nil
+}
@@ -3,10 +3,235 @@
 // Code generated by github.com/maximmihin/oapi-codegen/v2 version v2.4.5 DO NOT EDIT.
 package httpcontroller
 
+import (
+	"time"
+)
+
 const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AuctionBidStatus.
+const (
+	AuctionBidStatusHeld     AuctionBidStatus = "held"
+	AuctionBidStatusReleased AuctionBidStatus = "released"
+	AuctionBidStatusWon      AuctionBidStatus = "won"
+)
+
+// Defines values for AuctionStatus.
+const (
+	AuctionStatusClosed AuctionStatus = "closed"
+	AuctionStatusOpen   AuctionStatus = "open"
+)
+
+// Defines values for EmailPreferencesLanguage.
+const (
+	EmailPreferencesLanguageEn EmailPreferencesLanguage = "en"
+	EmailPreferencesLanguageRu EmailPreferencesLanguage = "ru"
+)
+
+// Defines values for ErrorCode.
+const (
+	ErrorCodeALREADYINWISHLIST       ErrorCode = "ALREADY_IN_WISHLIST"
+	ErrorCodeAUCTIONCLOSED           ErrorCode = "AUCTION_CLOSED"
+	ErrorCodeAUCTIONNOTFOUND         ErrorCode = "AUCTION_NOT_FOUND"
+	ErrorCodeAUCTIONNOTSTARTED       ErrorCode = "AUCTION_NOT_STARTED"
+	ErrorCodeBADREQUEST              ErrorCode = "BAD_REQUEST"
+	ErrorCodeBIDTOOLOW               ErrorCode = "BID_TOO_LOW"
+	ErrorCodeBUNDLEALREADYEXISTS     ErrorCode = "BUNDLE_ALREADY_EXISTS"
+	ErrorCodeBUYOWNLISTING           ErrorCode = "BUY_OWN_LISTING"
+	ErrorCodeCONFLICT                ErrorCode = "CONFLICT"
+	ErrorCodeEMAILNOTVERIFIED        ErrorCode = "EMAIL_NOT_VERIFIED"
+	ErrorCodeFORBIDDEN               ErrorCode = "FORBIDDEN"
+	ErrorCodeGIFTTOSELF              ErrorCode = "GIFT_TO_SELF"
+	ErrorCodeINTERNAL                ErrorCode = "INTERNAL"
+	ErrorCodeINVALIDAUCTION          ErrorCode = "INVALID_AUCTION"
+	ErrorCodeINVALIDBUNDLE           ErrorCode = "INVALID_BUNDLE"
+	ErrorCodeINVALIDLISTING          ErrorCode = "INVALID_LISTING"
+	ErrorCodeINVALIDOTP              ErrorCode = "INVALID_OTP"
+	ErrorCodeINVALIDPROMOCODE        ErrorCode = "INVALID_PROMO_CODE"
+	ErrorCodeINVALIDPROMOTION        ErrorCode = "INVALID_PROMOTION"
+	ErrorCodeINVALIDRAFFLE           ErrorCode = "INVALID_RAFFLE"
+	ErrorCodeINVALIDRECIPIENT        ErrorCode = "INVALID_RECIPIENT"
+	ErrorCodeINVALIDSENDER           ErrorCode = "INVALID_SENDER"
+	ErrorCodeINVALIDTOKEN            ErrorCode = "INVALID_TOKEN"
+	ErrorCodeINVALIDUSER             ErrorCode = "INVALID_USER"
+	ErrorCodeLISTINGCLOSED           ErrorCode = "LISTING_CLOSED"
+	ErrorCodeLISTINGNOTFOUND         ErrorCode = "LISTING_NOT_FOUND"
+	ErrorCodeNEGATIVEPRICE           ErrorCode = "NEGATIVE_PRICE"
+	ErrorCodeNONPOSITIVEAMOUNT       ErrorCode = "NON_POSITIVE_AMOUNT"
+	ErrorCodeNOREFUNDABLEPURCHASE    ErrorCode = "NO_REFUNDABLE_PURCHASE"
+	ErrorCodeNOTENOUGHCOINS          ErrorCode = "NOT_ENOUGH_COINS"
+	ErrorCodeNOTENOUGHLISTED         ErrorCode = "NOT_ENOUGH_LISTED"
+	ErrorCodeNOTENOUGHMERCH          ErrorCode = "NOT_ENOUGH_MERCH"
+	ErrorCodeNOTFOUND                ErrorCode = "NOT_FOUND"
+	ErrorCodeNOTIFICATIONNOTFOUND    ErrorCode = "NOTIFICATION_NOT_FOUND"
+	ErrorCodeNOTINWISHLIST           ErrorCode = "NOT_IN_WISHLIST"
+	ErrorCodeORDERNOTFOUND           ErrorCode = "ORDER_NOT_FOUND"
+	ErrorCodeORDERSTATUSTRANSITION   ErrorCode = "ORDER_STATUS_TRANSITION"
+	ErrorCodeOUTOFSTOCK              ErrorCode = "OUT_OF_STOCK"
+	ErrorCodePROMOCODEALREADYEXISTS  ErrorCode = "PROMO_CODE_ALREADY_EXISTS"
+	ErrorCodePROMOCODENOTAPPLICABLE  ErrorCode = "PROMO_CODE_NOT_APPLICABLE"
+	ErrorCodePROMOTIONEXHAUSTED      ErrorCode = "PROMOTION_EXHAUSTED"
+	ErrorCodeQUERYTOOCOMPLEX         ErrorCode = "QUERY_TOO_COMPLEX"
+	ErrorCodeRAFFLEDRAWN             ErrorCode = "RAFFLE_DRAWN"
+	ErrorCodeRAFFLENOTFOUND          ErrorCode = "RAFFLE_NOT_FOUND"
+	ErrorCodeRAFFLESALESNOTOVER      ErrorCode = "RAFFLE_SALES_NOT_OVER"
+	ErrorCodeRAFFLESALESOVER         ErrorCode = "RAFFLE_SALES_OVER"
+	ErrorCodeRAFFLETICKETSLIMIT      ErrorCode = "RAFFLE_TICKETS_LIMIT"
+	ErrorCodeREFUNDALREADYREQUESTED  ErrorCode = "REFUND_ALREADY_REQUESTED"
+	ErrorCodeREFUNDALREADYRESOLVED   ErrorCode = "REFUND_ALREADY_RESOLVED"
+	ErrorCodeREFUNDNOTFOUND          ErrorCode = "REFUND_NOT_FOUND"
+	ErrorCodeREFUNDORDERCANCELLED    ErrorCode = "REFUND_ORDER_CANCELLED"
+	ErrorCodeSELFTRANSFER            ErrorCode = "SELF_TRANSFER"
+	ErrorCodeTOOMANYREQUESTS         ErrorCode = "TOO_MANY_REQUESTS"
+	ErrorCodeTRANSFERNOTFOUND        ErrorCode = "TRANSFER_NOT_FOUND"
+	ErrorCodeTWOFACTORALREADYENABLED ErrorCode = "TWO_FACTOR_ALREADY_ENABLED"
+	ErrorCodeTWOFACTORNOTENABLED     ErrorCode = "TWO_FACTOR_NOT_ENABLED"
+	ErrorCodeTWOFACTORREQUIRED       ErrorCode = "TWO_FACTOR_REQUIRED"
+	ErrorCodeUNAUTHORIZED            ErrorCode = "UNAUTHORIZED"
+	ErrorCodeUNKNOWNITEM             ErrorCode = "UNKNOWN_ITEM"
+	ErrorCodeUNKNOWNVARIANT          ErrorCode = "UNKNOWN_VARIANT"
+	ErrorCodeUSERALREADYEXISTS       ErrorCode = "USER_ALREADY_EXISTS"
+	ErrorCodeUSERDELETED             ErrorCode = "USER_DELETED"
+	ErrorCodeVALIDATIONFAILED        ErrorCode = "VALIDATION_FAILED"
+	ErrorCodeWEBHOOKDELIVERYNOTFOUND ErrorCode = "WEBHOOK_DELIVERY_NOT_FOUND"
+	ErrorCodeWEBHOOKNOTFOUND         ErrorCode = "WEBHOOK_NOT_FOUND"
+	ErrorCodeWRONGPASSWORD           ErrorCode = "WRONG_PASSWORD"
+	ErrorCodeWRONGPICKUPCODE         ErrorCode = "WRONG_PICKUP_CODE"
+)
+
+// Defines values for ListingStatus.
+const (
+	ListingStatusActive    ListingStatus = "active"
+	ListingStatusCancelled ListingStatus = "cancelled"
+	ListingStatusSold      ListingStatus = "sold"
+)
+
+// Defines values for MerchSort.
+const (
+	MerchSortMinusPrice MerchSort = "-price"
+	MerchSortName       MerchSort = "name"
+	MerchSortPrice      MerchSort = "price"
+)
+
+// Defines values for NotificationKind.
+const (
+	NotificationKindCoinsReceived NotificationKind = "coins_received"
+	NotificationKindItemOnSale    NotificationKind = "item_on_sale"
+	NotificationKindItemRestocked NotificationKind = "item_restocked"
+	NotificationKindOrderReady    NotificationKind = "order_ready"
+)
+
+// Defines values for OrderStatus.
+const (
+	OrderStatusCancelled  OrderStatus = "cancelled"
+	OrderStatusHandedOver OrderStatus = "handed_over"
+	OrderStatusPlaced     OrderStatus = "placed"
+	OrderStatusReady      OrderStatus = "ready"
+)
+
+// Defines values for RaffleStatus.
+const (
+	RaffleStatusDrawn RaffleStatus = "drawn"
+	RaffleStatusOpen  RaffleStatus = "open"
+)
+
+// Defines values for RefundStatus.
+const (
+	RefundStatusApproved  RefundStatus = "approved"
+	RefundStatusRejected  RefundStatus = "rejected"
+	RefundStatusRequested RefundStatus = "requested"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeCoinsTransferred WebhookEventType = "coins.transferred"
+	WebhookEventTypeMerchPurchased   WebhookEventType = "merch.purchased"
+)
+
+// AddWishlistItemRequest defines model for AddWishlistItemRequest.
+type AddWishlistItemRequest struct {
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Variant Вариант предмета.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// Auction defines model for Auction.
+type Auction struct {
+	// Bids Ставки, сначала новые. Возвращаются только при запросе одного аукциона.
+	Bids *[]AuctionBid `json:"bids,omitempty"`
+
+	// ClosedAt Время закрытия аукциона.
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+
+	// EndsAt Время окончания аукциона с учетом продлений.
+	EndsAt time.Time `json:"endsAt"`
+
+	// ExtensionSeconds Ставка, сделанная меньше чем за это время до конца, продлевает аукцион на это время.
+	ExtensionSeconds int64 `json:"extensionSeconds"`
+
+	// Id Идентификатор аукциона.
+	Id int64 `json:"id"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Leader Имя лидирующего участника, после закрытия - победителя.
+	Leader *string `json:"leader,omitempty"`
+
+	// LeadingBid Лидирующая ставка.
+	LeadingBid *int64 `json:"leadingBid,omitempty"`
+
+	// MinStep На сколько следующая ставка должна быть больше лидирующей.
+	MinStep int64 `json:"minStep"`
+
+	// StartPrice Минимальная первая ставка.
+	StartPrice int64 `json:"startPrice"`
+
+	// StartsAt Время начала приема ставок.
+	StartsAt time.Time `json:"startsAt"`
+
+	// Status Статус аукциона.
+	Status AuctionStatus `json:"status"`
+
+	// Variant Вариант предмета.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// AuctionBid defines model for AuctionBid.
+type AuctionBid struct {
+	// Amount Сумма ставки.
+	Amount int64 `json:"amount"`
+
+	// Bidder Имя участника.
+	Bidder string `json:"bidder"`
+
+	// CreatedAt Время ставки.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Идентификатор ставки.
+	Id int64 `json:"id"`
+
+	// Status Статус ставки - удерживается, возвращена или выиграла.
+	Status AuctionBidStatus `json:"status"`
+}
+
+// AuctionBidStatus Статус ставки - удерживается, возвращена или выиграла.
+type AuctionBidStatus string
+
+// AuctionStatus Статус аукциона.
+type AuctionStatus string
+
 // AuthRequest defines model for AuthRequest.
 type AuthRequest struct {
 	// Password Пароль для аутентификации.
@@ -18,14 +243,364 @@ type AuthRequest struct {
 
 // AuthResponse defines model for AuthResponse.
 type AuthResponse struct {
+	// Challenge Возвращается вместо токена, если нужен код двухфакторной аутентификации.
+	Challenge *string `json:"challenge,omitempty"`
+
 	// Token JWT-токен для доступа к защищенным ресурсам.
 	Token *string `json:"token,omitempty"`
 }
 
+// Bundle defines model for Bundle.
+type Bundle struct {
+	// EndsAt Время окончания продажи набора.
+	EndsAt time.Time    `json:"endsAt"`
+	Items  []BundleItem `json:"items"`
+
+	// Price Цена набора.
+	Price int64 `json:"price"`
+
+	// Slug Идентификатор набора.
+	Slug string `json:"slug"`
+
+	// StartsAt Время начала продажи набора.
+	StartsAt time.Time `json:"startsAt"`
+}
+
+// BundleItem defines model for BundleItem.
+type BundleItem struct {
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Quantity Количество предметов в наборе.
+	Quantity int64 `json:"quantity"`
+}
+
+// BuyListingRequest defines model for BuyListingRequest.
+type BuyListingRequest struct {
+	// Quantity Сколько предметов купить, по умолчанию один.
+	Quantity *int64 `json:"quantity,omitempty"`
+}
+
+// BuyMerchRequest defines model for BuyMerchRequest.
+type BuyMerchRequest struct {
+	// ForUser Имя пользователя, которому покупается подарок. Если не указано - предмет покупается себе.
+	ForUser *string `json:"forUser,omitempty"`
+
+	// Message Сообщение к подарку.
+	Message *string `json:"message,omitempty"`
+
+	// PromoCode Промокод на скидку.
+	PromoCode *string `json:"promoCode,omitempty"`
+
+	// Variant Вариант предмета (например, размер). Если не указан - покупается предмет без варианта.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// BuyRaffleTicketsRequest defines model for BuyRaffleTicketsRequest.
+type BuyRaffleTicketsRequest struct {
+	// Quantity Сколько билетов купить, по умолчанию 1.
+	Quantity *int64 `json:"quantity,omitempty"`
+}
+
+// CreateAuctionRequest defines model for CreateAuctionRequest.
+type CreateAuctionRequest struct {
+	// EndsAt Время окончания аукциона.
+	EndsAt time.Time `json:"endsAt"`
+
+	// ExtensionSeconds Продление при поздней ставке в секундах, по умолчанию 60.
+	ExtensionSeconds *int64 `json:"extensionSeconds,omitempty"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// MinStep Минимальный шаг ставки, по умолчанию 1.
+	MinStep *int64 `json:"minStep,omitempty"`
+
+	// StartPrice Минимальная первая ставка.
+	StartPrice int64 `json:"startPrice"`
+
+	// StartsAt Время начала приема ставок.
+	StartsAt time.Time `json:"startsAt"`
+
+	// Variant Вариант предмета.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// CreateListingRequest defines model for CreateListingRequest.
+type CreateListingRequest struct {
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Price Цена за один предмет.
+	Price int64 `json:"price"`
+
+	// Quantity Сколько предметов выставить на продажу.
+	Quantity int64 `json:"quantity"`
+
+	// Variant Вариант предмета.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// CreateOrderRequest defines model for CreateOrderRequest.
+type CreateOrderRequest struct {
+	// ForUser Имя пользователя, которому покупается подарок. Если не указано - предмет покупается себе.
+	ForUser *string `json:"forUser,omitempty"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Message Сообщение к подарку.
+	Message *string `json:"message,omitempty"`
+
+	// PromoCode Промокод на скидку.
+	PromoCode *string `json:"promoCode,omitempty"`
+
+	// Variant Вариант предмета (например, размер). Если не указан - покупается предмет без варианта.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// CreatePromotionRequest defines model for CreatePromotionRequest.
+type CreatePromotionRequest struct {
+	// AmountOff Скидка в монетах.
+	AmountOff *int64 `json:"amountOff,omitempty"`
+
+	// Code Промокод. Если не указан - создается распродажа, которая применяется автоматически.
+	Code *string `json:"code,omitempty"`
+
+	// EndsAt Время окончания акции.
+	EndsAt time.Time `json:"endsAt"`
+
+	// Item Тип предмета, на который действует акция. Если не указан - акция действует на все предметы.
+	Item *string `json:"item,omitempty"`
+
+	// MaxRedemptions Сколько раз акция может быть применена всего.
+	MaxRedemptions *int64 `json:"maxRedemptions,omitempty"`
+
+	// MaxRedemptionsPerUser Сколько раз акция может быть применена одним пользователем.
+	MaxRedemptionsPerUser *int64 `json:"maxRedemptionsPerUser,omitempty"`
+
+	// PercentOff Скидка в процентах от цены.
+	PercentOff *int32 `json:"percentOff,omitempty"`
+
+	// Stackable Можно ли совмещать акцию с другими.
+	Stackable *bool `json:"stackable,omitempty"`
+
+	// StartsAt Время начала акции.
+	StartsAt time.Time `json:"startsAt"`
+}
+
+// CreateRaffleRequest defines model for CreateRaffleRequest.
+type CreateRaffleRequest struct {
+	// Item Разыгрываемый предмет.
+	Item string `json:"item"`
+
+	// MaxTicketsPerUser Сколько билетов может купить один участник. Если не указано - без ограничений.
+	MaxTicketsPerUser *int64 `json:"maxTicketsPerUser,omitempty"`
+
+	// SalesEndAt Время окончания продажи билетов.
+	SalesEndAt time.Time `json:"salesEndAt"`
+
+	// TicketPrice Цена одного билета.
+	TicketPrice int64 `json:"ticketPrice"`
+
+	// Variant Вариант разыгрываемого предмета.
+	Variant *string `json:"variant,omitempty"`
+
+	// WinnersCount Сколько участников получат приз, по умолчанию 1.
+	WinnersCount *int64 `json:"winnersCount,omitempty"`
+}
+
+// CreateWebhookSubscriptionRequest defines model for CreateWebhookSubscriptionRequest.
+type CreateWebhookSubscriptionRequest struct {
+	Events []WebhookEventType `json:"events"`
+
+	// Secret Секрет для подписи. Если не указан - генерируется.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Адрес http или https, на который доставляются события.
+	Url string `json:"url"`
+}
+
+// EmailPreferences Письма о получении монет и о готовности заказа отправляются на имя пользователя, если оно является email. Если не передано при изменении настроек, остается прежним.
+type EmailPreferences struct {
+	// Enabled Отправлять ли письма. По умолчанию включено.
+	Enabled bool `json:"enabled"`
+
+	// Language Язык писем. По умолчанию en.
+	Language EmailPreferencesLanguage `json:"language"`
+}
+
+// EmailPreferencesLanguage Язык писем. По умолчанию en.
+type EmailPreferencesLanguage string
+
+// ErrorCode Стабильный машиночитаемый код ошибки. Значения не меняются, новые коды могут добавляться.
+// * `INTERNAL` - внутренняя ошибка сервера
+// * `BAD_REQUEST` - неверный запрос без более точного кода
+// * `UNAUTHORIZED` - отсутствует или неверный токен
+// * `FORBIDDEN` - недостаточно прав
+// * `NOT_FOUND` - ресурс не найден
+// * `CONFLICT` - конфликт состояния без более точного кода
+// * `VALIDATION_FAILED` - параметры запроса не прошли валидацию
+// * `WRONG_PASSWORD` - неверный пароль
+// * `USER_ALREADY_EXISTS` - пользователь уже существует
+// * `INVALID_USER` - пользователь не существует
+// * `USER_DELETED` - пользователь был удален
+// * `NOT_ENOUGH_COINS` - у пользователя недостаточно монет
+// * `NON_POSITIVE_AMOUNT` - сумма перевода должна быть положительной
+// * `INVALID_SENDER` - отправитель не существует
+// * `INVALID_RECIPIENT` - получатель не существует
+// * `SELF_TRANSFER` - перевод самому себе
+// * `TRANSFER_NOT_FOUND` - перевод не найден
+// * `UNKNOWN_ITEM` - неизвестный товар
+// * `UNKNOWN_VARIANT` - неизвестный вариант товара
+// * `OUT_OF_STOCK` - вариант товара закончился
+// * `NOT_ENOUGH_MERCH` - у пользователя недостаточно товаров
+// * `GIFT_TO_SELF` - подарок самому себе
+// * `NEGATIVE_PRICE` - цена должна быть неотрицательной
+// * `NO_REFUNDABLE_PURCHASE` - нет покупки, доступной для возврата
+// * `REFUND_ALREADY_REQUESTED` - возврат этой покупки уже запрошен
+// * `REFUND_NOT_FOUND` - возврат не найден
+// * `REFUND_ALREADY_RESOLVED` - возврат уже рассмотрен
+// * `REFUND_ORDER_CANCELLED` - заказ покупки уже отменен, монеты возвращены при отмене
+// * `INVALID_PROMOTION` - неверные параметры акции
+// * `PROMO_CODE_ALREADY_EXISTS` - промокод уже существует
+// * `INVALID_PROMO_CODE` - неверный промокод
+// * `PROMO_CODE_NOT_APPLICABLE` - промокод не применим к этому товару сейчас
+// * `PROMOTION_EXHAUSTED` - исчерпан лимит использований акции
+// * `INVALID_BUNDLE` - неверный набор
+// * `BUNDLE_ALREADY_EXISTS` - набор уже существует
+// * `ORDER_NOT_FOUND` - заказ не найден
+// * `ORDER_STATUS_TRANSITION` - заказ нельзя перевести в этот статус
+// * `WRONG_PICKUP_CODE` - неверный код выдачи
+// * `INVALID_LISTING` - неверные параметры объявления
+// * `LISTING_NOT_FOUND` - объявление не найдено
+// * `LISTING_CLOSED` - объявление уже продано или отменено
+// * `NOT_ENOUGH_LISTED` - в объявлении недостаточно единиц
+// * `BUY_OWN_LISTING` - покупка своего объявления
+// * `ALREADY_IN_WISHLIST` - товар уже в списке желаний
+// * `NOT_IN_WISHLIST` - товара нет в списке желаний
+// * `INVALID_AUCTION` - неверные параметры аукциона
+// * `AUCTION_NOT_FOUND` - аукцион не найден
+// * `AUCTION_NOT_STARTED` - аукцион еще не начался
+// * `AUCTION_CLOSED` - аукцион завершен
+// * `BID_TOO_LOW` - ставка слишком мала
+// * `INVALID_RAFFLE` - неверные параметры розыгрыша
+// * `RAFFLE_NOT_FOUND` - розыгрыш не найден
+// * `RAFFLE_SALES_OVER` - продажа билетов завершена
+// * `RAFFLE_SALES_NOT_OVER` - продажа билетов еще не завершена
+// * `RAFFLE_TICKETS_LIMIT` - превышен лимит билетов на пользователя
+// * `RAFFLE_DRAWN` - розыгрыш уже проведен
+// * `QUERY_TOO_COMPLEX` - GraphQL-запрос превышает лимит глубины или сложности
+// * `WEBHOOK_NOT_FOUND` - подписка на вебхуки не найдена
+// * `WEBHOOK_DELIVERY_NOT_FOUND` - доставка вебхука не найдена
+// * `NOTIFICATION_NOT_FOUND` - уведомление не найдено
+// * `TOO_MANY_REQUESTS` - слишком много запросов
+// * `INVALID_TOKEN` - токен недействителен, истек или уже использован
+// * `EMAIL_NOT_VERIFIED` - email пользователя не подтвержден
+// * `INVALID_OTP` - неверный или уже использованный код двухфакторной аутентификации
+// * `TWO_FACTOR_REQUIRED` - требуется двухфакторная аутентификация
+// * `TWO_FACTOR_ALREADY_ENABLED` - двухфакторная аутентификация уже включена
+// * `TWO_FACTOR_NOT_ENABLED` - двухфакторная аутентификация не включена
+type ErrorCode string
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
+	// Code Стабильный машиночитаемый код ошибки. Значения не меняются, новые коды могут добавляться.
+	// * `INTERNAL` - внутренняя ошибка сервера
+	// * `BAD_REQUEST` - неверный запрос без более точного кода
+	// * `UNAUTHORIZED` - отсутствует или неверный токен
+	// * `FORBIDDEN` - недостаточно прав
+	// * `NOT_FOUND` - ресурс не найден
+	// * `CONFLICT` - конфликт состояния без более точного кода
+	// * `VALIDATION_FAILED` - параметры запроса не прошли валидацию
+	// * `WRONG_PASSWORD` - неверный пароль
+	// * `USER_ALREADY_EXISTS` - пользователь уже существует
+	// * `INVALID_USER` - пользователь не существует
+	// * `USER_DELETED` - пользователь был удален
+	// * `NOT_ENOUGH_COINS` - у пользователя недостаточно монет
+	// * `NON_POSITIVE_AMOUNT` - сумма перевода должна быть положительной
+	// * `INVALID_SENDER` - отправитель не существует
+	// * `INVALID_RECIPIENT` - получатель не существует
+	// * `SELF_TRANSFER` - перевод самому себе
+	// * `TRANSFER_NOT_FOUND` - перевод не найден
+	// * `UNKNOWN_ITEM` - неизвестный товар
+	// * `UNKNOWN_VARIANT` - неизвестный вариант товара
+	// * `OUT_OF_STOCK` - вариант товара закончился
+	// * `NOT_ENOUGH_MERCH` - у пользователя недостаточно товаров
+	// * `GIFT_TO_SELF` - подарок самому себе
+	// * `NEGATIVE_PRICE` - цена должна быть неотрицательной
+	// * `NO_REFUNDABLE_PURCHASE` - нет покупки, доступной для возврата
+	// * `REFUND_ALREADY_REQUESTED` - возврат этой покупки уже запрошен
+	// * `REFUND_NOT_FOUND` - возврат не найден
+	// * `REFUND_ALREADY_RESOLVED` - возврат уже рассмотрен
+	// * `REFUND_ORDER_CANCELLED` - заказ покупки уже отменен, монеты возвращены при отмене
+	// * `INVALID_PROMOTION` - неверные параметры акции
+	// * `PROMO_CODE_ALREADY_EXISTS` - промокод уже существует
+	// * `INVALID_PROMO_CODE` - неверный промокод
+	// * `PROMO_CODE_NOT_APPLICABLE` - промокод не применим к этому товару сейчас
+	// * `PROMOTION_EXHAUSTED` - исчерпан лимит использований акции
+	// * `INVALID_BUNDLE` - неверный набор
+	// * `BUNDLE_ALREADY_EXISTS` - набор уже существует
+	// * `ORDER_NOT_FOUND` - заказ не найден
+	// * `ORDER_STATUS_TRANSITION` - заказ нельзя перевести в этот статус
+	// * `WRONG_PICKUP_CODE` - неверный код выдачи
+	// * `INVALID_LISTING` - неверные параметры объявления
+	// * `LISTING_NOT_FOUND` - объявление не найдено
+	// * `LISTING_CLOSED` - объявление уже продано или отменено
+	// * `NOT_ENOUGH_LISTED` - в объявлении недостаточно единиц
+	// * `BUY_OWN_LISTING` - покупка своего объявления
+	// * `ALREADY_IN_WISHLIST` - товар уже в списке желаний
+	// * `NOT_IN_WISHLIST` - товара нет в списке желаний
+	// * `INVALID_AUCTION` - неверные параметры аукциона
+	// * `AUCTION_NOT_FOUND` - аукцион не найден
+	// * `AUCTION_NOT_STARTED` - аукцион еще не начался
+	// * `AUCTION_CLOSED` - аукцион завершен
+	// * `BID_TOO_LOW` - ставка слишком мала
+	// * `INVALID_RAFFLE` - неверные параметры розыгрыша
+	// * `RAFFLE_NOT_FOUND` - розыгрыш не найден
+	// * `RAFFLE_SALES_OVER` - продажа билетов завершена
+	// * `RAFFLE_SALES_NOT_OVER` - продажа билетов еще не завершена
+	// * `RAFFLE_TICKETS_LIMIT` - превышен лимит билетов на пользователя
+	// * `RAFFLE_DRAWN` - розыгрыш уже проведен
+	// * `QUERY_TOO_COMPLEX` - GraphQL-запрос превышает лимит глубины или сложности
+	// * `WEBHOOK_NOT_FOUND` - подписка на вебхуки не найдена
+	// * `WEBHOOK_DELIVERY_NOT_FOUND` - доставка вебхука не найдена
+	// * `NOTIFICATION_NOT_FOUND` - уведомление не найдено
+	// * `TOO_MANY_REQUESTS` - слишком много запросов
+	// * `INVALID_TOKEN` - токен недействителен, истек или уже использован
+	// * `EMAIL_NOT_VERIFIED` - email пользователя не подтвержден
+	// * `INVALID_OTP` - неверный или уже использованный код двухфакторной аутентификации
+	// * `TWO_FACTOR_REQUIRED` - требуется двухфакторная аутентификация
+	// * `TWO_FACTOR_ALREADY_ENABLED` - двухфакторная аутентификация уже включена
+	// * `TWO_FACTOR_NOT_ENABLED` - двухфакторная аутентификация не включена
+	Code ErrorCode `json:"code"`
+
 	// Errors Сообщение об ошибке, описывающее проблему.
 	Errors *string `json:"errors,omitempty"`
+
+	// Fields Ошибки валидации по полям запроса, только для кода VALIDATION_FAILED.
+	Fields *[]FieldError `json:"fields,omitempty"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Путь к полю запроса, для элементов массивов через точку, например items.0.quantity.
+	Field string `json:"field"`
+
+	// Message Почему значение поля не прошло валидацию.
+	Message string `json:"message"`
+}
+
+// ForgotPasswordRequest defines model for ForgotPasswordRequest.
+type ForgotPasswordRequest struct {
+	// Username Имя пользователя, письмо отправляется на него.
+	Username string `json:"username"`
+}
+
+// HandOverOrderRequest defines model for HandOverOrderRequest.
+type HandOverOrderRequest struct {
+	// PickupCode Код выдачи, который показал пользователь.
+	PickupCode string `json:"pickupCode"`
 }
 
 // InfoResponse defines model for InfoResponse.
@@ -48,16 +623,477 @@ type InfoResponse struct {
 	} `json:"coinHistory,omitempty"`
 
 	// Coins Количество доступных монет.
-	Coins     *int `json:"coins,omitempty"`
+	Coins       *int `json:"coins,omitempty"`
+	GiftHistory *struct {
+		Received *[]struct {
+			// FromUser Имя пользователя, который подарил предмет.
+			FromUser *string `json:"fromUser,omitempty"`
+
+			// Item Тип подаренного предмета.
+			Item *string `json:"item,omitempty"`
+
+			// Message Сообщение к подарку.
+			Message *string `json:"message,omitempty"`
+		} `json:"received,omitempty"`
+		Sent *[]struct {
+			// Item Тип подаренного предмета.
+			Item *string `json:"item,omitempty"`
+
+			// Message Сообщение к подарку.
+			Message *string `json:"message,omitempty"`
+
+			// ToUser Имя пользователя, которому подарен предмет.
+			ToUser *string `json:"toUser,omitempty"`
+		} `json:"sent,omitempty"`
+	} `json:"giftHistory,omitempty"`
 	Inventory *[]struct {
 		// Quantity Количество предметов.
 		Quantity *int `json:"quantity,omitempty"`
 
 		// Type Тип предмета.
 		Type *string `json:"type,omitempty"`
+
+		// Variant Вариант предмета, отсутствует у предметов без вариантов.
+		Variant *string `json:"variant,omitempty"`
 	} `json:"inventory,omitempty"`
 }
 
+// InventoryItem defines model for InventoryItem.
+type InventoryItem struct {
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Quantity Количество предметов.
+	Quantity int64 `json:"quantity"`
+
+	// Variant Вариант предмета, отсутствует у предметов без вариантов.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// Listing defines model for Listing.
+type Listing struct {
+	// ClosedAt Время продажи последнего предмета или отмены объявления.
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+
+	// CreatedAt Время создания объявления.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Идентификатор объявления.
+	Id int64 `json:"id"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Price Цена за один предмет.
+	Price int64 `json:"price"`
+
+	// Quantity Сколько предметов еще продается.
+	Quantity int64 `json:"quantity"`
+
+	// Seller Имя продавца.
+	Seller string `json:"seller"`
+
+	// Status Статус объявления.
+	Status ListingStatus `json:"status"`
+
+	// Variant Вариант предмета.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// ListingStatus Статус объявления.
+type ListingStatus string
+
+// LoginOTPRequest defines model for LoginOTPRequest.
+type LoginOTPRequest struct {
+	// Challenge Challenge из ответа /api/auth.
+	Challenge string `json:"challenge"`
+
+	// Code Шестизначный код из приложения-аутентификатора или резервный код.
+	Code string `json:"code"`
+}
+
+// MerchCategory defines model for MerchCategory.
+type MerchCategory struct {
+	// Name Название категории.
+	Name string `json:"name"`
+
+	// Slug Идентификатор категории.
+	Slug string `json:"slug"`
+}
+
+// MerchItem defines model for MerchItem.
+type MerchItem struct {
+	// Category Категория предмета.
+	Category *string `json:"category,omitempty"`
+
+	// Description Описание предмета.
+	Description string `json:"description"`
+
+	// ImageUrl Ссылка на изображение предмета, отсутствует у предметов без изображения.
+	ImageUrl *string `json:"imageUrl,omitempty"`
+
+	// Name Название предмета.
+	Name string `json:"name"`
+
+	// Price Цена предмета.
+	Price int64 `json:"price"`
+
+	// Slug Идентификатор предмета, используется в /api/buy/{item}.
+	Slug string `json:"slug"`
+}
+
+// MerchPage defines model for MerchPage.
+type MerchPage struct {
+	Items []MerchItem `json:"items"`
+
+	// Limit Размер страницы.
+	Limit int32 `json:"limit"`
+
+	// Offset Сколько предметов пропущено.
+	Offset int32 `json:"offset"`
+
+	// Total Сколько всего предметов подходит под фильтры.
+	Total int64 `json:"total"`
+}
+
+// MerchSort Сортировка каталога - по названию, по возрастанию или убыванию цены.
+type MerchSort string
+
+// Notification defines model for Notification.
+type Notification struct {
+	// CreatedAt Время создания уведомления.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Идентификатор уведомления.
+	Id int64 `json:"id"`
+
+	// Item Тип предмета, к которому относится уведомление.
+	Item *string `json:"item,omitempty"`
+
+	// Kind Тип уведомления.
+	Kind NotificationKind `json:"kind"`
+
+	// Message Текст уведомления.
+	Message string `json:"message"`
+
+	// ReadAt Время прочтения, отсутствует у непрочитанных.
+	ReadAt *time.Time `json:"readAt,omitempty"`
+}
+
+// NotificationKind Тип уведомления.
+type NotificationKind string
+
+// NotificationPreferences defines model for NotificationPreferences.
+type NotificationPreferences struct {
+	// Email Письма о получении монет и о готовности заказа отправляются на имя пользователя, если оно является email. Если не передано при изменении настроек, остается прежним.
+	Email *EmailPreferences `json:"email,omitempty"`
+
+	// Muted Отключенные типы уведомлений.
+	Muted []NotificationKind `json:"muted"`
+}
+
+// Order defines model for Order.
+type Order struct {
+	// CreatedAt Время оформления заказа.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Идентификатор заказа.
+	Id int64 `json:"id"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Payer Имя пользователя, который оплатил заказ. Отличается от user для подарков.
+	Payer string `json:"payer"`
+
+	// PickupCode Код, который пользователь показывает при получении заказа.
+	PickupCode *string `json:"pickupCode,omitempty"`
+
+	// Price Оплаченная цена - столько монет вернется при отмене.
+	Price int64 `json:"price"`
+
+	// Status Статус заказа - оформлен, готов к выдаче, выдан или отменен.
+	Status OrderStatus `json:"status"`
+
+	// UpdatedAt Время последнего изменения статуса.
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// UpdatedBy Пользователь, последним изменивший статус.
+	UpdatedBy *string `json:"updatedBy,omitempty"`
+
+	// User Имя пользователя, который забирает предмет.
+	User string `json:"user"`
+
+	// Variant Вариант предмета.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// OrderStatus Статус заказа - оформлен, готов к выдаче, выдан или отменен.
+type OrderStatus string
+
+// PlaceBidRequest defines model for PlaceBidRequest.
+type PlaceBidRequest struct {
+	// Amount Сумма ставки.
+	Amount int64 `json:"amount"`
+}
+
+// ProblemDetails Ошибка в формате RFC 7807. Возвращается, если клиент указал application/problem+json
+// в заголовке Accept, иначе возвращается ErrorResponse.
+type ProblemDetails struct {
+	// Code Стабильный машиночитаемый код ошибки. Значения не меняются, новые коды могут добавляться.
+	// * `INTERNAL` - внутренняя ошибка сервера
+	// * `BAD_REQUEST` - неверный запрос без более точного кода
+	// * `UNAUTHORIZED` - отсутствует или неверный токен
+	// * `FORBIDDEN` - недостаточно прав
+	// * `NOT_FOUND` - ресурс не найден
+	// * `CONFLICT` - конфликт состояния без более точного кода
+	// * `VALIDATION_FAILED` - параметры запроса не прошли валидацию
+	// * `WRONG_PASSWORD` - неверный пароль
+	// * `USER_ALREADY_EXISTS` - пользователь уже существует
+	// * `INVALID_USER` - пользователь не существует
+	// * `USER_DELETED` - пользователь был удален
+	// * `NOT_ENOUGH_COINS` - у пользователя недостаточно монет
+	// * `NON_POSITIVE_AMOUNT` - сумма перевода должна быть положительной
+	// * `INVALID_SENDER` - отправитель не существует
+	// * `INVALID_RECIPIENT` - получатель не существует
+	// * `SELF_TRANSFER` - перевод самому себе
+	// * `TRANSFER_NOT_FOUND` - перевод не найден
+	// * `UNKNOWN_ITEM` - неизвестный товар
+	// * `UNKNOWN_VARIANT` - неизвестный вариант товара
+	// * `OUT_OF_STOCK` - вариант товара закончился
+	// * `NOT_ENOUGH_MERCH` - у пользователя недостаточно товаров
+	// * `GIFT_TO_SELF` - подарок самому себе
+	// * `NEGATIVE_PRICE` - цена должна быть неотрицательной
+	// * `NO_REFUNDABLE_PURCHASE` - нет покупки, доступной для возврата
+	// * `REFUND_ALREADY_REQUESTED` - возврат этой покупки уже запрошен
+	// * `REFUND_NOT_FOUND` - возврат не найден
+	// * `REFUND_ALREADY_RESOLVED` - возврат уже рассмотрен
+	// * `REFUND_ORDER_CANCELLED` - заказ покупки уже отменен, монеты возвращены при отмене
+	// * `INVALID_PROMOTION` - неверные параметры акции
+	// * `PROMO_CODE_ALREADY_EXISTS` - промокод уже существует
+	// * `INVALID_PROMO_CODE` - неверный промокод
+	// * `PROMO_CODE_NOT_APPLICABLE` - промокод не применим к этому товару сейчас
+	// * `PROMOTION_EXHAUSTED` - исчерпан лимит использований акции
+	// * `INVALID_BUNDLE` - неверный набор
+	// * `BUNDLE_ALREADY_EXISTS` - набор уже существует
+	// * `ORDER_NOT_FOUND` - заказ не найден
+	// * `ORDER_STATUS_TRANSITION` - заказ нельзя перевести в этот статус
+	// * `WRONG_PICKUP_CODE` - неверный код выдачи
+	// * `INVALID_LISTING` - неверные параметры объявления
+	// * `LISTING_NOT_FOUND` - объявление не найдено
+	// * `LISTING_CLOSED` - объявление уже продано или отменено
+	// * `NOT_ENOUGH_LISTED` - в объявлении недостаточно единиц
+	// * `BUY_OWN_LISTING` - покупка своего объявления
+	// * `ALREADY_IN_WISHLIST` - товар уже в списке желаний
+	// * `NOT_IN_WISHLIST` - товара нет в списке желаний
+	// * `INVALID_AUCTION` - неверные параметры аукциона
+	// * `AUCTION_NOT_FOUND` - аукцион не найден
+	// * `AUCTION_NOT_STARTED` - аукцион еще не начался
+	// * `AUCTION_CLOSED` - аукцион завершен
+	// * `BID_TOO_LOW` - ставка слишком мала
+	// * `INVALID_RAFFLE` - неверные параметры розыгрыша
+	// * `RAFFLE_NOT_FOUND` - розыгрыш не найден
+	// * `RAFFLE_SALES_OVER` - продажа билетов завершена
+	// * `RAFFLE_SALES_NOT_OVER` - продажа билетов еще не завершена
+	// * `RAFFLE_TICKETS_LIMIT` - превышен лимит билетов на пользователя
+	// * `RAFFLE_DRAWN` - розыгрыш уже проведен
+	// * `QUERY_TOO_COMPLEX` - GraphQL-запрос превышает лимит глубины или сложности
+	// * `WEBHOOK_NOT_FOUND` - подписка на вебхуки не найдена
+	// * `WEBHOOK_DELIVERY_NOT_FOUND` - доставка вебхука не найдена
+	// * `NOTIFICATION_NOT_FOUND` - уведомление не найдено
+	// * `TOO_MANY_REQUESTS` - слишком много запросов
+	// * `INVALID_TOKEN` - токен недействителен, истек или уже использован
+	// * `EMAIL_NOT_VERIFIED` - email пользователя не подтвержден
+	// * `INVALID_OTP` - неверный или уже использованный код двухфакторной аутентификации
+	// * `TWO_FACTOR_REQUIRED` - требуется двухфакторная аутентификация
+	// * `TWO_FACTOR_ALREADY_ENABLED` - двухфакторная аутентификация уже включена
+	// * `TWO_FACTOR_NOT_ENABLED` - двухфакторная аутентификация не включена
+	Code ErrorCode `json:"code"`
+
+	// Detail Сообщение об ошибке, описывающее проблему.
+	Detail *string `json:"detail,omitempty"`
+
+	// Fields Ошибки валидации по полям запроса, только для кода VALIDATION_FAILED.
+	Fields *[]FieldError `json:"fields,omitempty"`
+
+	// Instance Идентификатор запроса из заголовка X-Request-ID.
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP статус ответа.
+	Status int `json:"status"`
+
+	// Title Краткое описание HTTP статуса.
+	Title string `json:"title"`
+
+	// Type URI типа ошибки, однозначно соответствует коду ошибки.
+	Type string `json:"type"`
+}
+
+// Promotion defines model for Promotion.
+type Promotion struct {
+	// AmountOff Скидка в монетах.
+	AmountOff int64 `json:"amountOff"`
+
+	// Code Промокод. Отсутствует у распродаж, которые применяются автоматически.
+	Code *string `json:"code,omitempty"`
+
+	// EndsAt Время окончания акции.
+	EndsAt time.Time `json:"endsAt"`
+
+	// Id Идентификатор акции.
+	Id int64 `json:"id"`
+
+	// Item Тип предмета, на который действует акция. Если не указан - акция действует на все предметы.
+	Item *string `json:"item,omitempty"`
+
+	// MaxRedemptions Сколько раз акция может быть применена всего. Если не указано - без ограничений.
+	MaxRedemptions *int64 `json:"maxRedemptions,omitempty"`
+
+	// MaxRedemptionsPerUser Сколько раз акция может быть применена одним пользователем. Если не указано - без ограничений.
+	MaxRedemptionsPerUser *int64 `json:"maxRedemptionsPerUser,omitempty"`
+
+	// PercentOff Скидка в процентах от цены.
+	PercentOff int32 `json:"percentOff"`
+
+	// Stackable Можно ли совмещать акцию с другими. Из несовмещаемых акций применяется одна с наибольшей скидкой, совмещаемые применяются после нее к оставшейся цене.
+	Stackable bool `json:"stackable"`
+
+	// StartsAt Время начала акции.
+	StartsAt time.Time `json:"startsAt"`
+}
+
+// Raffle defines model for Raffle.
+type Raffle struct {
+	// CreatedAt Время создания розыгрыша.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DrawnAt Время проведения розыгрыша.
+	DrawnAt *time.Time `json:"drawnAt,omitempty"`
+
+	// DrawnBy Кто провел розыгрыш.
+	DrawnBy *string `json:"drawnBy,omitempty"`
+
+	// Id Идентификатор розыгрыша.
+	Id int64 `json:"id"`
+
+	// Item Разыгрываемый предмет.
+	Item string `json:"item"`
+
+	// MaxTicketsPerUser Сколько билетов может купить один участник.
+	MaxTicketsPerUser *int64 `json:"maxTicketsPerUser,omitempty"`
+
+	// SalesEndAt Время окончания продажи билетов.
+	SalesEndAt time.Time `json:"salesEndAt"`
+
+	// Seed Зерно розыгрыша, раскрывается после розыгрыша. Победители выбираются по очереди из билетов в порядке продажи: на шаге k берутся первые 8 байт SHA-256 от строки "<seed>:<k>" как беззнаковое big-endian число, остаток от деления на число оставшихся билетов - индекс билета-победителя, после чего все билеты его владельца исключаются.
+	Seed *string `json:"seed,omitempty"`
+
+	// SeedHash SHA-256 от зерна в hex, публикуется при создании розыгрыша.
+	SeedHash string `json:"seedHash"`
+
+	// Status Статус розыгрыша.
+	Status RaffleStatus `json:"status"`
+
+	// TicketPrice Цена одного билета.
+	TicketPrice int64 `json:"ticketPrice"`
+
+	// TicketsSold Сколько билетов продано. Возвращается только при запросе одного розыгрыша.
+	TicketsSold *int64 `json:"ticketsSold,omitempty"`
+
+	// Variant Вариант разыгрываемого предмета.
+	Variant *string `json:"variant,omitempty"`
+
+	// Winners Выигравшие билеты. Возвращаются только при запросе одного розыгрыша.
+	Winners *[]RaffleTicket `json:"winners,omitempty"`
+
+	// WinnersCount Сколько участников получат приз.
+	WinnersCount int64 `json:"winnersCount"`
+}
+
+// RaffleStatus Статус розыгрыша.
+type RaffleStatus string
+
+// RaffleTicket defines model for RaffleTicket.
+type RaffleTicket struct {
+	// CreatedAt Время покупки билета.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Номер билета.
+	Id int64 `json:"id"`
+
+	// Price Цена, уплаченная за билет.
+	Price int64 `json:"price"`
+
+	// User Владелец билета.
+	User string `json:"user"`
+
+	// Won Выиграл ли билет.
+	Won bool `json:"won"`
+}
+
+// Refund defines model for Refund.
+type Refund struct {
+	// Id Идентификатор запроса на возврат.
+	Id int64 `json:"id"`
+
+	// Item Тип возвращаемого предмета.
+	Item string `json:"item"`
+
+	// Price Цена покупки - столько монет будет возвращено.
+	Price int64 `json:"price"`
+
+	// RequestedAt Время создания запроса на возврат.
+	RequestedAt time.Time `json:"requestedAt"`
+
+	// ResolvedAt Время обработки запроса администратором.
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+
+	// ResolvedBy Администратор, обработавший запрос.
+	ResolvedBy *string `json:"resolvedBy,omitempty"`
+
+	// Status Статус запроса на возврат.
+	Status RefundStatus `json:"status"`
+
+	// User Имя пользователя, запросившего возврат.
+	User string `json:"user"`
+
+	// Variant Вариант возвращаемого предмета.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// RefundStatus Статус запроса на возврат.
+type RefundStatus string
+
+// ResetPasswordRequest defines model for ResetPasswordRequest.
+type ResetPasswordRequest struct {
+	// Password Новый пароль.
+	Password string `json:"password"`
+
+	// Token Токен из письма.
+	Token string `json:"token"`
+}
+
+// RestockRequest defines model for RestockRequest.
+type RestockRequest struct {
+	// Quantity Сколько предметов добавить в запас.
+	Quantity int64 `json:"quantity"`
+}
+
+// RestockResponse defines model for RestockResponse.
+type RestockResponse struct {
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Notified Сколько пользователей получили уведомление.
+	Notified int64 `json:"notified"`
+
+	// Stock Запас после пополнения.
+	Stock int64 `json:"stock"`
+
+	// Variant Вариант предмета.
+	Variant string `json:"variant"`
+}
+
 // SendCoinRequest defines model for SendCoinRequest.
 type SendCoinRequest struct {
 	// Amount Количество монет, которые необходимо отправить.
@@ -67,8 +1103,385 @@ type SendCoinRequest struct {
 	ToUser string `json:"toUser"`
 }
 
+// Transfer defines model for Transfer.
+type Transfer struct {
+	// Amount Количество монет.
+	Amount int64 `json:"amount"`
+
+	// CreatedAt Время перевода. У переводов, сделанных до появления v2, - время обновления сервиса.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FromUser Имя пользователя, который отправил монеты.
+	FromUser string `json:"fromUser"`
+
+	// Id Идентификатор перевода.
+	Id int64 `json:"id"`
+
+	// ToUser Имя пользователя, который получил монеты.
+	ToUser string `json:"toUser"`
+}
+
+// TransferPage defines model for TransferPage.
+type TransferPage struct {
+	Items []Transfer `json:"items"`
+
+	// Limit Размер страницы.
+	Limit int32 `json:"limit"`
+
+	// Offset Сколько переводов пропущено.
+	Offset int32 `json:"offset"`
+
+	// Total Сколько всего переводов у пользователя.
+	Total int64 `json:"total"`
+}
+
+// TwoFactorCodeRequest defines model for TwoFactorCodeRequest.
+type TwoFactorCodeRequest struct {
+	// Code Шестизначный код из приложения-аутентификатора, для отключения подходит и резервный код.
+	Code string `json:"code"`
+}
+
+// TwoFactorEnrollment defines model for TwoFactorEnrollment.
+type TwoFactorEnrollment struct {
+	// Secret Секрет в base32 для ручного ввода в приложение.
+	Secret string `json:"secret"`
+
+	// Uri otpauth:// URI для QR-кода.
+	Uri string `json:"uri"`
+}
+
+// TwoFactorRecoveryCodes defines model for TwoFactorRecoveryCodes.
+type TwoFactorRecoveryCodes struct {
+	// RecoveryCodes Одноразовые резервные коды на случай потери приложения.
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// TwoFactorStatus defines model for TwoFactorStatus.
+type TwoFactorStatus struct {
+	// Enabled Вход требует код.
+	Enabled bool `json:"enabled"`
+
+	// RecoveryCodesLeft Количество неиспользованных резервных кодов.
+	RecoveryCodesLeft int64 `json:"recoveryCodesLeft"`
+
+	// Required Двухфакторная аутентификация обязательна для пользователя - он администратор.
+	Required bool `json:"required"`
+}
+
+// User defines model for User.
+type User struct {
+	// Coins Количество доступных монет.
+	Coins     int64           `json:"coins"`
+	Inventory []InventoryItem `json:"inventory"`
+
+	// Name Имя пользователя.
+	Name string `json:"name"`
+}
+
+// VerifyEmailRequest defines model for VerifyEmailRequest.
+type VerifyEmailRequest struct {
+	// Token Токен из письма.
+	Token string `json:"token"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts Сколько попыток сделано.
+	Attempts int32 `json:"attempts"`
+
+	// CreatedAt Время создания доставки.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeliveredAt Время успешной доставки.
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+
+	// EventId Идентификатор события.
+	EventId int64 `json:"eventId"`
+
+	// EventType Тип события.
+	EventType WebhookEventType `json:"eventType"`
+
+	// Id Идентификатор доставки.
+	Id int64 `json:"id"`
+
+	// LastError Ошибка последней попытки.
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastStatusCode HTTP-статус последнего ответа получателя.
+	LastStatusCode *int32 `json:"lastStatusCode,omitempty"`
+
+	// NextAttemptAt Время следующей попытки для статуса pending.
+	NextAttemptAt time.Time `json:"nextAttemptAt"`
+
+	// Status Статус доставки - ожидает отправки, доставлено или попытки исчерпаны.
+	Status WebhookDeliveryStatus `json:"status"`
+
+	// SubscriptionId Идентификатор подписки.
+	SubscriptionId int64 `json:"subscriptionId"`
+}
+
+// WebhookDeliveryStatus Статус доставки - ожидает отправки, доставлено или попытки исчерпаны.
+type WebhookDeliveryStatus string
+
+// WebhookEventType Тип события.
+type WebhookEventType string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	// CreatedAt Время создания подписки.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Администратор, создавший подписку.
+	CreatedBy string             `json:"createdBy"`
+	Events    []WebhookEventType `json:"events"`
+
+	// Id Идентификатор подписки.
+	Id int64 `json:"id"`
+
+	// Secret Секрет для проверки подписи, возвращается только при создании подписки.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Адрес, на который доставляются события.
+	Url string `json:"url"`
+}
+
+// Wishlist defines model for Wishlist.
+type Wishlist struct {
+	// Coins Текущий баланс пользователя.
+	Coins int64          `json:"coins"`
+	Items []WishlistItem `json:"items"`
+}
+
+// WishlistItem defines model for WishlistItem.
+type WishlistItem struct {
+	// CreatedAt Время добавления в список.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// InStock Есть ли предмет в наличии.
+	InStock bool `json:"inStock"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// MissingCoins Сколько монет не хватает для покупки, 0 если хватает.
+	MissingCoins int64 `json:"missingCoins"`
+
+	// Price Текущая цена предмета с учетом распродаж.
+	Price int64 `json:"price"`
+
+	// Variant Вариант предмета. Если не указан - подходит любой вариант.
+	Variant *string `json:"variant,omitempty"`
+}
+
+// MerchCategoryFilter defines model for MerchCategoryFilter.
+type MerchCategoryFilter = string
+
+// MerchMaxPriceFilter defines model for MerchMaxPriceFilter.
+type MerchMaxPriceFilter = int64
+
+// MerchMinPriceFilter defines model for MerchMinPriceFilter.
+type MerchMinPriceFilter = int64
+
+// MerchSearchQuery defines model for MerchSearchQuery.
+type MerchSearchQuery = string
+
+// MerchSortOrder Сортировка каталога - по названию, по возрастанию или убыванию цены.
+type MerchSortOrder = MerchSort
+
+// PageLimit defines model for PageLimit.
+type PageLimit = int32
+
+// PageOffset defines model for PageOffset.
+type PageOffset = int32
+
+// GetApiAdminOrdersParams defines parameters for GetApiAdminOrders.
+type GetApiAdminOrdersParams struct {
+	// Status Статус заказов, по умолчанию placed.
+	Status *OrderStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetApiAdminRefundsParams defines parameters for GetApiAdminRefunds.
+type GetApiAdminRefundsParams struct {
+	// Status Статус запросов на возврат, по умолчанию requested.
+	Status *RefundStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetApiAdminWebhooksIdDeliveriesParams defines parameters for GetApiAdminWebhooksIdDeliveries.
+type GetApiAdminWebhooksIdDeliveriesParams struct {
+	// Status Статус доставок, по умолчанию все.
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 20.
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько элементов пропустить.
+	Offset *PageOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetApiBuyItemParams defines parameters for GetApiBuyItem.
+type GetApiBuyItemParams struct {
+	// Variant Вариант предмета (например, размер). Если не указан - покупается предмет без варианта.
+	Variant *string `form:"variant,omitempty" json:"variant,omitempty"`
+
+	// PromoCode Промокод на скидку.
+	PromoCode *string `form:"promoCode,omitempty" json:"promoCode,omitempty"`
+}
+
+// GetApiMarketListingsParams defines parameters for GetApiMarketListings.
+type GetApiMarketListingsParams struct {
+	// Item Тип предмета. Если не указан - возвращаются объявления по всем предметам.
+	Item *string `form:"item,omitempty" json:"item,omitempty"`
+}
+
+// DeleteApiMeWishlistItemParams defines parameters for DeleteApiMeWishlistItem.
+type DeleteApiMeWishlistItemParams struct {
+	// Variant Вариант предмета.
+	Variant *string `form:"variant,omitempty" json:"variant,omitempty"`
+}
+
+// GetApiMerchParams defines parameters for GetApiMerch.
+type GetApiMerchParams struct {
+	// Category Категория предметов.
+	Category *MerchCategoryFilter `form:"category,omitempty" json:"category,omitempty"`
+
+	// MinPrice Минимальная цена.
+	MinPrice *MerchMinPriceFilter `form:"minPrice,omitempty" json:"minPrice,omitempty"`
+
+	// MaxPrice Максимальная цена.
+	MaxPrice *MerchMaxPriceFilter `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
+
+	// Q Текст для поиска по идентификатору, названию и описанию предмета.
+	Q *MerchSearchQuery `form:"q,omitempty" json:"q,omitempty"`
+
+	// Sort Сортировка - по названию, по возрастанию или убыванию цены. По умолчанию по идентификатору.
+	Sort *MerchSortOrder `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 20.
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько элементов пропустить.
+	Offset *PageOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetApiNotificationsParams defines parameters for GetApiNotifications.
+type GetApiNotificationsParams struct {
+	// Unread Только непрочитанные уведомления.
+	Unread *bool `form:"unread,omitempty" json:"unread,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 20.
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько элементов пропустить.
+	Offset *PageOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PostApiRefundItemParams defines parameters for PostApiRefundItem.
+type PostApiRefundItemParams struct {
+	// Variant Вариант возвращаемого предмета.
+	Variant *string `form:"variant,omitempty" json:"variant,omitempty"`
+}
+
+// GetApiV2MerchParams defines parameters for GetApiV2Merch.
+type GetApiV2MerchParams struct {
+	// Category Категория предметов.
+	Category *MerchCategoryFilter `form:"category,omitempty" json:"category,omitempty"`
+
+	// MinPrice Минимальная цена.
+	MinPrice *MerchMinPriceFilter `form:"minPrice,omitempty" json:"minPrice,omitempty"`
+
+	// MaxPrice Максимальная цена.
+	MaxPrice *MerchMaxPriceFilter `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
+
+	// Q Текст для поиска по идентификатору, названию и описанию предмета.
+	Q *MerchSearchQuery `form:"q,omitempty" json:"q,omitempty"`
+
+	// Sort Сортировка - по названию, по возрастанию или убыванию цены. По умолчанию по идентификатору.
+	Sort *MerchSortOrder `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 20.
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько элементов пропустить.
+	Offset *PageOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetApiV2UsersMeTransactionsParams defines parameters for GetApiV2UsersMeTransactions.
+type GetApiV2UsersMeTransactionsParams struct {
+	// Limit Размер страницы, по умолчанию 20.
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько элементов пропустить.
+	Offset *PageOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PostApiAdminAuctionsJSONRequestBody defines body for PostApiAdminAuctions for application/json ContentType.
+type PostApiAdminAuctionsJSONRequestBody = CreateAuctionRequest
+
+// PostApiAdminBundlesJSONRequestBody defines body for PostApiAdminBundles for application/json ContentType.
+type PostApiAdminBundlesJSONRequestBody = Bundle
+
+// PostApiAdminMerchItemVariantsVariantRestockJSONRequestBody defines body for PostApiAdminMerchItemVariantsVariantRestock for application/json ContentType.
+type PostApiAdminMerchItemVariantsVariantRestockJSONRequestBody = RestockRequest
+
+// PostApiAdminOrdersIdHandoverJSONRequestBody defines body for PostApiAdminOrdersIdHandover for application/json ContentType.
+type PostApiAdminOrdersIdHandoverJSONRequestBody = HandOverOrderRequest
+
+// PostApiAdminPromotionsJSONRequestBody defines body for PostApiAdminPromotions for application/json ContentType.
+type PostApiAdminPromotionsJSONRequestBody = CreatePromotionRequest
+
+// PostApiAdminRafflesJSONRequestBody defines body for PostApiAdminRaffles for application/json ContentType.
+type PostApiAdminRafflesJSONRequestBody = CreateRaffleRequest
+
+// PostApiAdminWebhooksJSONRequestBody defines body for PostApiAdminWebhooks for application/json ContentType.
+type PostApiAdminWebhooksJSONRequestBody = CreateWebhookSubscriptionRequest
+
+// PostApiAuctionsIdBidsJSONRequestBody defines body for PostApiAuctionsIdBids for application/json ContentType.
+type PostApiAuctionsIdBidsJSONRequestBody = PlaceBidRequest
+
 // PostApiAuthJSONRequestBody defines body for PostApiAuth for application/json ContentType.
 type PostApiAuthJSONRequestBody = AuthRequest
 
+// PostApiAuth2faConfirmJSONRequestBody defines body for PostApiAuth2faConfirm for application/json ContentType.
+type PostApiAuth2faConfirmJSONRequestBody = TwoFactorCodeRequest
+
+// PostApiAuth2faDisableJSONRequestBody defines body for PostApiAuth2faDisable for application/json ContentType.
+type PostApiAuth2faDisableJSONRequestBody = TwoFactorCodeRequest
+
+// PostApiAuthForgotJSONRequestBody defines body for PostApiAuthForgot for application/json ContentType.
+type PostApiAuthForgotJSONRequestBody = ForgotPasswordRequest
+
+// PostApiAuthOtpJSONRequestBody defines body for PostApiAuthOtp for application/json ContentType.
+type PostApiAuthOtpJSONRequestBody = LoginOTPRequest
+
+// PostApiAuthResetJSONRequestBody defines body for PostApiAuthReset for application/json ContentType.
+type PostApiAuthResetJSONRequestBody = ResetPasswordRequest
+
+// PostApiAuthVerifyJSONRequestBody defines body for PostApiAuthVerify for application/json ContentType.
+type PostApiAuthVerifyJSONRequestBody = VerifyEmailRequest
+
+// PostApiBuyItemJSONRequestBody defines body for PostApiBuyItem for application/json ContentType.
+type PostApiBuyItemJSONRequestBody = BuyMerchRequest
+
+// PostApiMarketListingsJSONRequestBody defines body for PostApiMarketListings for application/json ContentType.
+type PostApiMarketListingsJSONRequestBody = CreateListingRequest
+
+// PostApiMarketListingsIdBuyJSONRequestBody defines body for PostApiMarketListingsIdBuy for application/json ContentType.
+type PostApiMarketListingsIdBuyJSONRequestBody = BuyListingRequest
+
+// PostApiMeWishlistJSONRequestBody defines body for PostApiMeWishlist for application/json ContentType.
+type PostApiMeWishlistJSONRequestBody = AddWishlistItemRequest
+
+// PutApiNotificationsPreferencesJSONRequestBody defines body for PutApiNotificationsPreferences for application/json ContentType.
+type PutApiNotificationsPreferencesJSONRequestBody = NotificationPreferences
+
+// PostApiRafflesIdTicketsJSONRequestBody defines body for PostApiRafflesIdTickets for application/json ContentType.
+type PostApiRafflesIdTicketsJSONRequestBody = BuyRaffleTicketsRequest
+
 // PostApiSendCoinJSONRequestBody defines body for PostApiSendCoin for application/json ContentType.
 type PostApiSendCoinJSONRequestBody = SendCoinRequest
+
+// PostApiV2OrdersJSONRequestBody defines body for PostApiV2Orders for application/json ContentType.
+type PostApiV2OrdersJSONRequestBody = CreateOrderRequest
+
+// PostApiV2TransfersJSONRequestBody defines body for PostApiV2Transfers for application/json ContentType.
+type PostApiV2TransfersJSONRequestBody = SendCoinRequest
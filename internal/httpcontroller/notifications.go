@@ -0,0 +1,223 @@
+package httpcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+func newNotification(notification models.Notification) Notification {
+	return Notification{
+		Id:        notification.ID,
+		Kind:      NotificationKind(notification.Kind),
+		Item:      textPtr(notification.MerchItem),
+		Message:   notification.Message,
+		CreatedAt: notification.CreatedAt.Time,
+		ReadAt:    timePtr(notification.ReadAt),
+	}
+}
+
+// notify adds the notification in the transaction of txRepo, so it exists only if the action is committed.
+// email is the data of the mailer templates of the kind, the email is queued with the notification if not nil
+func (r Handlers) notify(ctx context.Context, txRepo *dal.Dal, userName string, kind string, merchItem *string, message string, email any) error {
+	added, err := txRepo.AddNotification(ctx, models.AddNotificationParams{
+		UserName:  userName,
+		Kind:      kind,
+		MerchItem: pgText(merchItem),
+		Message:   message,
+	})
+	if err != nil || !added || email == nil {
+		return err
+	}
+	return r.queueEmail(ctx, txRepo, userName, kind, email, true)
+}
+
+func (r Handlers) GetApiNotifications(ctx context.Context, request GetApiNotificationsRequestObject) (GetApiNotificationsResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "ListNotifications"))
+
+	params := request.Params
+	if errValidate := params.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	listArgs := models.ListUserNotificationsParams{
+		UserName:   userName,
+		UnreadOnly: params.Unread != nil && *params.Unread,
+		PageLimit:  defaultMerchPageLimit,
+		PageOffset: 0,
+	}
+	if params.Limit != nil {
+		listArgs.PageLimit = *params.Limit
+	}
+	if params.Offset != nil {
+		listArgs.PageOffset = *params.Offset
+	}
+
+	rows, err := r.Dal.ListUserNotifications(ctx, listArgs)
+	if err != nil {
+		return nil, fmt.Errorf("fail ListUserNotifications: %w", err)
+	}
+	total, unread, err := r.Dal.CountUserNotifications(ctx, userName, listArgs.UnreadOnly)
+	if err != nil {
+		return nil, fmt.Errorf("fail CountUserNotifications: %w", err)
+	}
+
+	notifications := make([]Notification, 0, len(rows))
+	for _, row := range rows {
+		notifications = append(notifications, newNotification(row))
+	}
+	return GetApiNotifications200JSONResponse{
+		Body: notifications,
+		Headers: GetApiNotifications200ResponseHeaders{
+			XTotalCount:  total,
+			XUnreadCount: unread,
+		},
+	}, nil
+}
+
+func (r Handlers) PostApiNotificationsIdRead(ctx context.Context, request PostApiNotificationsIdReadRequestObject) (PostApiNotificationsIdReadResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "ReadNotification"))
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	// notifications of other users are not found, not forbidden
+	notification, err := r.Dal.MarkNotificationRead(ctx, request.Id, userName)
+	if err != nil {
+		if errors.Is(err, dal.ErrNotificationNotFound) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail MarkNotificationRead: %w", err)
+	}
+	return PostApiNotificationsIdRead200JSONResponse(newNotification(*notification)), nil
+}
+
+func (r Handlers) PostApiNotificationsReadAll(ctx context.Context, request PostApiNotificationsReadAllRequestObject) (PostApiNotificationsReadAllResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "ReadAllNotifications"))
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	if _, err = r.Dal.MarkAllNotificationsRead(ctx, userName); err != nil {
+		return nil, fmt.Errorf("fail MarkAllNotificationsRead: %w", err)
+	}
+	return PostApiNotificationsReadAll204Response{}, nil
+}
+
+func (r Handlers) GetApiNotificationsPreferences(ctx context.Context, request GetApiNotificationsPreferencesRequestObject) (GetApiNotificationsPreferencesResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "GetNotificationPreferences"))
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	muted, err := r.Dal.ListNotificationMutes(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("fail ListNotificationMutes: %w", err)
+	}
+	email, err := r.Dal.GetEmailSettings(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("fail GetEmailSettings: %w", err)
+	}
+	return GetApiNotificationsPreferences200JSONResponse(newNotificationPreferences(muted, *email)), nil
+}
+
+func (r Handlers) PutApiNotificationsPreferences(ctx context.Context, request PutApiNotificationsPreferencesRequestObject) (PutApiNotificationsPreferencesResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "SetNotificationPreferences"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	muted := make([]string, 0, len(req.Muted))
+	for _, kind := range req.Muted {
+		muted = append(muted, string(kind))
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	if err = txRepo.SetNotificationMutes(ctx, userName, muted); err != nil {
+		return nil, fmt.Errorf("fail txRepo.SetNotificationMutes: %w", err)
+	}
+	saved, err := txRepo.ListNotificationMutes(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("fail txRepo.ListNotificationMutes: %w", err)
+	}
+
+	// email settings are kept if not sent
+	var email *models.EmailSetting
+	if req.Email != nil {
+		email, err = txRepo.SetEmailSettings(ctx, models.UpsertEmailSettingsParams{
+			UserName: userName,
+			Enabled:  req.Email.Enabled,
+			Language: string(req.Email.Language),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail txRepo.SetEmailSettings: %w", err)
+		}
+	} else {
+		email, err = txRepo.GetEmailSettings(ctx, userName)
+		if err != nil {
+			return nil, fmt.Errorf("fail txRepo.GetEmailSettings: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+	return PutApiNotificationsPreferences200JSONResponse(newNotificationPreferences(saved, *email)), nil
+}
+
+func newNotificationPreferences(muted []string, email models.EmailSetting) NotificationPreferences {
+	res := NotificationPreferences{
+		Muted: make([]NotificationKind, 0, len(muted)),
+		Email: &EmailPreferences{
+			Enabled:  email.Enabled,
+			Language: EmailPreferencesLanguage(email.Language),
+		},
+	}
+	for _, kind := range muted {
+		res.Muted = append(res.Muted, NotificationKind(kind))
+	}
+	return res
+}
@@ -0,0 +1,238 @@
+package httpcontroller
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp/fasthttpadaptor"
+)
+
+// OpenAPI checks requests, and responses if ValidateResponses is set, against the embedded api/v1.yaml
+type OpenAPI struct {
+	Logger *slog.Logger
+
+	// ValidateResponses turns on response checks, they cost a body decode per request and are meant for tests
+	ValidateResponses bool
+
+	router routers.Router
+}
+
+func NewOpenAPI(logger *slog.Logger, validateResponses bool) (*OpenAPI, error) {
+	doc, err := GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("fail to load openapi spec: %w", err)
+	}
+	// servers of the spec are for docs only, operations must be found on any host
+	doc.Servers = nil
+
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("fail to build openapi router: %w", err)
+	}
+
+	return &OpenAPI{
+		Logger:            logger,
+		ValidateResponses: validateResponses,
+		router:            router,
+	}, nil
+}
+
+const openAPIRouteLocal = "openapi_route"
+
+type openAPIRoute struct {
+	route      *routers.Route
+	pathParams map[string]string
+	req        *http.Request
+}
+
+// findRoute looks the operation up once per request, the result is kept in fiber locals
+func (o *OpenAPI) findRoute(c *fiber.Ctx) (*openAPIRoute, error) {
+	if found, ok := c.Locals(openAPIRouteLocal).(*openAPIRoute); ok {
+		return found, nil
+	}
+
+	req := new(http.Request)
+	if err := fasthttpadaptor.ConvertRequest(c.Context(), req, true); err != nil {
+		return nil, err
+	}
+
+	route, pathParams, err := o.router.FindRoute(req)
+	if err != nil {
+		return nil, err
+	}
+
+	found := &openAPIRoute{
+		route:      route,
+		pathParams: pathParams,
+		req:        req,
+	}
+	c.Locals(openAPIRouteLocal, found)
+	return found, nil
+}
+
+// IsPublic is a jwt middleware filter, it skips only operations declared with empty security.
+// Routes missing in the spec need a token, so an unknown admin route is never reached anonymously
+func (o *OpenAPI) IsPublic(c *fiber.Ctx) bool {
+	found, err := o.findRoute(c)
+	if err != nil {
+		return false
+	}
+	security := found.route.Operation.Security
+	return security != nil && len(*security) == 0
+}
+
+// deprecatedSince is the date the v2 api replaced deprecated v1 operations
+var deprecatedSince = time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+// Deprecation marks responses of operations deprecated in the spec with Deprecation header (RFC 9745)
+// and links the replacing operation from x-successor
+func (o *OpenAPI) Deprecation(c *fiber.Ctx) error {
+	found, err := o.findRoute(c)
+	if err != nil || !found.route.Operation.Deprecated {
+		return c.Next()
+	}
+
+	c.Set("Deprecation", "@"+strconv.FormatInt(deprecatedSince.Unix(), 10))
+	if successor, ok := found.route.Operation.Extensions["x-successor"].(string); ok {
+		c.Set(fiber.HeaderLink, "<"+successor+">; rel=\"successor-version\"")
+	}
+	return c.Next()
+}
+
+func (o *OpenAPI) Validate(c *fiber.Ctx) error {
+	found, err := o.findRoute(c)
+	if err != nil {
+		return c.Next()
+	}
+
+	// generated handlers parse the body of every operation having one, so an omitted optional body becomes empty object
+	if body := found.route.Operation.RequestBody; body != nil && body.Value != nil && !body.Value.Required && len(c.Body()) == 0 {
+		c.Request().SetBodyString("{}")
+		c.Request().Header.SetContentType(fiber.MIMEApplicationJSON)
+		found.req.Body = io.NopCloser(strings.NewReader("{}"))
+		found.req.ContentLength = 2
+		found.req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+
+	input := &openapi3filter.RequestValidationInput{
+		Request:    found.req,
+		PathParams: found.pathParams,
+		Route:      found.route,
+		Options: &openapi3filter.Options{
+			MultiError:          true,
+			AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+			SkipSettingDefaults: true,
+		},
+	}
+	if err = openapi3filter.ValidateRequest(c.UserContext(), input); err != nil {
+		o.Logger.Info("user sent request not matching openapi spec",
+			slog.String("operation_id", found.route.Operation.OperationID),
+			slog.String("error", err.Error()),
+		)
+		return requestValidationError(err)
+	}
+
+	if !o.ValidateResponses {
+		return c.Next()
+	}
+
+	if err = c.Next(); err != nil {
+		return err
+	}
+
+	res := c.Response()
+	header := make(http.Header)
+	res.Header.VisitAll(func(key, value []byte) {
+		header.Add(string(key), string(value))
+	})
+
+	err = openapi3filter.ValidateResponse(c.UserContext(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 res.StatusCode(),
+		Header:                 header,
+		Body:                   io.NopCloser(bytes.NewReader(res.Body())),
+		Options: &openapi3filter.Options{
+			IncludeResponseStatus: true,
+			MultiError:            true,
+		},
+	})
+	if err != nil {
+		o.Logger.Error("response does not match openapi spec",
+			slog.String("operation_id", found.route.Operation.OperationID),
+			slog.Int("status", res.StatusCode()),
+			slog.String("error", err.Error()),
+		)
+		res.Reset()
+		return errInternalServer()
+	}
+	return nil
+}
+
+// requestValidationError converts openapi3filter errors to VALIDATION_FAILED with a field per invalid param or body property
+func requestValidationError(err error) *Error {
+	fields := requestFieldErrors("", err)
+	slices.SortStableFunc(fields, func(a, b FieldError) int {
+		return strings.Compare(a.Field, b.Field)
+	})
+
+	messages := make([]string, 0, len(fields))
+	for _, field := range fields {
+		messages = append(messages, field.Field+": "+field.Message)
+	}
+
+	return &Error{
+		Status:  400,
+		Code:    ErrorCodeVALIDATIONFAILED,
+		Message: "invalid request: " + strings.Join(messages, "; "),
+		Fields:  fields,
+	}
+}
+
+func requestFieldErrors(field string, err error) []FieldError {
+	var multiErr openapi3.MultiError
+	if errors.As(err, &multiErr) {
+		var res []FieldError
+		for _, e := range multiErr {
+			res = append(res, requestFieldErrors(field, e)...)
+		}
+		return res
+	}
+
+	var reqErr *openapi3filter.RequestError
+	if errors.As(err, &reqErr) {
+		switch {
+		case reqErr.Parameter != nil:
+			field = reqErr.Parameter.Name
+		case reqErr.RequestBody != nil:
+			field = "body"
+		}
+		if reqErr.Err == nil {
+			return []FieldError{{Field: field, Message: reqErr.Reason}}
+		}
+		return requestFieldErrors(field, reqErr.Err)
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		// body properties are named by their own path, params by the param name
+		if pointer := schemaErr.JSONPointer(); field == "body" && len(pointer) > 0 {
+			field = strings.Join(pointer, ".")
+		}
+		return []FieldError{{Field: field, Message: schemaErr.Reason}}
+	}
+
+	return []FieldError{{Field: field, Message: err.Error()}}
+}
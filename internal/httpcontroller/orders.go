@@ -0,0 +1,266 @@
+package httpcontroller
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"slices"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+	"github.com/maximmihin/aw25/internal/mailer"
+)
+
+const pickupCodeDigits = 6
+
+// orderTransitions lists statuses an order can be moved to from its current status
+var orderTransitions = map[string][]string{
+	dal.OrderStatusPlaced: {dal.OrderStatusReady, dal.OrderStatusCancelled},
+	dal.OrderStatusReady:  {dal.OrderStatusHandedOver, dal.OrderStatusCancelled},
+}
+
+var ErrOrderStatusTransition = errors.New("order can not be moved to this status")
+var ErrWrongPickupCode = errors.New("wrong pickup code")
+
+func newPickupCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", pickupCodeDigits, n.Int64()), nil
+}
+
+// placeOrder creates an order line for merch bought in tx, so it can be handed out at the desk
+func placeOrder(ctx context.Context, txRepo *dal.Dal, createArgs models.CreateOrderParams) (*models.MerchOrder, error) {
+	pickupCode, err := newPickupCode()
+	if err != nil {
+		return nil, fmt.Errorf("fail newPickupCode: %w", err)
+	}
+	createArgs.PickupCode = pickupCode
+
+	order, err := txRepo.CreateOrder(ctx, createArgs)
+	if err != nil {
+		return nil, fmt.Errorf("fail txRepo.CreateOrder: %w", err)
+	}
+	return order, nil
+}
+
+// newOrder converts db order to response model, pickup code is shown only to the user who picks the order up
+func newOrder(order models.MerchOrder, viewer string) Order {
+	res := Order{
+		Id:        order.ID,
+		User:      order.UserName,
+		Payer:     order.Payer,
+		Item:      order.MerchItem,
+		Variant:   nonEmptyPtr(order.Variant),
+		Price:     order.Price,
+		Status:    OrderStatus(order.Status),
+		CreatedAt: order.CreatedAt.Time,
+		UpdatedAt: order.UpdatedAt.Time,
+		UpdatedBy: textPtr(order.UpdatedBy),
+	}
+	if viewer == order.UserName {
+		res.PickupCode = &order.PickupCode
+	}
+	return res
+}
+
+func (r Handlers) GetApiOrders(ctx context.Context, request GetApiOrdersRequestObject) (GetApiOrdersResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "ListMyOrders"))
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	rows, err := r.Dal.ListUserOrders(ctx, userName)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]Order, 0, len(rows))
+	for _, row := range rows {
+		orders = append(orders, newOrder(row, userName))
+	}
+	return GetApiOrders200JSONResponse(orders), nil
+}
+
+func (r Handlers) GetApiAdminOrders(ctx context.Context, request GetApiAdminOrdersRequestObject) (GetApiAdminOrdersResponseObject, error) {
+	status := OrderStatusPlaced
+	if request.Params.Status != nil {
+		status = *request.Params.Status
+	}
+	switch status {
+	case OrderStatusPlaced, OrderStatusReady, OrderStatusHandedOver, OrderStatusCancelled:
+	default:
+		return nil, fiber.NewError(400, "invalid order status")
+	}
+
+	rows, err := r.Dal.ListOrders(ctx, string(status))
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]Order, 0, len(rows))
+	for _, row := range rows {
+		orders = append(orders, newOrder(row, ""))
+	}
+	return GetApiAdminOrders200JSONResponse(orders), nil
+}
+
+func (r Handlers) PostApiOrdersIdCancel(ctx context.Context, request PostApiOrdersIdCancelRequestObject) (PostApiOrdersIdCancelResponseObject, error) {
+	order, err := r.changeOrderStatus(ctx, request.Id, nil, dal.OrderStatusCancelled, true)
+	if err != nil {
+		return nil, err
+	}
+	return PostApiOrdersIdCancel200JSONResponse(order), nil
+}
+
+func (r Handlers) PostApiAdminOrdersIdReady(ctx context.Context, request PostApiAdminOrdersIdReadyRequestObject) (PostApiAdminOrdersIdReadyResponseObject, error) {
+	order, err := r.changeOrderStatus(ctx, request.Id, nil, dal.OrderStatusReady, false)
+	if err != nil {
+		return nil, err
+	}
+	return PostApiAdminOrdersIdReady200JSONResponse(order), nil
+}
+
+func (r Handlers) PostApiAdminOrdersIdHandover(ctx context.Context, request PostApiAdminOrdersIdHandoverRequestObject) (PostApiAdminOrdersIdHandoverResponseObject, error) {
+	order, err := r.changeOrderStatus(ctx, request.Id, request.Body, dal.OrderStatusHandedOver, false)
+	if err != nil {
+		return nil, err
+	}
+	return PostApiAdminOrdersIdHandover200JSONResponse(order), nil
+}
+
+func (r Handlers) PostApiAdminOrdersIdCancel(ctx context.Context, request PostApiAdminOrdersIdCancelRequestObject) (PostApiAdminOrdersIdCancelResponseObject, error) {
+	order, err := r.changeOrderStatus(ctx, request.Id, nil, dal.OrderStatusCancelled, false)
+	if err != nil {
+		return nil, err
+	}
+	return PostApiAdminOrdersIdCancel200JSONResponse(order), nil
+}
+
+// changeOrderStatus moves the order along its lifecycle, users may only cancel
+// their own orders before they are ready, admins may do any allowed transition.
+// handOver is required only to hand the order over
+func (r Handlers) changeOrderStatus(ctx context.Context, orderID int64, handOver *HandOverOrderRequest, status string, byPayer bool) (Order, error) {
+	log := r.Logger.With(slog.String("handler_name", "ChangeOrderStatus"))
+
+	if orderID <= 0 {
+		return Order{}, fiber.NewError(400, "invalid order id")
+	}
+
+	actorName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return Order{}, err
+	}
+
+	var req HandOverOrderRequest
+	if status == dal.OrderStatusHandedOver {
+		if handOver == nil {
+			return Order{}, fiber.NewError(400, "invalid body: pickup code required")
+		}
+		req = *handOver
+		if errValidate := req.Validate(log); errValidate != nil {
+			return Order{}, errValidate
+		}
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return Order{}, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	pending, err := txRepo.GetOrderForUpdate(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, dal.ErrOrderNotFound) {
+			return Order{}, newError(404, err)
+		}
+		return Order{}, fmt.Errorf("fail txRepo.GetOrderForUpdate: %w", err)
+	}
+	if byPayer {
+		if pending.Payer != actorName {
+			return Order{}, newError(404, dal.ErrOrderNotFound)
+		}
+		if pending.Status != dal.OrderStatusPlaced {
+			return Order{}, fiber.NewError(409, "order can be cancelled only before it is ready")
+		}
+	}
+	if !slices.Contains(orderTransitions[pending.Status], status) {
+		return Order{}, newError(409, ErrOrderStatusTransition)
+	}
+
+	switch status {
+	case dal.OrderStatusHandedOver:
+		if subtle.ConstantTimeCompare([]byte(pending.PickupCode), []byte(req.PickupCode)) != 1 {
+			return Order{}, newError(400, ErrWrongPickupCode)
+		}
+	case dal.OrderStatusCancelled:
+		_, err = txRepo.MinusMerchVariantFromUser(ctx, pending.UserName, pending.MerchItem, pending.Variant)
+		if err != nil {
+			if errors.Is(err, dal.ErrNotEnoughMerch) {
+				return Order{}, newError(400, err)
+			}
+			return Order{}, fmt.Errorf("fail txRepo.MinusMerchVariantFromUser: %w", err)
+		}
+
+		if pending.Variant != "" {
+			if err = txRepo.ReturnMerchVariantStock(ctx, pending.MerchItem, pending.Variant, 1); err != nil {
+				return Order{}, fmt.Errorf("fail txRepo.ReturnMerchVariantStock: %w", err)
+			}
+		}
+
+		if _, err = txRepo.PlusCoins(ctx, pending.Payer, pending.Price); err != nil {
+			return Order{}, fmt.Errorf("fail txRepo.PlusCoins: %w", err)
+		}
+
+		// coins are already returned, so the purchase can not be refunded once more
+		if pending.PurchaseID.Valid {
+			if err = txRepo.RejectPurchaseRefunds(ctx, pending.PurchaseID.Int64, actorName); err != nil {
+				return Order{}, fmt.Errorf("fail txRepo.RejectPurchaseRefunds: %w", err)
+			}
+		}
+	}
+
+	order, err := txRepo.UpdateOrderStatus(ctx, pending.ID, status, actorName)
+	if err != nil {
+		return Order{}, fmt.Errorf("fail txRepo.UpdateOrderStatus: %w", err)
+	}
+
+	if status == dal.OrderStatusReady {
+		message := fmt.Sprintf("Your order #%d (%s) is ready for pickup", order.ID, order.MerchItem)
+		email := mailer.OrderReady{OrderID: order.ID, Item: order.MerchItem}
+		if err = r.notify(ctx, txRepo, order.UserName, dal.NotificationOrderReady, &order.MerchItem, message, email); err != nil {
+			return Order{}, fmt.Errorf("fail notify: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return Order{}, err
+	}
+
+	log.Info("order status changed",
+		slog.Int64("order_id", order.ID),
+		slog.String("status", order.Status),
+		slog.String("by", actorName),
+	)
+	return newOrder(*order, actorName), nil
+}
@@ -0,0 +1,139 @@
+package httpcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+// appliedPromotion is a promotion that gave a discount to the purchase
+type appliedPromotion struct {
+	promotion models.Promotion
+	discount  int64
+}
+
+func promotionDiscount(promotion models.Promotion, price int64) int64 {
+	discount := price*int64(promotion.PercentOff)/100 + promotion.AmountOff
+	return min(discount, price)
+}
+
+func promotionAppliesTo(promotion models.Promotion, merchItem string, now time.Time) bool {
+	if promotion.MerchItem.Valid && promotion.MerchItem.String != merchItem {
+		return false
+	}
+	return !now.Before(promotion.StartsAt.Time) && now.Before(promotion.EndsAt.Time)
+}
+
+// applyPromotions returns the final price and promotions which gave a discount.
+// Not stackable promotions do not combine - only the one with the biggest discount is applied,
+// stackable promotions are applied after it one by one to the remaining price
+func applyPromotions(price int64, promotions []models.Promotion) (int64, []appliedPromotion) {
+	var applied []appliedPromotion
+
+	best := -1
+	var bestDiscount int64
+	for i, promotion := range promotions {
+		if promotion.Stackable {
+			continue
+		}
+		if discount := promotionDiscount(promotion, price); discount > bestDiscount {
+			best, bestDiscount = i, discount
+		}
+	}
+	if best >= 0 {
+		applied = append(applied, appliedPromotion{promotion: promotions[best], discount: bestDiscount})
+		price -= bestDiscount
+	}
+
+	for _, promotion := range promotions {
+		if !promotion.Stackable {
+			continue
+		}
+		if discount := promotionDiscount(promotion, price); discount > 0 {
+			applied = append(applied, appliedPromotion{promotion: promotion, discount: discount})
+			price -= discount
+		}
+	}
+	return price, applied
+}
+
+// eligiblePromotions returns running sales of the merch item and the promotion of the promo code
+// which the user has not exhausted yet. Promotion rows stay locked until the end of tx
+func eligiblePromotions(ctx context.Context, txRepo *dal.Dal, userName string, merchItem string, promoCode *string) ([]models.Promotion, error) {
+	sales, err := txRepo.ListActiveSales(ctx, merchItem)
+	if err != nil {
+		return nil, fmt.Errorf("fail txRepo.ListActiveSales: %w", err)
+	}
+
+	promotions := make([]models.Promotion, 0, len(sales)+1)
+	for _, sale := range sales {
+		err = txRepo.CheckPromotionLimits(ctx, sale, userName)
+		if errors.Is(err, dal.ErrPromotionExhausted) {
+			continue // exhausted sale just stops working
+		}
+		if err != nil {
+			return nil, fmt.Errorf("fail txRepo.CheckPromotionLimits: %w", err)
+		}
+		promotions = append(promotions, sale)
+	}
+
+	if promoCode == nil {
+		return promotions, nil
+	}
+
+	promotion, err := txRepo.GetPromotionByCodeForUpdate(ctx, *promoCode)
+	if err != nil {
+		if errors.Is(err, dal.ErrInvalidPromoCode) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.GetPromotionByCodeForUpdate: %w", err)
+	}
+	if !promotionAppliesTo(*promotion, merchItem, time.Now()) {
+		return nil, newError(400, dal.ErrPromoCodeNotApplicable)
+	}
+	if err = txRepo.CheckPromotionLimits(ctx, *promotion, userName); err != nil {
+		if errors.Is(err, dal.ErrPromotionExhausted) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.CheckPromotionLimits: %w", err)
+	}
+	return append(promotions, *promotion), nil
+}
+
+// allocateBundlePrice splits the bundle price between units proportionally to their list prices,
+// so every unit is recorded as a separate purchase and unit prices sum up to the bundle price exactly
+func allocateBundlePrice(bundlePrice int64, listPrices []int64) []int64 {
+	prices := make([]int64, len(listPrices))
+
+	var total int64
+	for _, price := range listPrices {
+		total += price
+	}
+	if total == 0 {
+		return prices
+	}
+
+	remainders := make([]int64, len(listPrices))
+	order := make([]int, len(listPrices))
+	var allocated int64
+	for i, price := range listPrices {
+		prices[i] = price * bundlePrice / total
+		remainders[i] = price * bundlePrice % total
+		order[i] = i
+		allocated += prices[i]
+	}
+
+	// coins lost by rounding down go to units with the biggest remainders
+	slices.SortStableFunc(order, func(a, b int) int {
+		return int(remainders[b] - remainders[a])
+	})
+	for i := int64(0); i < bundlePrice-allocated; i++ {
+		prices[order[i%int64(len(order))]]++
+	}
+	return prices
+}
@@ -0,0 +1,332 @@
+package httpcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+	"github.com/maximmihin/aw25/internal/eventbus"
+)
+
+func newPromotion(promotion models.Promotion) Promotion {
+	return Promotion{
+		Id:                    promotion.ID,
+		Code:                  textPtr(promotion.Code),
+		Item:                  textPtr(promotion.MerchItem),
+		PercentOff:            promotion.PercentOff,
+		AmountOff:             promotion.AmountOff,
+		Stackable:             promotion.Stackable,
+		StartsAt:              promotion.StartsAt.Time,
+		EndsAt:                promotion.EndsAt.Time,
+		MaxRedemptions:        int8Ptr(promotion.MaxRedemptions),
+		MaxRedemptionsPerUser: int8Ptr(promotion.MaxRedemptionsPerUser),
+	}
+}
+
+func int8Ptr(i pgtype.Int8) *int64 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int64
+}
+
+func pgText(s *string) pgtype.Text {
+	if s == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *s, Valid: true}
+}
+
+func pgInt8(i *int64) pgtype.Int8 {
+	if i == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: *i, Valid: true}
+}
+
+func saleMessage(promotion models.Promotion) string {
+	period := fmt.Sprintf("from %s until %s",
+		promotion.StartsAt.Time.UTC().Format(time.RFC3339),
+		promotion.EndsAt.Time.UTC().Format(time.RFC3339),
+	)
+	if !promotion.MerchItem.Valid {
+		return "items from your wishlist are on sale " + period
+	}
+	return promotion.MerchItem.String + " from your wishlist is on sale " + period
+}
+
+func (r Handlers) PostApiAdminPromotions(ctx context.Context, request PostApiAdminPromotionsRequestObject) (PostApiAdminPromotionsResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "CreatePromotion"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	createArgs := models.CreatePromotionParams{
+		Code:                  pgText(req.Code),
+		MerchItem:             pgText(req.Item),
+		StartsAt:              pgtype.Timestamptz{Time: req.StartsAt, Valid: true},
+		EndsAt:                pgtype.Timestamptz{Time: req.EndsAt, Valid: true},
+		MaxRedemptions:        pgInt8(req.MaxRedemptions),
+		MaxRedemptionsPerUser: pgInt8(req.MaxRedemptionsPerUser),
+	}
+	if req.PercentOff != nil {
+		createArgs.PercentOff = *req.PercentOff
+	}
+	if req.AmountOff != nil {
+		createArgs.AmountOff = *req.AmountOff
+	}
+	if req.Stackable != nil {
+		createArgs.Stackable = *req.Stackable
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	promotion, err := txRepo.CreatePromotion(ctx, createArgs)
+	if err != nil {
+		if errors.Is(err, dal.ErrInternal) {
+			return nil, fmt.Errorf("fail txRepo.CreatePromotion: %w", err)
+		}
+		return nil, newError(400, err)
+	}
+
+	// promotions without code are sales everybody gets, so users waiting for the item are told about them
+	var notified int64
+	if !promotion.Code.Valid {
+		notified, err = txRepo.NotifyWishlistedUsers(ctx, models.NotifyWishlistedUsersParams{
+			Kind:      dal.NotificationItemOnSale,
+			Message:   saleMessage(*promotion),
+			MerchItem: promotion.MerchItem,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail txRepo.NotifyWishlistedUsers: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("promotion created",
+		slog.Int64("promotion_id", promotion.ID),
+		slog.Int64("notified", notified),
+	)
+	return PostApiAdminPromotions200JSONResponse(newPromotion(*promotion)), nil
+}
+
+func (r Handlers) GetApiAdminPromotions(ctx context.Context, request GetApiAdminPromotionsRequestObject) (GetApiAdminPromotionsResponseObject, error) {
+	rows, err := r.Dal.ListPromotions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	promotions := make([]Promotion, 0, len(rows))
+	for _, row := range rows {
+		promotions = append(promotions, newPromotion(row))
+	}
+	return GetApiAdminPromotions200JSONResponse(promotions), nil
+}
+
+func (r Handlers) PostApiAdminBundles(ctx context.Context, request PostApiAdminBundlesRequestObject) (PostApiAdminBundlesResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "CreateBundle"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	_, err = txRepo.CreateBundle(ctx, models.CreateBundleParams{
+		Slug:     req.Slug,
+		Price:    req.Price,
+		StartsAt: pgtype.Timestamptz{Time: req.StartsAt, Valid: true},
+		EndsAt:   pgtype.Timestamptz{Time: req.EndsAt, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, dal.ErrInternal) {
+			return nil, fmt.Errorf("fail txRepo.CreateBundle: %w", err)
+		}
+		return nil, newError(400, err)
+	}
+
+	for _, item := range req.Items {
+		_, err = txRepo.AddBundleItem(ctx, models.CreateBundleItemParams{
+			Bundle:    req.Slug,
+			MerchItem: item.Item,
+			Quantity:  item.Quantity,
+		})
+		if err != nil {
+			if errors.Is(err, dal.ErrInternal) {
+				return nil, fmt.Errorf("fail txRepo.AddBundleItem: %w", err)
+			}
+			return nil, newError(400, err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("bundle created", slog.String("bundle", req.Slug))
+	return PostApiAdminBundles200JSONResponse(req), nil
+}
+
+func (r Handlers) GetApiBundles(ctx context.Context, request GetApiBundlesRequestObject) (GetApiBundlesResponseObject, error) {
+	rows, err := r.Dal.ListActiveBundles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bundles := make([]Bundle, 0)
+	for _, row := range rows {
+		if len(bundles) == 0 || bundles[len(bundles)-1].Slug != row.Slug {
+			bundles = append(bundles, Bundle{
+				Slug:     row.Slug,
+				Price:    row.Price,
+				StartsAt: row.StartsAt.Time,
+				EndsAt:   row.EndsAt.Time,
+				Items:    []BundleItem{},
+			})
+		}
+		bundle := &bundles[len(bundles)-1]
+		bundle.Items = append(bundle.Items, BundleItem{
+			Item:     row.MerchItem,
+			Quantity: row.Quantity,
+		})
+	}
+	return GetApiBundles200JSONResponse(bundles), nil
+}
+
+func (r Handlers) PostApiBundlesBundleBuy(ctx context.Context, request PostApiBundlesBundleBuyRequestObject) (PostApiBundlesBundleBuyResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "BuyBundle"))
+
+	slug := request.Bundle
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	items, err := txRepo.GetActiveBundleItems(ctx, slug)
+	if err != nil {
+		if errors.Is(err, dal.ErrInvalidBundle) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.GetActiveBundleItems: %w", err)
+	}
+	bundlePrice := items[0].Price
+
+	// every unit of the bundle is recorded as a separate purchase to be refundable on its own
+	var units []string
+	var listPrices []int64
+	for _, item := range items {
+		for range item.Quantity {
+			units = append(units, item.MerchItem)
+			listPrices = append(listPrices, MerchShowCase[item.MerchItem])
+		}
+	}
+	unitPrices := allocateBundlePrice(bundlePrice, listPrices)
+
+	_, err = txRepo.MinusCoins(ctx, userName, bundlePrice)
+	if err != nil {
+		if errors.Is(err, dal.ErrNotEnoughCoins) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.MinusCoins: %w", err)
+	}
+
+	for i, merchItem := range units {
+		if _, err = txRepo.AddMerchToUser(ctx, userName, merchItem); err != nil {
+			if errors.Is(err, dal.ErrInvalidUser) {
+				return nil, newError(400, ErrUserDeleted)
+			}
+			return nil, fmt.Errorf("fail txRepo.AddMerchToUser: %w", err)
+		}
+
+		purchase, err := txRepo.AddPurchase(ctx, models.CreatePurchaseParams{
+			UserName:  userName,
+			MerchItem: merchItem,
+			Price:     unitPrices[i],
+			Discount:  listPrices[i] - unitPrices[i],
+			Bundle:    pgtype.Text{String: slug, Valid: true},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail txRepo.AddPurchase: %w", err)
+		}
+
+		placed, err := placeOrder(ctx, txRepo, models.CreateOrderParams{
+			UserName:   userName,
+			Payer:      userName,
+			MerchItem:  merchItem,
+			Price:      purchase.Price,
+			PurchaseID: pgtype.Int8{Int64: purchase.ID, Valid: true},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err = addOutboxEvent(ctx, txRepo, eventbus.OutboxMerchPurchased, newOrder(*placed, "")); err != nil {
+			return nil, fmt.Errorf("fail addOutboxEvent: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("bundle bought",
+		slog.String("bundle", slug),
+		slog.String("user", userName),
+	)
+	return PostApiBundlesBundleBuy200Response{}, nil
+}
@@ -0,0 +1,466 @@
+package httpcontroller
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+const raffleSeedBytes = 32
+
+var ErrRaffleSalesOver = errors.New("raffle ticket sales are over")
+var ErrRaffleSalesNotOver = errors.New("raffle ticket sales are not over yet")
+var ErrRaffleTicketsLimit = errors.New("raffle tickets limit per user is exceeded")
+
+// newRaffleSeed returns a random hex seed and its sha256 hash to publish before the draw
+func newRaffleSeed() (seed string, seedHash string, err error) {
+	b := make([]byte, raffleSeedBytes)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", err
+	}
+	seed = hex.EncodeToString(b)
+	hash := sha256.Sum256([]byte(seed))
+	return seed, hex.EncodeToString(hash[:]), nil
+}
+
+// drawRaffleWinners picks winning tickets from tickets in the order they were sold.
+// On step k the first 8 bytes of sha256("<seed>:<k>") as big-endian uint64 modulo remaining
+// tickets count is the index of the winning ticket, then all tickets of its owner are dropped,
+// so anybody can repeat the draw with the revealed seed and the public ticket list
+func drawRaffleWinners(seed string, tickets []models.RaffleTicket, winners int64) []models.RaffleTicket {
+	remaining := slices.Clone(tickets)
+
+	var won []models.RaffleTicket
+	for k := int64(0); k < winners && len(remaining) > 0; k++ {
+		hash := sha256.Sum256([]byte(seed + ":" + strconv.FormatInt(k, 10)))
+		idx := binary.BigEndian.Uint64(hash[:8]) % uint64(len(remaining))
+
+		winner := remaining[idx]
+		won = append(won, winner)
+		remaining = slices.DeleteFunc(remaining, func(ticket models.RaffleTicket) bool {
+			return ticket.UserName == winner.UserName
+		})
+	}
+	return won
+}
+
+// newRaffle converts db raffle to response model, the seed is shown only after the draw
+func newRaffle(raffle models.Raffle) Raffle {
+	res := Raffle{
+		Id:                raffle.ID,
+		Item:              raffle.MerchItem,
+		Variant:           nonEmptyPtr(raffle.Variant),
+		TicketPrice:       raffle.TicketPrice,
+		WinnersCount:      raffle.Winners,
+		MaxTicketsPerUser: int8Ptr(raffle.MaxTicketsPerUser),
+		SalesEndAt:        raffle.SalesEndAt.Time,
+		Status:            RaffleStatus(raffle.Status),
+		SeedHash:          raffle.SeedHash,
+		CreatedAt:         raffle.CreatedAt.Time,
+		DrawnAt:           timePtr(raffle.DrawnAt),
+		DrawnBy:           textPtr(raffle.DrawnBy),
+	}
+	if raffle.Status == dal.RaffleStatusDrawn {
+		res.Seed = &raffle.Seed
+	}
+	return res
+}
+
+func newRaffleTicket(ticket models.RaffleTicket) RaffleTicket {
+	return RaffleTicket{
+		Id:        ticket.ID,
+		User:      ticket.UserName,
+		Price:     ticket.Price,
+		Won:       ticket.Won,
+		CreatedAt: ticket.CreatedAt.Time,
+	}
+}
+
+func (r Handlers) PostApiAdminRaffles(ctx context.Context, request PostApiAdminRafflesRequestObject) (PostApiAdminRafflesResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "CreateRaffle"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	adminName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	seed, seedHash, err := newRaffleSeed()
+	if err != nil {
+		return nil, fmt.Errorf("fail newRaffleSeed: %w", err)
+	}
+
+	createArgs := models.CreateRaffleParams{
+		MerchItem:         req.Item,
+		TicketPrice:       req.TicketPrice,
+		Winners:           1,
+		MaxTicketsPerUser: pgInt8(req.MaxTicketsPerUser),
+		SalesEndAt:        pgtype.Timestamptz{Time: req.SalesEndAt, Valid: true},
+		SeedHash:          seedHash,
+		Seed:              seed,
+		CreatedBy:         adminName,
+	}
+	if req.Variant != nil {
+		createArgs.Variant = *req.Variant
+	}
+	if req.WinnersCount != nil {
+		createArgs.Winners = *req.WinnersCount
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	if createArgs.Variant != "" {
+		if _, err = txRepo.GetMerchVariant(ctx, createArgs.MerchItem, createArgs.Variant); err != nil {
+			if errors.Is(err, dal.ErrInvalidMerchVariant) {
+				return nil, newError(400, err)
+			}
+			return nil, fmt.Errorf("fail txRepo.GetMerchVariant: %w", err)
+		}
+	}
+
+	raffle, err := txRepo.CreateRaffle(ctx, createArgs)
+	if err != nil {
+		if errors.Is(err, dal.ErrInternal) {
+			return nil, fmt.Errorf("fail txRepo.CreateRaffle: %w", err)
+		}
+		return nil, newError(400, err)
+	}
+
+	// the prizes are taken from stock until the draw, the ones nobody wins are returned
+	if createArgs.Variant != "" {
+		if err = txRepo.TakeMerchVariantStock(ctx, createArgs.MerchItem, createArgs.Variant, createArgs.Winners); err != nil {
+			if errors.Is(err, dal.ErrOutOfStock) {
+				return nil, newError(400, err)
+			}
+			return nil, fmt.Errorf("fail txRepo.TakeMerchVariantStock: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("raffle created",
+		slog.Int64("raffle_id", raffle.ID),
+		slog.String("seed_hash", raffle.SeedHash),
+		slog.String("by", adminName),
+	)
+	return PostApiAdminRaffles200JSONResponse(newRaffle(*raffle)), nil
+}
+
+func (r Handlers) GetApiRaffles(ctx context.Context, request GetApiRafflesRequestObject) (GetApiRafflesResponseObject, error) {
+	rows, err := r.Dal.ListRaffles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	raffles := make([]Raffle, 0, len(rows))
+	for _, row := range rows {
+		raffles = append(raffles, newRaffle(row))
+	}
+	return GetApiRaffles200JSONResponse(raffles), nil
+}
+
+func (r Handlers) GetApiRafflesId(ctx context.Context, request GetApiRafflesIdRequestObject) (GetApiRafflesIdResponseObject, error) {
+	raffleID := request.Id
+	if raffleID <= 0 {
+		return nil, fiber.NewError(400, "invalid raffle id")
+	}
+
+	raffle, err := r.Dal.GetRaffle(ctx, raffleID)
+	if err != nil {
+		if errors.Is(err, dal.ErrRaffleNotFound) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail GetRaffle: %w", err)
+	}
+
+	tickets, err := r.Dal.ListRaffleTickets(ctx, raffle.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fail ListRaffleTickets: %w", err)
+	}
+
+	winners := make([]RaffleTicket, 0)
+	for _, ticket := range tickets {
+		if ticket.Won {
+			winners = append(winners, newRaffleTicket(ticket))
+		}
+	}
+	ticketsSold := int64(len(tickets))
+
+	res := newRaffle(*raffle)
+	res.TicketsSold = &ticketsSold
+	res.Winners = &winners
+	return GetApiRafflesId200JSONResponse(res), nil
+}
+
+func (r Handlers) GetApiRafflesIdTickets(ctx context.Context, request GetApiRafflesIdTicketsRequestObject) (GetApiRafflesIdTicketsResponseObject, error) {
+	raffleID := request.Id
+	if raffleID <= 0 {
+		return nil, fiber.NewError(400, "invalid raffle id")
+	}
+
+	raffle, err := r.Dal.GetRaffle(ctx, raffleID)
+	if err != nil {
+		if errors.Is(err, dal.ErrRaffleNotFound) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail GetRaffle: %w", err)
+	}
+
+	rows, err := r.Dal.ListRaffleTickets(ctx, raffle.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fail ListRaffleTickets: %w", err)
+	}
+
+	tickets := make([]RaffleTicket, 0, len(rows))
+	for _, row := range rows {
+		tickets = append(tickets, newRaffleTicket(row))
+	}
+	return GetApiRafflesIdTickets200JSONResponse(tickets), nil
+}
+
+func (r Handlers) PostApiRafflesIdTickets(ctx context.Context, request PostApiRafflesIdTicketsRequestObject) (PostApiRafflesIdTicketsResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "BuyRaffleTickets"))
+
+	raffleID := request.Id
+	if raffleID <= 0 {
+		return nil, fiber.NewError(400, "invalid raffle id")
+	}
+
+	var req BuyRaffleTicketsRequest
+	if request.Body != nil {
+		req = *request.Body
+	}
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	quantity := int64(1)
+	if req.Quantity != nil {
+		quantity = *req.Quantity
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	raffle, err := txRepo.GetRaffleForUpdate(ctx, raffleID)
+	if err != nil {
+		if errors.Is(err, dal.ErrRaffleNotFound) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.GetRaffleForUpdate: %w", err)
+	}
+	// the draw compares sales_end_at with the database time, so ticket sales do too
+	now, err := txRepo.TransactionTime(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail txRepo.TransactionTime: %w", err)
+	}
+	if raffle.Status != dal.RaffleStatusOpen || !now.Before(raffle.SalesEndAt.Time) {
+		return nil, newError(409, ErrRaffleSalesOver)
+	}
+
+	if raffle.MaxTicketsPerUser.Valid {
+		bought, err := txRepo.CountUserRaffleTickets(ctx, raffle.ID, userName)
+		if err != nil {
+			return nil, fmt.Errorf("fail txRepo.CountUserRaffleTickets: %w", err)
+		}
+		if bought+quantity > raffle.MaxTicketsPerUser.Int64 {
+			return nil, newError(400, ErrRaffleTicketsLimit)
+		}
+	}
+
+	_, err = txRepo.MinusCoins(ctx, userName, raffle.TicketPrice*quantity)
+	if err != nil {
+		if errors.Is(err, dal.ErrNotEnoughCoins) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.MinusCoins: %w", err)
+	}
+
+	tickets := make([]RaffleTicket, 0, quantity)
+	for range quantity {
+		ticket, err := txRepo.AddRaffleTicket(ctx, models.CreateRaffleTicketParams{
+			RaffleID: raffle.ID,
+			UserName: userName,
+			Price:    raffle.TicketPrice,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail txRepo.AddRaffleTicket: %w", err)
+		}
+		tickets = append(tickets, newRaffleTicket(*ticket))
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("raffle tickets bought",
+		slog.Int64("raffle_id", raffle.ID),
+		slog.String("user", userName),
+		slog.Int64("quantity", quantity),
+	)
+	return PostApiRafflesIdTickets200JSONResponse(tickets), nil
+}
+
+func (r Handlers) PostApiAdminRafflesIdDraw(ctx context.Context, request PostApiAdminRafflesIdDrawRequestObject) (PostApiAdminRafflesIdDrawResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "DrawRaffle"))
+
+	raffleID := request.Id
+	if raffleID <= 0 {
+		return nil, fiber.NewError(400, "invalid raffle id")
+	}
+
+	adminName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	pending, err := txRepo.GetRaffleForUpdate(ctx, raffleID)
+	if err != nil {
+		if errors.Is(err, dal.ErrRaffleNotFound) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.GetRaffleForUpdate: %w", err)
+	}
+	if pending.Status != dal.RaffleStatusOpen {
+		return nil, newError(409, dal.ErrRaffleDrawn)
+	}
+
+	now, err := txRepo.TransactionTime(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail txRepo.TransactionTime: %w", err)
+	}
+	if now.Before(pending.SalesEndAt.Time) {
+		return nil, newError(409, ErrRaffleSalesNotOver)
+	}
+
+	tickets, err := txRepo.ListRaffleTickets(ctx, pending.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fail txRepo.ListRaffleTickets: %w", err)
+	}
+
+	won := drawRaffleWinners(pending.Seed, tickets, pending.Winners)
+
+	winners := make([]RaffleTicket, 0, len(won))
+	for _, ticket := range won {
+		if err = txRepo.WinRaffleTicket(ctx, ticket.ID); err != nil {
+			return nil, fmt.Errorf("fail txRepo.WinRaffleTicket: %w", err)
+		}
+
+		_, err = txRepo.AddMerchVariantToUser(ctx, ticket.UserName, pending.MerchItem, pending.Variant)
+		if err != nil {
+			return nil, fmt.Errorf("fail txRepo.AddMerchVariantToUser: %w", err)
+		}
+
+		// the prize is free for the winner, so cancelling the order returns nothing
+		_, err = placeOrder(ctx, txRepo, models.CreateOrderParams{
+			UserName:  ticket.UserName,
+			Payer:     ticket.UserName,
+			MerchItem: pending.MerchItem,
+			Variant:   pending.Variant,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ticket.Won = true
+		winners = append(winners, newRaffleTicket(ticket))
+	}
+
+	// prizes left without a winner go back to stock
+	unawarded := pending.Winners - int64(len(won))
+	if pending.Variant != "" && unawarded > 0 {
+		if err = txRepo.ReturnMerchVariantStock(ctx, pending.MerchItem, pending.Variant, unawarded); err != nil {
+			return nil, fmt.Errorf("fail txRepo.ReturnMerchVariantStock: %w", err)
+		}
+	}
+
+	raffle, err := txRepo.DrawRaffle(ctx, pending.ID, adminName)
+	if err != nil {
+		return nil, fmt.Errorf("fail txRepo.DrawRaffle: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("raffle drawn",
+		slog.Int64("raffle_id", raffle.ID),
+		slog.String("seed", raffle.Seed),
+		slog.Int("tickets", len(tickets)),
+		slog.Int("winners", len(winners)),
+		slog.String("by", adminName),
+	)
+
+	ticketsSold := int64(len(tickets))
+	res := newRaffle(*raffle)
+	res.TicketsSold = &ticketsSold
+	res.Winners = &winners
+	return PostApiAdminRafflesIdDraw200JSONResponse(res), nil
+}
@@ -0,0 +1,247 @@
+package httpcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+var ErrRefundOrderCancelled = errors.New("order of the purchase is already cancelled, coins were returned with it")
+
+func newRefund(refund models.MerchRefund, purchase models.MerchPurchase) Refund {
+	return Refund{
+		Id:          refund.ID,
+		User:        purchase.UserName,
+		Item:        purchase.MerchItem,
+		Variant:     nonEmptyPtr(purchase.Variant),
+		Price:       purchase.Price,
+		Status:      RefundStatus(refund.Status),
+		RequestedAt: refund.RequestedAt.Time,
+		ResolvedAt:  timePtr(refund.ResolvedAt),
+		ResolvedBy:  textPtr(refund.ResolvedBy),
+	}
+}
+
+func nonEmptyPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func timePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func textPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	return &t.String
+}
+
+func (r Handlers) PostApiRefundItem(ctx context.Context, request PostApiRefundItemRequestObject) (PostApiRefundItemResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "RequestRefund"))
+
+	merchItem := request.Item
+	if _, ok := MerchShowCase[merchItem]; !ok {
+		return nil, newError(400, dal.ErrInvalidMerchItem)
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	var variant string
+	if request.Params.Variant != nil {
+		variant = *request.Params.Variant
+	}
+
+	purchase, err := r.Dal.GetLastRefundablePurchase(ctx, userName, merchItem, variant, time.Now().Add(-r.RefundWindow))
+	if err != nil {
+		if errors.Is(err, dal.ErrNoRefundablePurchase) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail GetLastRefundablePurchase: %w", err)
+	}
+
+	refund, err := r.Dal.CreateRefund(ctx, purchase.ID)
+	if err != nil {
+		if errors.Is(err, dal.ErrRefundAlreadyRequested) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail CreateRefund: %w", err)
+	}
+
+	log.Info("refund requested",
+		slog.Int64("refund_id", refund.ID),
+		slog.Int64("purchase_id", purchase.ID),
+		slog.String("user", userName),
+	)
+	return PostApiRefundItem200JSONResponse(newRefund(*refund, *purchase)), nil
+}
+
+func (r Handlers) GetApiAdminRefunds(ctx context.Context, request GetApiAdminRefundsRequestObject) (GetApiAdminRefundsResponseObject, error) {
+	status := RefundStatusRequested
+	if request.Params.Status != nil {
+		status = *request.Params.Status
+	}
+	switch status {
+	case RefundStatusRequested, RefundStatusApproved, RefundStatusRejected:
+	default:
+		return nil, fiber.NewError(400, "invalid refund status")
+	}
+
+	rows, err := r.Dal.ListRefunds(ctx, string(status))
+	if err != nil {
+		return nil, err
+	}
+
+	refunds := make([]Refund, 0, len(rows))
+	for _, row := range rows {
+		refunds = append(refunds, newRefund(models.MerchRefund{
+			ID:          row.ID,
+			PurchaseID:  row.PurchaseID,
+			Status:      row.Status,
+			RequestedAt: row.RequestedAt,
+			ResolvedAt:  row.ResolvedAt,
+			ResolvedBy:  row.ResolvedBy,
+		}, models.MerchPurchase{
+			ID:        row.PurchaseID,
+			UserName:  row.UserName,
+			MerchItem: row.MerchItem,
+			Variant:   row.Variant,
+			Price:     row.Price,
+		}))
+	}
+	return GetApiAdminRefunds200JSONResponse(refunds), nil
+}
+
+func (r Handlers) PostApiAdminRefundsIdApprove(ctx context.Context, request PostApiAdminRefundsIdApproveRequestObject) (PostApiAdminRefundsIdApproveResponseObject, error) {
+	refund, err := r.resolveRefund(ctx, request.Id, dal.RefundStatusApproved)
+	if err != nil {
+		return nil, err
+	}
+	return PostApiAdminRefundsIdApprove200JSONResponse(refund), nil
+}
+
+func (r Handlers) PostApiAdminRefundsIdReject(ctx context.Context, request PostApiAdminRefundsIdRejectRequestObject) (PostApiAdminRefundsIdRejectResponseObject, error) {
+	refund, err := r.resolveRefund(ctx, request.Id, dal.RefundStatusRejected)
+	if err != nil {
+		return nil, err
+	}
+	return PostApiAdminRefundsIdReject200JSONResponse(refund), nil
+}
+
+func (r Handlers) resolveRefund(ctx context.Context, refundID int64, status string) (Refund, error) {
+	log := r.Logger.With(slog.String("handler_name", "ResolveRefund"))
+
+	if refundID <= 0 {
+		return Refund{}, fiber.NewError(400, "invalid refund id")
+	}
+
+	adminName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return Refund{}, err
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return Refund{}, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	// the order is locked before the refund, as cancellation does, so a concurrent cancel
+	// waits for the refund to be resolved instead of returning the coins once more
+	order, err := txRepo.GetRefundOrderForUpdate(ctx, refundID)
+	if err != nil && !errors.Is(err, dal.ErrOrderNotFound) {
+		return Refund{}, fmt.Errorf("fail txRepo.GetRefundOrderForUpdate: %w", err)
+	}
+
+	pending, err := txRepo.GetRefundForUpdate(ctx, refundID)
+	if err != nil {
+		if errors.Is(err, dal.ErrRefundNotFound) {
+			return Refund{}, newError(404, err)
+		}
+		return Refund{}, fmt.Errorf("fail txRepo.GetRefundForUpdate: %w", err)
+	}
+	if pending.Status != dal.RefundStatusRequested {
+		return Refund{}, newError(409, dal.ErrRefundAlreadyResolved)
+	}
+	if status == dal.RefundStatusApproved && order != nil && order.Status == dal.OrderStatusCancelled {
+		return Refund{}, newError(409, ErrRefundOrderCancelled)
+	}
+
+	if status == dal.RefundStatusApproved {
+		_, err = txRepo.MinusMerchVariantFromUser(ctx, pending.UserName, pending.MerchItem, pending.Variant)
+		if err != nil {
+			if errors.Is(err, dal.ErrNotEnoughMerch) {
+				return Refund{}, newError(400, err)
+			}
+			return Refund{}, fmt.Errorf("fail txRepo.MinusMerchVariantFromUser: %w", err)
+		}
+
+		if pending.Variant != "" {
+			if err = txRepo.ReturnMerchVariantStock(ctx, pending.MerchItem, pending.Variant, 1); err != nil {
+				return Refund{}, fmt.Errorf("fail txRepo.ReturnMerchVariantStock: %w", err)
+			}
+		}
+
+		if _, err = txRepo.PlusCoins(ctx, pending.UserName, pending.Price); err != nil {
+			return Refund{}, fmt.Errorf("fail txRepo.PlusCoins: %w", err)
+		}
+
+		// coins are returned here, so the order must not be handed out or cancelled with one more refund
+		if err = txRepo.CancelPurchaseOrder(ctx, pending.PurchaseID, adminName); err != nil {
+			return Refund{}, fmt.Errorf("fail txRepo.CancelPurchaseOrder: %w", err)
+		}
+	}
+
+	refund, err := txRepo.ResolveRefund(ctx, pending.ID, status, adminName)
+	if err != nil {
+		if errors.Is(err, dal.ErrRefundAlreadyResolved) {
+			return Refund{}, newError(409, err)
+		}
+		return Refund{}, fmt.Errorf("fail txRepo.ResolveRefund: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return Refund{}, err
+	}
+
+	log.Info("refund resolved",
+		slog.Int64("refund_id", refund.ID),
+		slog.String("status", refund.Status),
+		slog.String("admin", adminName),
+	)
+	return newRefund(*refund, models.MerchPurchase{
+		ID:        pending.PurchaseID,
+		UserName:  pending.UserName,
+		MerchItem: pending.MerchItem,
+		Variant:   pending.Variant,
+		Price:     pending.Price,
+	}), nil
+}
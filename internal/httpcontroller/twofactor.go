@@ -0,0 +1,306 @@
+package httpcontroller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	"github.com/maximmihin/aw25/internal/totp"
+)
+
+const (
+	totpIssuer = "merch-store"
+
+	// loginChallengeTTL is the time between the password and the code steps of a login
+	loginChallengeTTL = 5 * time.Minute
+
+	totpRecoveryCodes    = 10
+	totpRecoveryCodeSize = 5
+	// totpRecoveryPurpose keys hashes of recovery codes apart from auth tokens
+	totpRecoveryPurpose = "totp_recovery"
+)
+
+var ErrTwoFactorRequired = errors.New("two-factor authentication is required for admins")
+
+// newLoginChallenge returns an empty challenge if the user logs in with the password only
+func (r Handlers) newLoginChallenge(ctx context.Context, userName string) (string, error) {
+	userTotp, err := r.Dal.GetUserTotp(ctx, userName)
+	if err != nil {
+		return "", fmt.Errorf("fail GetUserTotp: %w", err)
+	}
+	if userTotp == nil || !userTotp.ConfirmedAt.Valid {
+		return "", nil
+	}
+	return r.newAuthToken(ctx, r.Dal, userName, dal.AuthTokenLoginChallenge, loginChallengeTTL)
+}
+
+// isTotpCode tells codes of authenticator apps from recovery codes
+func isTotpCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// recoveryCodeHash ignores case and separators, users retype recovery codes from paper
+func (r Handlers) recoveryCodeHash(code string) []byte {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return r.authTokenHash(totpRecoveryPurpose, code)
+}
+
+func (r Handlers) newRecoveryCodes() ([]string, [][]byte, error) {
+	codes := make([]string, 0, totpRecoveryCodes)
+	hashes := make([][]byte, 0, totpRecoveryCodes)
+	for range totpRecoveryCodes {
+		raw := make([]byte, totpRecoveryCodeSize)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, fmt.Errorf("%w: unexepted error: %w", dal.ErrInternal, err)
+		}
+		code := hex.EncodeToString(raw)
+		codes = append(codes, code[:len(code)/2]+"-"+code[len(code)/2:])
+		hashes = append(hashes, r.recoveryCodeHash(code))
+	}
+	return codes, hashes, nil
+}
+
+// checkSecondFactor accepts a code of the authenticator app or a recovery code, both are single-use
+func (r Handlers) checkSecondFactor(ctx context.Context, repo *dal.Dal, userName string, code string) error {
+	if !isTotpCode(code) {
+		return repo.UseTotpRecoveryCode(ctx, userName, r.recoveryCodeHash(code))
+	}
+
+	userTotp, err := repo.GetUserTotp(ctx, userName)
+	if err != nil {
+		return err
+	}
+	if userTotp == nil || !userTotp.ConfirmedAt.Valid {
+		return dal.ErrTwoFactorNotEnabled
+	}
+	step, ok := totp.Validate(userTotp.Secret, code, time.Now())
+	if !ok {
+		return dal.ErrInvalidOTP
+	}
+	return repo.UseTotpStep(ctx, userName, step)
+}
+
+func (r Handlers) PostApiAuthOtp(ctx context.Context, request PostApiAuthOtpRequestObject) (PostApiAuthOtpResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "LoginOTP"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	// the challenge is used up before the code is checked, so codes can not be guessed without the password
+	userName, err := r.Dal.UseAuthToken(ctx, r.authTokenHash(dal.AuthTokenLoginChallenge, req.Challenge), dal.AuthTokenLoginChallenge)
+	if err != nil {
+		if errors.Is(err, dal.ErrInvalidAuthToken) {
+			return nil, newError(401, err)
+		}
+		return nil, fmt.Errorf("fail UseAuthToken: %w", err)
+	}
+
+	if err = r.checkSecondFactor(ctx, r.Dal, userName, req.Code); err != nil {
+		if errors.Is(err, dal.ErrInvalidOTP) || errors.Is(err, dal.ErrTwoFactorNotEnabled) {
+			return nil, newError(401, dal.ErrInvalidOTP)
+		}
+		return nil, fmt.Errorf("fail checkSecondFactor: %w", err)
+	}
+
+	jwtt, err := newJWT(userName, r.JWTPrivateKey, amrOTP)
+	if err != nil {
+		log.Error("fail to create new JWT: " + err.Error())
+		return nil, err
+	}
+	return PostApiAuthOtp200JSONResponse{Token: &jwtt}, nil
+}
+
+func (r Handlers) GetApiAuth2fa(ctx context.Context, request GetApiAuth2faRequestObject) (GetApiAuth2faResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "GetTwoFactor"))
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	userTotp, err := r.Dal.GetUserTotp(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("fail GetUserTotp: %w", err)
+	}
+	codesLeft, err := r.Dal.CountTotpRecoveryCodes(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("fail CountTotpRecoveryCodes: %w", err)
+	}
+
+	return GetApiAuth2fa200JSONResponse{
+		Enabled:           userTotp != nil && userTotp.ConfirmedAt.Valid,
+		Required:          r.isAdmin(userName),
+		RecoveryCodesLeft: codesLeft,
+	}, nil
+}
+
+func (r Handlers) PostApiAuth2faEnroll(ctx context.Context, request PostApiAuth2faEnrollRequestObject) (PostApiAuth2faEnrollResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "EnrollTwoFactor"))
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return nil, fmt.Errorf("fail totp.NewSecret: %w", err)
+	}
+	if _, err = r.Dal.EnrollTotp(ctx, userName, secret); err != nil {
+		if errors.Is(err, dal.ErrTwoFactorAlreadyEnabled) {
+			return nil, newError(409, err)
+		}
+		return nil, fmt.Errorf("fail EnrollTotp: %w", err)
+	}
+
+	return PostApiAuth2faEnroll200JSONResponse{
+		Secret: totp.EncodeSecret(secret),
+		Uri:    totp.URI(totpIssuer, userName, secret),
+	}, nil
+}
+
+func (r Handlers) PostApiAuth2faConfirm(ctx context.Context, request PostApiAuth2faConfirmRequestObject) (PostApiAuth2faConfirmResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "ConfirmTwoFactor"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	userTotp, err := txRepo.GetUserTotp(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("fail txRepo.GetUserTotp: %w", err)
+	}
+	if userTotp == nil {
+		return nil, newError(409, dal.ErrTwoFactorNotEnabled)
+	}
+	if userTotp.ConfirmedAt.Valid {
+		return nil, newError(409, dal.ErrTwoFactorAlreadyEnabled)
+	}
+
+	step, ok := totp.Validate(userTotp.Secret, req.Code, time.Now())
+	if !ok {
+		return nil, newError(400, dal.ErrInvalidOTP)
+	}
+	if err = txRepo.ConfirmTotp(ctx, userName, step); err != nil {
+		if errors.Is(err, dal.ErrInvalidOTP) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.ConfirmTotp: %w", err)
+	}
+
+	codes, hashes, err := r.newRecoveryCodes()
+	if err != nil {
+		return nil, fmt.Errorf("fail newRecoveryCodes: %w", err)
+	}
+	if err = txRepo.ReplaceTotpRecoveryCodes(ctx, userName, hashes); err != nil {
+		return nil, fmt.Errorf("fail txRepo.ReplaceTotpRecoveryCodes: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("two-factor authentication enabled", slog.String("user", userName))
+	return PostApiAuth2faConfirm200JSONResponse{RecoveryCodes: codes}, nil
+}
+
+func (r Handlers) PostApiAuth2faDisable(ctx context.Context, request PostApiAuth2faDisableRequestObject) (PostApiAuth2faDisableResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "DisableTwoFactor"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+	if r.isAdmin(userName) {
+		return nil, newError(403, ErrTwoFactorRequired)
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	userTotp, err := txRepo.GetUserTotp(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("fail txRepo.GetUserTotp: %w", err)
+	}
+	if userTotp == nil || !userTotp.ConfirmedAt.Valid {
+		return nil, newError(409, dal.ErrTwoFactorNotEnabled)
+	}
+
+	if err = r.checkSecondFactor(ctx, txRepo, userName, req.Code); err != nil {
+		if errors.Is(err, dal.ErrInvalidOTP) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail checkSecondFactor: %w", err)
+	}
+	if err = txRepo.DisableTotp(ctx, userName); err != nil {
+		return nil, fmt.Errorf("fail txRepo.DisableTotp: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("two-factor authentication disabled", slog.String("user", userName))
+	return PostApiAuth2faDisable204Response{}, nil
+}
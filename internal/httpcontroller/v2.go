@@ -0,0 +1,221 @@
+package httpcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+func newTransfer(transfer models.CoinTransfer) Transfer {
+	return Transfer{
+		Id:        transfer.ID,
+		FromUser:  transfer.Sender,
+		ToUser:    transfer.Recipient,
+		Amount:    transfer.Amount,
+		CreatedAt: transfer.CreatedAt.Time,
+	}
+}
+
+func newUser(userInfo models.UserInfo) User {
+	inventory := make([]InventoryItem, 0, len(userInfo.FullUserInventory))
+	for _, item := range userInfo.FullUserInventory {
+		inventory = append(inventory, InventoryItem{
+			Item:     item.Type,
+			Variant:  nonEmptyPtr(item.Variant),
+			Quantity: item.Quantity,
+		})
+	}
+	return User{
+		Name:      userInfo.UserName,
+		Coins:     userInfo.Coins,
+		Inventory: inventory,
+	}
+}
+
+func (r Handlers) GetApiV2UsersMe(ctx context.Context, request GetApiV2UsersMeRequestObject) (GetApiV2UsersMeResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "GetMe"))
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	userInfo, err := r.Dal.GetCompositeUserInfo(ctx, userName)
+	if err != nil {
+		return nil, err
+	}
+	if userInfo == nil {
+		return nil, fmt.Errorf("jwt token valid, but user not found in db")
+	}
+	return GetApiV2UsersMe200JSONResponse(newUser(*userInfo)), nil
+}
+
+// GetApiV2UsersMeTransactions lists transfers sent and received by the user, newest first
+func (r Handlers) GetApiV2UsersMeTransactions(ctx context.Context, request GetApiV2UsersMeTransactionsRequestObject) (GetApiV2UsersMeTransactionsResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "ListMyTransactions"))
+
+	params := request.Params
+	if errValidate := params.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	listArgs := models.ListUserTransfersParams{
+		UserName:   userName,
+		PageLimit:  defaultMerchPageLimit,
+		PageOffset: 0,
+	}
+	if params.Limit != nil {
+		listArgs.PageLimit = *params.Limit
+	}
+	if params.Offset != nil {
+		listArgs.PageOffset = *params.Offset
+	}
+
+	rows, err := r.Dal.ListUserTransfers(ctx, listArgs)
+	if err != nil {
+		return nil, fmt.Errorf("fail ListUserTransfers: %w", err)
+	}
+	total, err := r.Dal.CountUserTransfers(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("fail CountUserTransfers: %w", err)
+	}
+
+	items := make([]Transfer, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, newTransfer(row))
+	}
+	return GetApiV2UsersMeTransactions200JSONResponse{
+		Items:  items,
+		Total:  total,
+		Limit:  listArgs.PageLimit,
+		Offset: listArgs.PageOffset,
+	}, nil
+}
+
+func (r Handlers) PostApiV2Transfers(ctx context.Context, request PostApiV2TransfersRequestObject) (PostApiV2TransfersResponseObject, error) {
+	transfer, err := r.sendCoin(ctx, *request.Body)
+	if err != nil {
+		return nil, err
+	}
+	return PostApiV2Transfers201JSONResponse{
+		Body: newTransfer(*transfer),
+		Headers: PostApiV2Transfers201ResponseHeaders{
+			Location: "/api/v2/transfers/" + strconv.FormatInt(transfer.ID, 10),
+		},
+	}, nil
+}
+
+// GetApiV2TransfersId shows the transfer to its sender and recipient only, for others it does not exist
+func (r Handlers) GetApiV2TransfersId(ctx context.Context, request GetApiV2TransfersIdRequestObject) (GetApiV2TransfersIdResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "GetTransfer"))
+
+	if request.Id <= 0 {
+		return nil, fiber.NewError(400, "invalid transfer id")
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	transfer, err := r.Dal.GetTransfer(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, dal.ErrTransferNotFound) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail GetTransfer: %w", err)
+	}
+	if transfer.Sender != userName && transfer.Recipient != userName {
+		return nil, newError(404, dal.ErrTransferNotFound)
+	}
+	return GetApiV2TransfersId200JSONResponse(newTransfer(*transfer)), nil
+}
+
+func (r Handlers) PostApiV2Orders(ctx context.Context, request PostApiV2OrdersRequestObject) (PostApiV2OrdersResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "CreateOrder"))
+
+	req := *request.Body
+	buyReq := BuyMerchRequest{
+		Variant:   req.Variant,
+		ForUser:   req.ForUser,
+		Message:   req.Message,
+		PromoCode: req.PromoCode,
+	}
+
+	if errValidate := buyReq.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	order, err := r.buyMerch(ctx, req.Item, buyReq)
+	if err != nil {
+		return nil, err
+	}
+	return PostApiV2Orders201JSONResponse{
+		Body: newOrder(*order, userName),
+		Headers: PostApiV2Orders201ResponseHeaders{
+			Location: "/api/v2/orders/" + strconv.FormatInt(order.ID, 10),
+		},
+	}, nil
+}
+
+// GetApiV2OrdersId shows the order to its payer and to the user who picks it up
+func (r Handlers) GetApiV2OrdersId(ctx context.Context, request GetApiV2OrdersIdRequestObject) (GetApiV2OrdersIdResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "GetOrder"))
+
+	if request.Id <= 0 {
+		return nil, fiber.NewError(400, "invalid order id")
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	order, err := r.Dal.GetOrder(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, dal.ErrOrderNotFound) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail GetOrder: %w", err)
+	}
+	if order.UserName != userName && order.Payer != userName {
+		return nil, newError(404, dal.ErrOrderNotFound)
+	}
+	return GetApiV2OrdersId200JSONResponse(newOrder(*order, userName)), nil
+}
+
+func (r Handlers) GetApiV2Merch(ctx context.Context, request GetApiV2MerchRequestObject) (GetApiV2MerchResponseObject, error) {
+	page, err := r.listMerch(ctx, GetApiMerchParams(request.Params))
+	if err != nil {
+		return nil, err
+	}
+	return GetApiV2Merch200JSONResponse{
+		Body: *page,
+		Headers: GetApiV2Merch200ResponseHeaders{
+			ContentLanguage: languageFromContext(ctx),
+			Vary:            fiber.HeaderAcceptLanguage,
+		},
+	}, nil
+}
@@ -4,16 +4,37 @@ import (
 	"errors"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
-	"github.com/gofiber/fiber/v2"
 	"log/slog"
+	"regexp"
+	"slices"
+	"time"
 )
 
 const (
 	maxNameLength = 200
 	minNameLength = 10
+
+	maxAuthTokenLength = 128
+	// maxTwoFactorCodeLength fits recovery codes with separators users may type
+	maxTwoFactorCodeLength = 32
+
+	maxGiftMessageLength = 255
+
+	maxPromoCodeLength  = 64
+	maxBundleSlugLength = 255
+
+	maxRaffleTicketsPerRequest = 100
+
+	maxWebhookURLLength    = 2048
+	minWebhookSecretLength = 16
+	maxWebhookSecretLength = 255
 )
 
-func (request AuthRequest) Validate(log *slog.Logger) *fiber.Error {
+var bundleSlugRegexp = regexp.MustCompile("^[a-z0-9-]+$")
+
+var webhookURLRegexp = regexp.MustCompile("^https?://")
+
+func (request AuthRequest) Validate(log *slog.Logger) *Error {
 	rb := request
 	errValid := validation.ValidateStruct(&rb,
 		validation.Field(&rb.Username,
@@ -23,20 +44,453 @@ func (request AuthRequest) Validate(log *slog.Logger) *fiber.Error {
 			validation.Required, is.Alphanumeric,
 		),
 	)
-	if errValid != nil {
-		var errValInternal validation.InternalError
-		if errors.As(errValid, &errValInternal) {
-			log.Error("fail to validate input: " + errValInternal.Error())
-			return fiber.NewError(500)
+	return validationError(log, errValid, "user auth")
+}
+
+func (request VerifyEmailRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Token,
+			validation.Required, validation.RuneLength(1, maxAuthTokenLength),
+		),
+	)
+	return validationError(log, errValid, "email verification")
+}
+
+func (request ForgotPasswordRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Username,
+			validation.Required, validation.RuneLength(minNameLength, maxNameLength),
+		),
+	)
+	return validationError(log, errValid, "password recovery")
+}
+
+func (request ResetPasswordRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Token,
+			validation.Required, validation.RuneLength(1, maxAuthTokenLength),
+		),
+		validation.Field(&rb.Password,
+			validation.Required, is.Alphanumeric,
+		),
+	)
+	return validationError(log, errValid, "password reset")
+}
+
+func (request LoginOTPRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Challenge,
+			validation.Required, validation.RuneLength(1, maxAuthTokenLength),
+		),
+		validation.Field(&rb.Code,
+			validation.Required, validation.RuneLength(1, maxTwoFactorCodeLength),
+		),
+	)
+	return validationError(log, errValid, "login otp")
+}
+
+func (request TwoFactorCodeRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Code,
+			validation.Required, validation.RuneLength(1, maxTwoFactorCodeLength),
+		),
+	)
+	return validationError(log, errValid, "two-factor code")
+}
+
+func (request SendCoinRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.ToUser,
+			validation.Required, validation.RuneLength(minNameLength, maxNameLength),
+		),
+		validation.Field(&rb.Amount,
+			validation.Required, validation.Min(1),
+		),
+	)
+	return validationError(log, errValid, "send coin")
+}
+
+func (request HandOverOrderRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.PickupCode,
+			validation.Required, is.Digit,
+		),
+	)
+	return validationError(log, errValid, "hand over")
+}
+
+func (request BuyMerchRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.ForUser,
+			validation.NilOrNotEmpty, validation.RuneLength(minNameLength, maxNameLength),
+		),
+		validation.Field(&rb.Message,
+			validation.RuneLength(0, maxGiftMessageLength),
+		),
+		validation.Field(&rb.PromoCode,
+			validation.NilOrNotEmpty, validation.RuneLength(0, maxPromoCodeLength),
+		),
+	)
+	return validationError(log, errValid, "buy")
+}
+
+func (request CreatePromotionRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	noPercentOff := rb.PercentOff == nil || *rb.PercentOff == 0
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Code,
+			validation.NilOrNotEmpty, validation.RuneLength(0, maxPromoCodeLength), is.Alphanumeric,
+		),
+		validation.Field(&rb.Item,
+			validation.NilOrNotEmpty, validation.In(merchItems()...).Error("unknown merch item"),
+		),
+		validation.Field(&rb.PercentOff,
+			validation.Min(int32(0)), validation.Max(int32(100)),
+		),
+		validation.Field(&rb.AmountOff,
+			validation.Min(int64(0)),
+			validation.When(noPercentOff, validation.Required.Error("percentOff or amountOff is required")),
+		),
+		validation.Field(&rb.StartsAt,
+			validation.Required,
+		),
+		validation.Field(&rb.EndsAt,
+			validation.Required, validation.Min(rb.StartsAt).Exclusive().Error("must be after startsAt"),
+		),
+		validation.Field(&rb.MaxRedemptions,
+			validation.Min(int64(1)),
+		),
+		validation.Field(&rb.MaxRedemptionsPerUser,
+			validation.Min(int64(1)),
+		),
+	)
+	return validationError(log, errValid, "promotion")
+}
+
+func (item BundleItem) Validate() error {
+	return validation.ValidateStruct(&item,
+		validation.Field(&item.Item,
+			validation.Required, validation.In(merchItems()...).Error("unknown merch item"),
+		),
+		validation.Field(&item.Quantity,
+			validation.Required, validation.Min(int64(1)),
+		),
+	)
+}
+
+func (request Bundle) Validate(log *slog.Logger) *Error {
+	rb := request
+
+	var listPrice int64
+	seen := make(map[string]bool, len(rb.Items))
+	duplicates := false
+	for _, item := range rb.Items {
+		listPrice += MerchShowCase[item.Item] * item.Quantity
+		duplicates = duplicates || seen[item.Item]
+		seen[item.Item] = true
+	}
+
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Slug,
+			validation.Required, validation.RuneLength(0, maxBundleSlugLength), validation.Match(bundleSlugRegexp),
+		),
+		validation.Field(&rb.Price,
+			validation.Min(int64(0)), validation.Max(listPrice).Error("must not exceed the price of items"),
+		),
+		validation.Field(&rb.StartsAt,
+			validation.Required,
+		),
+		validation.Field(&rb.EndsAt,
+			validation.Required, validation.Min(rb.StartsAt).Exclusive().Error("must be after startsAt"),
+		),
+		validation.Field(&rb.Items,
+			validation.Required,
+			validation.By(func(interface{}) error {
+				if duplicates {
+					return errors.New("items must be unique")
+				}
+				return nil
+			}),
+		),
+	)
+	return validationError(log, errValid, "bundle")
+}
+
+func (request CreateListingRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Item,
+			validation.Required, validation.In(merchItems()...).Error("unknown merch item"),
+		),
+		validation.Field(&rb.Variant,
+			validation.NilOrNotEmpty,
+		),
+		validation.Field(&rb.Quantity,
+			validation.Required, validation.Min(int64(1)),
+		),
+		validation.Field(&rb.Price,
+			validation.Required, validation.Min(int64(1)),
+		),
+	)
+	return validationError(log, errValid, "listing")
+}
+
+func (request BuyListingRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Quantity,
+			validation.NilOrNotEmpty, validation.Min(int64(1)),
+		),
+	)
+	return validationError(log, errValid, "buy listing")
+}
+
+func merchItems() []interface{} {
+	items := make([]interface{}, 0, len(MerchShowCase))
+	for item := range MerchShowCase {
+		items = append(items, item)
+	}
+	return items
+}
+
+func validationError(log *slog.Logger, errValid error, what string) *Error {
+	if errValid == nil {
+		return nil
+	}
+	var errValInternal validation.InternalError
+	if errors.As(errValid, &errValInternal) {
+		log.Error("fail to validate input: " + errValInternal.Error())
+		return errInternalServer()
+	}
+	var errValVal validation.Errors
+	if errors.As(errValid, &errValVal) {
+		log.Info("user sent invalid "+what+" params",
+			slog.Any("invalids params", errValVal),
+		)
+		return &Error{
+			Status:  400,
+			Code:    ErrorCodeVALIDATIONFAILED,
+			Message: "invalid " + what + " parameters: " + errValVal.Error(),
+			Fields:  fieldErrors("", errValVal),
 		}
-		var errValVal validation.Errors
-		if errors.As(errValid, &errValVal) {
-			log.Info("user sent invalid auth params",
-				slog.Any("invalids params", errValVal),
-			)
-			return fiber.NewError(400, "invalid user auth parameters: %s", errValVal.Error())
+	}
+	return errInternalServer()
+}
+
+// fieldErrors flattens nested validation errors of slices and structs into dotted field paths
+func fieldErrors(prefix string, errValVal validation.Errors) []FieldError {
+	fields := make([]string, 0, len(errValVal))
+	for field := range errValVal {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	var res []FieldError
+	for _, field := range fields {
+		var nested validation.Errors
+		if errors.As(errValVal[field], &nested) {
+			res = append(res, fieldErrors(prefix+field+".", nested)...)
+			continue
 		}
-		return fiber.NewError(500)
+		res = append(res, FieldError{
+			Field:   prefix + field,
+			Message: errValVal[field].Error(),
+		})
+	}
+	return res
+}
+
+func (params GetApiMerchParams) Validate(log *slog.Logger) *Error {
+	rb := params
+	var minPrice int64
+	if rb.MinPrice != nil {
+		minPrice = *rb.MinPrice
 	}
-	return nil
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.MinPrice, validation.Min(int64(0))),
+		validation.Field(&rb.MaxPrice, validation.Min(minPrice)),
+		validation.Field(&rb.Q, validation.RuneLength(0, maxNameLength)),
+		validation.Field(&rb.Sort, validation.In(
+			MerchSortName, MerchSortPrice, MerchSortMinusPrice,
+		)),
+		validation.Field(&rb.Limit, validation.Min(int32(1)), validation.Max(int32(maxMerchPageLimit))),
+		validation.Field(&rb.Offset, validation.Min(int32(0))),
+	)
+	return validationError(log, errValid, "catalog")
+}
+
+func (params GetApiV2UsersMeTransactionsParams) Validate(log *slog.Logger) *Error {
+	rb := params
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Limit, validation.Min(int32(1)), validation.Max(int32(maxMerchPageLimit))),
+		validation.Field(&rb.Offset, validation.Min(int32(0))),
+	)
+	return validationError(log, errValid, "transactions")
+}
+
+func (request AddWishlistItemRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Item,
+			validation.Required, validation.In(merchItems()...).Error("unknown merch item"),
+		),
+		validation.Field(&rb.Variant,
+			validation.NilOrNotEmpty,
+		),
+	)
+	return validationError(log, errValid, "wishlist")
+}
+
+func (request RestockRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Quantity,
+			validation.Required, validation.Min(int64(1)),
+		),
+	)
+	return validationError(log, errValid, "restock")
+}
+
+func (request CreateAuctionRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Item,
+			validation.Required, validation.In(merchItems()...).Error("unknown merch item"),
+		),
+		validation.Field(&rb.Variant,
+			validation.NilOrNotEmpty,
+		),
+		validation.Field(&rb.StartPrice,
+			validation.Required, validation.Min(int64(1)),
+		),
+		validation.Field(&rb.MinStep,
+			validation.Min(int64(1)),
+		),
+		validation.Field(&rb.ExtensionSeconds,
+			validation.Min(int64(0)),
+		),
+		validation.Field(&rb.StartsAt,
+			validation.Required,
+		),
+		validation.Field(&rb.EndsAt,
+			validation.Required,
+			validation.Min(rb.StartsAt).Exclusive().Error("must be after startsAt"),
+			validation.Min(time.Now()).Exclusive().Error("must be in the future"),
+		),
+	)
+	return validationError(log, errValid, "auction")
+}
+
+func (request PlaceBidRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Amount,
+			validation.Required, validation.Min(int64(1)),
+		),
+	)
+	return validationError(log, errValid, "bid")
+}
+
+func (request CreateRaffleRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Item,
+			validation.Required, validation.In(merchItems()...).Error("unknown merch item"),
+		),
+		validation.Field(&rb.Variant,
+			validation.NilOrNotEmpty,
+		),
+		validation.Field(&rb.TicketPrice,
+			validation.Required, validation.Min(int64(1)),
+		),
+		validation.Field(&rb.WinnersCount,
+			validation.NilOrNotEmpty, validation.Min(int64(1)),
+		),
+		validation.Field(&rb.MaxTicketsPerUser,
+			validation.NilOrNotEmpty, validation.Min(int64(1)),
+		),
+		validation.Field(&rb.SalesEndAt,
+			validation.Required, validation.Min(time.Now()).Exclusive().Error("must be in the future"),
+		),
+	)
+	return validationError(log, errValid, "raffle")
+}
+
+func (request BuyRaffleTicketsRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Quantity,
+			validation.NilOrNotEmpty, validation.Min(int64(1)), validation.Max(int64(maxRaffleTicketsPerRequest)),
+		),
+	)
+	return validationError(log, errValid, "raffle tickets")
+}
+
+func (params GetApiNotificationsParams) Validate(log *slog.Logger) *Error {
+	rb := params
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Limit, validation.Min(int32(1)), validation.Max(int32(maxMerchPageLimit))),
+		validation.Field(&rb.Offset, validation.Min(int32(0))),
+	)
+	return validationError(log, errValid, "notifications")
+}
+
+func (request NotificationPreferences) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Muted,
+			validation.NotNil, validation.Each(validation.In(
+				NotificationKindItemOnSale, NotificationKindItemRestocked,
+				NotificationKindCoinsReceived, NotificationKindOrderReady,
+			).Error("unknown notification kind")),
+		),
+	)
+	if errValid == nil && rb.Email != nil {
+		email := *rb.Email
+		errValid = validation.ValidateStruct(&email,
+			validation.Field(&email.Language,
+				validation.Required, validation.In(EmailPreferencesLanguageRu, EmailPreferencesLanguageEn),
+			),
+		)
+	}
+	return validationError(log, errValid, "notification preferences")
+}
+
+func (request CreateWebhookSubscriptionRequest) Validate(log *slog.Logger) *Error {
+	rb := request
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Url,
+			validation.Required, validation.RuneLength(0, maxWebhookURLLength), is.URL,
+			validation.Match(webhookURLRegexp).Error("must be an http or https url"),
+		),
+		validation.Field(&rb.Events,
+			validation.Required, validation.Each(validation.In(
+				WebhookEventTypeCoinsTransferred, WebhookEventTypeMerchPurchased,
+			).Error("unknown event type")),
+		),
+		validation.Field(&rb.Secret,
+			validation.NilOrNotEmpty, validation.RuneLength(minWebhookSecretLength, maxWebhookSecretLength),
+		),
+	)
+	return validationError(log, errValid, "webhook")
+}
+
+func (params GetApiAdminWebhooksIdDeliveriesParams) Validate(log *slog.Logger) *Error {
+	rb := params
+	errValid := validation.ValidateStruct(&rb,
+		validation.Field(&rb.Status, validation.In(
+			WebhookDeliveryStatusPending, WebhookDeliveryStatusDelivered, WebhookDeliveryStatusDead,
+		)),
+		validation.Field(&rb.Limit, validation.Min(int32(1)), validation.Max(int32(maxMerchPageLimit))),
+		validation.Field(&rb.Offset, validation.Min(int32(0))),
+	)
+	return validationError(log, errValid, "webhook deliveries")
 }
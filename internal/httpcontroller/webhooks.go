@@ -0,0 +1,344 @@
+package httpcontroller
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+const (
+	webhookBatchSize      = 100
+	webhookRequestTimeout = 10 * time.Second
+	// webhookLease must outlast a request, otherwise another instance sends the delivery once more
+	webhookLease = 2 * webhookRequestTimeout
+	// webhookMaxAttempts failed attempts make the delivery dead, an admin can redeliver it
+	webhookMaxAttempts   = 8
+	webhookMaxRetryDelay = time.Hour
+
+	// webhookErrorLength is the most of a failed response body stored as the delivery error
+	webhookErrorLength = 1024
+)
+
+// webhookEvent is the body of a webhook request, see WebhookEvent in api/v1.yaml
+type webhookEvent struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// addOutboxEvent stores the event in the transaction of txRepo for webhooks and the event bus,
+// eventType is one of eventbus.Outbox* types and data is the api model of the event
+func addOutboxEvent(ctx context.Context, txRepo *dal.Dal, eventType string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("%w: unexepted error: %w", dal.ErrInternal, err)
+	}
+	_, err = txRepo.AddOutboxEvent(ctx, eventType, payload)
+	return err
+}
+
+// RunWebhookDispatcher delivers outbox events to webhook subscriptions until ctx is done, every instance runs its own.
+// Failed deliveries are retried with exponential backoff starting from WebhookRetryDelay
+func (r Handlers) RunWebhookDispatcher(ctx context.Context, interval time.Duration) {
+	log := r.Logger.With(slog.String("worker_name", "WebhookDispatcher"))
+	client := &http.Client{Timeout: webhookRequestTimeout}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			dispatched, err := r.Dal.DispatchOutboxEventsToWebhooks(ctx, webhookBatchSize)
+			if err != nil {
+				log.Error("fail to dispatch events: " + err.Error())
+				break
+			}
+			if dispatched < webhookBatchSize {
+				break
+			}
+		}
+
+		for {
+			deliveries, err := r.Dal.ClaimDueWebhookDeliveries(ctx, time.Now().Add(webhookLease), webhookBatchSize)
+			if err != nil {
+				log.Error("fail to claim deliveries: " + err.Error())
+				break
+			}
+
+			var wg sync.WaitGroup
+			for _, delivery := range deliveries {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					r.deliverWebhook(ctx, log, client, delivery)
+				}()
+			}
+			wg.Wait()
+
+			if len(deliveries) < webhookBatchSize {
+				break
+			}
+		}
+	}
+}
+
+func (r Handlers) deliverWebhook(ctx context.Context, log *slog.Logger, client *http.Client, delivery models.ClaimDueWebhookDeliveriesRow) {
+	log = log.With(slog.Int64("delivery_id", delivery.ID))
+
+	statusCode, err := sendWebhook(ctx, client, delivery)
+	if err == nil {
+		if err = r.Dal.MarkWebhookDelivered(ctx, delivery.ID, statusCode); err != nil {
+			log.Error("fail to mark delivered: " + err.Error())
+		}
+		return
+	}
+	if ctx.Err() != nil {
+		// the lease ends and the delivery is sent again, the attempt is not counted
+		return
+	}
+
+	attempt := delivery.Attempts + 1
+	nextAttemptAt := time.Now().Add(retryDelay(r.WebhookRetryDelay, webhookMaxRetryDelay, attempt))
+	if err = r.Dal.MarkWebhookFailed(ctx, delivery.ID, statusCode, err.Error(), nextAttemptAt, webhookMaxAttempts); err != nil {
+		log.Error("fail to mark failed: " + err.Error())
+		return
+	}
+	if attempt >= webhookMaxAttempts {
+		log.Warn("webhook delivery is dead", slog.Int("attempts", int(attempt)))
+	}
+}
+
+// retryDelay doubles the base delay after every failed attempt up to maxDelay
+func retryDelay(base, maxDelay time.Duration, attempt int32) time.Duration {
+	delay := base
+	for i := int32(1); i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+// sendWebhook posts the event signed with the subscription secret, any 2xx response means delivered.
+// statusCode is 0 if there was no response
+func sendWebhook(ctx context.Context, client *http.Client, delivery models.ClaimDueWebhookDeliveriesRow) (statusCode int, err error) {
+	body, err := json.Marshal(webhookEvent{
+		ID:        delivery.EventID,
+		Type:      delivery.EventType,
+		CreatedAt: delivery.EventCreatedAt.Time,
+		Data:      delivery.Payload,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Id", strconv.FormatInt(delivery.EventID, 10))
+	req.Header.Set("X-Webhook-Event", delivery.EventType)
+	req.Header.Set("X-Webhook-Timestamp", timestamp)
+	req.Header.Set("X-Webhook-Signature", signWebhook(delivery.Secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.StatusCode, nil
+	}
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, webhookErrorLength))
+	return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, respBody)
+}
+
+// signWebhook is the X-Webhook-Signature header: hex hmac-sha256 of "<timestamp>.<body>"
+func signWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+func (r Handlers) PostApiAdminWebhooks(ctx context.Context, request PostApiAdminWebhooksRequestObject) (PostApiAdminWebhooksResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "CreateWebhook"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	createArgs := models.CreateWebhookSubscriptionParams{
+		Url:       req.Url,
+		CreatedBy: userName,
+	}
+	if req.Secret != nil {
+		createArgs.Secret = *req.Secret
+	} else if createArgs.Secret, err = newWebhookSecret(); err != nil {
+		return nil, fmt.Errorf("fail newWebhookSecret: %w", err)
+	}
+	for _, event := range req.Events {
+		createArgs.EventTypes = append(createArgs.EventTypes, string(event))
+	}
+
+	subscription, err := r.Dal.CreateWebhookSubscription(ctx, createArgs)
+	if err != nil {
+		return nil, fmt.Errorf("fail CreateWebhookSubscription: %w", err)
+	}
+
+	log.Info("webhook created", slog.Int64("subscription_id", subscription.ID))
+
+	// the secret is shown only once
+	res := newWebhookSubscription(*subscription)
+	res.Secret = &subscription.Secret
+	return PostApiAdminWebhooks201JSONResponse(res), nil
+}
+
+func (r Handlers) GetApiAdminWebhooks(ctx context.Context, request GetApiAdminWebhooksRequestObject) (GetApiAdminWebhooksResponseObject, error) {
+	rows, err := r.Dal.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail ListWebhookSubscriptions: %w", err)
+	}
+
+	subscriptions := make([]WebhookSubscription, 0, len(rows))
+	for _, row := range rows {
+		subscriptions = append(subscriptions, newWebhookSubscription(row))
+	}
+	return GetApiAdminWebhooks200JSONResponse(subscriptions), nil
+}
+
+func (r Handlers) DeleteApiAdminWebhooksId(ctx context.Context, request DeleteApiAdminWebhooksIdRequestObject) (DeleteApiAdminWebhooksIdResponseObject, error) {
+	if err := r.Dal.DeleteWebhookSubscription(ctx, request.Id); err != nil {
+		if errors.Is(err, dal.ErrWebhookSubscriptionNotFound) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail DeleteWebhookSubscription: %w", err)
+	}
+	return DeleteApiAdminWebhooksId204Response{}, nil
+}
+
+func (r Handlers) GetApiAdminWebhooksIdDeliveries(ctx context.Context, request GetApiAdminWebhooksIdDeliveriesRequestObject) (GetApiAdminWebhooksIdDeliveriesResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "ListWebhookDeliveries"))
+
+	params := request.Params
+	if errValidate := params.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	if _, err := r.Dal.GetWebhookSubscription(ctx, request.Id); err != nil {
+		if errors.Is(err, dal.ErrWebhookSubscriptionNotFound) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail GetWebhookSubscription: %w", err)
+	}
+
+	listArgs := models.ListWebhookDeliveriesParams{
+		SubscriptionID: request.Id,
+		PageLimit:      defaultMerchPageLimit,
+		PageOffset:     0,
+	}
+	if params.Status != nil {
+		listArgs.Status = string(*params.Status)
+	}
+	if params.Limit != nil {
+		listArgs.PageLimit = *params.Limit
+	}
+	if params.Offset != nil {
+		listArgs.PageOffset = *params.Offset
+	}
+
+	rows, err := r.Dal.ListWebhookDeliveries(ctx, listArgs)
+	if err != nil {
+		return nil, fmt.Errorf("fail ListWebhookDeliveries: %w", err)
+	}
+
+	deliveries := make([]WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, newWebhookDelivery(row))
+	}
+	return GetApiAdminWebhooksIdDeliveries200JSONResponse(deliveries), nil
+}
+
+func (r Handlers) PostApiAdminWebhookDeliveriesIdRedeliver(ctx context.Context, request PostApiAdminWebhookDeliveriesIdRedeliverRequestObject) (PostApiAdminWebhookDeliveriesIdRedeliverResponseObject, error) {
+	delivery, err := r.Dal.RedeliverWebhookDelivery(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, dal.ErrWebhookDeliveryNotFound) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail RedeliverWebhookDelivery: %w", err)
+	}
+	return PostApiAdminWebhookDeliveriesIdRedeliver200JSONResponse(newWebhookDelivery(models.ListWebhookDeliveriesRow(*delivery))), nil
+}
+
+// newWebhookSubscription converts db subscription to response model without the secret
+func newWebhookSubscription(subscription models.WebhookSubscription) WebhookSubscription {
+	events := make([]WebhookEventType, 0, len(subscription.EventTypes))
+	for _, event := range subscription.EventTypes {
+		events = append(events, WebhookEventType(event))
+	}
+	return WebhookSubscription{
+		Id:        subscription.ID,
+		Url:       subscription.Url,
+		Events:    events,
+		CreatedAt: subscription.CreatedAt.Time,
+		CreatedBy: subscription.CreatedBy,
+	}
+}
+
+func newWebhookDelivery(delivery models.ListWebhookDeliveriesRow) WebhookDelivery {
+	res := WebhookDelivery{
+		Id:             delivery.ID,
+		SubscriptionId: delivery.SubscriptionID,
+		EventId:        delivery.EventID,
+		EventType:      WebhookEventType(delivery.EventType),
+		Status:         WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt.Time,
+		LastError:      textPtr(delivery.LastError),
+		DeliveredAt:    timePtr(delivery.DeliveredAt),
+		CreatedAt:      delivery.CreatedAt.Time,
+	}
+	if delivery.LastStatusCode.Valid {
+		res.LastStatusCode = &delivery.LastStatusCode.Int32
+	}
+	return res
+}
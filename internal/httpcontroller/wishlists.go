@@ -0,0 +1,254 @@
+package httpcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/maximmihin/aw25/internal/dal"
+	models "github.com/maximmihin/aw25/internal/dal/modelsgen"
+)
+
+// wishlist shows wishlist items priced with running sales and coins the user still lacks for every item
+func (r Handlers) wishlist(ctx context.Context, repo *dal.Dal, userName string) (*Wishlist, error) {
+	user, err := repo.GetUserByName(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("fail GetUserByName: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("jwt token valid, but user not found in db")
+	}
+
+	rows, err := repo.ListWishlist(ctx, userName)
+	if err != nil {
+		return nil, fmt.Errorf("fail ListWishlist: %w", err)
+	}
+
+	sales, err := repo.ListRunningSales(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail ListRunningSales: %w", err)
+	}
+
+	now := time.Now()
+	wishlist := Wishlist{
+		Coins: user.Coins,
+		Items: make([]WishlistItem, 0, len(rows)),
+	}
+	for _, row := range rows {
+		var itemSales []models.Promotion
+		for _, sale := range sales {
+			if promotionAppliesTo(sale, row.MerchItem, now) {
+				itemSales = append(itemSales, sale)
+			}
+		}
+		price, _ := applyPromotions(row.Price, itemSales)
+
+		wishlist.Items = append(wishlist.Items, WishlistItem{
+			Item:         row.MerchItem,
+			Variant:      nonEmptyPtr(row.Variant),
+			Price:        price,
+			MissingCoins: max(price-user.Coins, 0),
+			InStock:      !row.Stock.Valid || row.Stock.Int64 > 0,
+			CreatedAt:    row.CreatedAt.Time,
+		})
+	}
+	return &wishlist, nil
+}
+
+func (r Handlers) GetApiMeWishlist(ctx context.Context, request GetApiMeWishlistRequestObject) (GetApiMeWishlistResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "GetWishlist"))
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	wishlist, err := r.wishlist(ctx, r.Dal, userName)
+	if err != nil {
+		return nil, err
+	}
+	return GetApiMeWishlist200JSONResponse(*wishlist), nil
+}
+
+func (r Handlers) PostApiMeWishlist(ctx context.Context, request PostApiMeWishlistRequestObject) (PostApiMeWishlistResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "AddWishlistItem"))
+
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	var variant string
+	if req.Variant != nil {
+		variant = *req.Variant
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	if variant != "" {
+		if _, err = txRepo.GetMerchVariant(ctx, req.Item, variant); err != nil {
+			if errors.Is(err, dal.ErrInvalidMerchVariant) {
+				return nil, newError(400, err)
+			}
+			return nil, fmt.Errorf("fail txRepo.GetMerchVariant: %w", err)
+		}
+	}
+
+	if _, err = txRepo.AddWishlistItem(ctx, userName, req.Item, variant); err != nil {
+		switch {
+		case errors.Is(err, dal.ErrAlreadyInWishlist):
+			return nil, newError(409, err)
+		case errors.Is(err, dal.ErrInternal):
+			return nil, fmt.Errorf("fail txRepo.AddWishlistItem: %w", err)
+		}
+		return nil, newError(400, err)
+	}
+
+	wishlist, err := r.wishlist(ctx, txRepo, userName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("wishlist item added",
+		slog.String("user", userName),
+		slog.String("merch_item", req.Item),
+	)
+	return PostApiMeWishlist200JSONResponse(*wishlist), nil
+}
+
+func (r Handlers) DeleteApiMeWishlistItem(ctx context.Context, request DeleteApiMeWishlistItemRequestObject) (DeleteApiMeWishlistItemResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "DeleteWishlistItem"))
+
+	merchItem := request.Item
+	var variant string
+	if request.Params.Variant != nil {
+		variant = *request.Params.Variant
+	}
+
+	userName, err := userNameFromContext(ctx)
+	if err != nil {
+		log.Error("error via extract name from jwt token: " + err.Error())
+		return nil, err
+	}
+
+	if err = r.Dal.DeleteWishlistItem(ctx, userName, merchItem, variant); err != nil {
+		if errors.Is(err, dal.ErrNotInWishlist) {
+			return nil, newError(404, err)
+		}
+		return nil, fmt.Errorf("fail DeleteWishlistItem: %w", err)
+	}
+
+	wishlist, err := r.wishlist(ctx, r.Dal, userName)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("wishlist item deleted",
+		slog.String("user", userName),
+		slog.String("merch_item", merchItem),
+	)
+	return DeleteApiMeWishlistItem200JSONResponse(*wishlist), nil
+}
+
+// PostApiAdminMerchItemVariantsVariantRestock adds units to the variant stock and notifies users waiting for the sold out variant
+func (r Handlers) PostApiAdminMerchItemVariantsVariantRestock(ctx context.Context, request PostApiAdminMerchItemVariantsVariantRestockRequestObject) (PostApiAdminMerchItemVariantsVariantRestockResponseObject, error) {
+	log := r.Logger.With(slog.String("handler_name", "RestockMerchVariant"))
+
+	merchItem := request.Item
+	variant := request.Variant
+	req := *request.Body
+
+	if errValidate := req.Validate(log); errValidate != nil {
+		return nil, errValidate
+	}
+
+	tx, err := r.Dal.PgxPool.Begin(ctx)
+	if err != nil {
+		log.Error("error via start db transaction: " + err.Error())
+		return nil, err
+	}
+	defer func() {
+		if err = tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error("error via rollback tx: " + err.Error())
+		}
+	}()
+
+	txRepo := r.Dal.WithTx(tx)
+
+	// the row stays locked until commit, so the stock before the restock is exact
+	before, err := txRepo.GetMerchVariantForUpdate(ctx, merchItem, variant)
+	if err != nil {
+		if errors.Is(err, dal.ErrInvalidMerchVariant) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.GetMerchVariantForUpdate: %w", err)
+	}
+
+	merchVariant, err := txRepo.RestockMerchVariant(ctx, merchItem, variant, req.Quantity)
+	if err != nil {
+		if errors.Is(err, dal.ErrInvalidMerchVariant) {
+			return nil, newError(400, err)
+		}
+		return nil, fmt.Errorf("fail txRepo.RestockMerchVariant: %w", err)
+	}
+
+	// an unlimited variant was never sold out, users wait only for a variant with no stock left
+	var notified int64
+	if before.Stock.Valid && before.Stock.Int64 == 0 {
+		notified, err = txRepo.NotifyWishlistedUsers(ctx, models.NotifyWishlistedUsersParams{
+			Kind:      dal.NotificationItemRestocked,
+			Message:   fmt.Sprintf("%s (%s) from your wishlist is back in stock", merchItem, variant),
+			MerchItem: pgText(&merchItem),
+			Variant:   pgText(&variant),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail txRepo.NotifyWishlistedUsers: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error("fail tx.Commit: " + err.Error())
+		return nil, err
+	}
+
+	log.Info("merch variant restocked",
+		slog.String("merch_item", merchItem),
+		slog.String("variant", variant),
+		slog.Int64("stock", merchVariant.Stock.Int64),
+		slog.Int64("notified", notified),
+	)
+	return PostApiAdminMerchItemVariantsVariantRestock200JSONResponse{
+		Item:     merchItem,
+		Variant:  variant,
+		Stock:    merchVariant.Stock.Int64,
+		Notified: notified,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+)
+
+// templates are <name>.<language>.txt and <name>.<language>.html, the txt one also defines the "subject" template
+//
+//go:embed templates/*
+var templates embed.FS
+
+// CoinsReceived is the data of the "coins_received" templates
+type CoinsReceived struct {
+	From   string
+	Amount int64
+}
+
+// OrderReady is the data of the "order_ready" templates
+type OrderReady struct {
+	OrderID int64
+	Item    string
+}
+
+// AuthToken is the data of the "verify_email" and "reset_password" templates
+type AuthToken struct {
+	Token      string
+	ValidHours int
+}
+
+// Render executes the templates of name in language, To and ID of the message are left to the caller
+func Render(name, language string, data any) (Message, error) {
+	base := fmt.Sprintf("templates/%s.%s", name, language)
+
+	text, err := texttemplate.ParseFS(templates, base+".txt")
+	if err != nil {
+		return Message{}, fmt.Errorf("fail to parse text template: %w", err)
+	}
+	html, err := htmltemplate.ParseFS(templates, base+".html")
+	if err != nil {
+		return Message{}, fmt.Errorf("fail to parse html template: %w", err)
+	}
+
+	var subject, textBody, htmlBody bytes.Buffer
+	if err = text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, fmt.Errorf("fail to render subject: %w", err)
+	}
+	if err = text.Execute(&textBody, data); err != nil {
+		return Message{}, fmt.Errorf("fail to render text: %w", err)
+	}
+	if err = html.Execute(&htmlBody, data); err != nil {
+		return Message{}, fmt.Errorf("fail to render html: %w", err)
+	}
+
+	return Message{
+		Subject: subject.String(),
+		Text:    textBody.String(),
+		HTML:    htmlBody.String(),
+	}, nil
+}
@@ -0,0 +1,87 @@
+// Package totp implements RFC 6238 time-based one-time passwords with the parameters
+// every authenticator app supports: HMAC-SHA1, 6 digits and 30 seconds steps
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	Digits = 6
+	Period = 30 * time.Second
+
+	secretSize = 20
+	// skew steps around the current one are accepted too, clocks of phones drift
+	skew = 1
+)
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func NewSecret() ([]byte, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// EncodeSecret is the form users type into an authenticator app if they can not scan the QR code
+func EncodeSecret(secret []byte) string {
+	return b32.EncodeToString(secret)
+}
+
+// URI is the otpauth provisioning URI, the content of the QR code for authenticator apps
+func URI(issuer, account string, secret []byte) string {
+	query := url.Values{
+		"secret":    {EncodeSecret(secret)},
+		"issuer":    {issuer},
+		"algorithm": {"SHA1"},
+		"digits":    {fmt.Sprint(Digits)},
+		"period":    {fmt.Sprint(int(Period.Seconds()))},
+	}
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: query.Encode(),
+	}).String()
+}
+
+// Step is the number of the time step of t
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period.Seconds())
+}
+
+// Code is the password of the step
+func Code(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// dynamic truncation of RFC 4226
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%1_000_000)
+}
+
+// Validate returns the step of the code if it is valid at now. The caller must reject steps
+// that were already used, otherwise an intercepted code can be replayed
+func Validate(secret []byte, code string, now time.Time) (int64, bool) {
+	current := Step(now)
+	for step := current - skew; step <= current+skew; step++ {
+		if hmac.Equal([]byte(Code(secret, step)), []byte(code)) {
+			return step, true
+		}
+	}
+	return 0, false
+}
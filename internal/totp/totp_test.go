@@ -0,0 +1,34 @@
+package totp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestCodeRFC6238 checks the SHA1 test vectors of RFC 6238 Appendix B, truncated to 6 digits
+func TestCodeRFC6238(t *testing.T) {
+	secret := []byte("12345678901234567890")
+
+	vectors := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, v := range vectors {
+		now := time.Unix(v.unix, 0)
+		require.Equal(t, v.code, Code(secret, Step(now)), "time %d", v.unix)
+
+		step, ok := Validate(secret, v.code, now)
+		require.True(t, ok, "time %d", v.unix)
+		require.Equal(t, Step(now), step)
+	}
+}